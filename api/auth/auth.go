@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
 const (
@@ -42,6 +43,8 @@ func generateToken(username string, userID int32, audience string, expirationTim
 		Audience: jwt.ClaimStrings{audience},
 		IssuedAt: jwt.NewNumericDate(time.Now()),
 		Subject:  fmt.Sprint(userID),
+		// The id tells apart the tokens issued at the same second, so that they can be revoked one by one.
+		ID: uuid.New().String(),
 	}
 	if !expirationTime.IsZero() {
 		registeredClaims.ExpiresAt = jwt.NewNumericDate(expirationTime)
@@ -1,5 +1,9 @@
 package v1
 
+import (
+	"github.com/yourselfhosted/slash/internal/analytics"
+)
+
 type ActivityShorcutCreatePayload struct {
 	ShortcutID int32 `json:"shortcutId"`
 }
@@ -9,4 +13,24 @@ type ActivityShorcutViewPayload struct {
 	IP         string `json:"ip"`
 	Referer    string `json:"referer"`
 	UserAgent  string `json:"userAgent"`
+	// Browser, OS and DeviceType are parsed from the user agent when the view is recorded, when the
+	// server parses user agents.
+	Browser    string `json:"browser,omitempty"`
+	OS         string `json:"os,omitempty"`
+	DeviceType string `json:"deviceType,omitempty"`
+	// Locale is the language of the locale link the view was redirected to, empty for the other links.
+	Locale string `json:"locale,omitempty"`
+}
+
+// ActivityShortcutConversionPayload is the payload of a conversion of the goal of a shortcut.
+type ActivityShortcutConversionPayload struct {
+	ShortcutID int32  `json:"shortcutId"`
+	Goal       string `json:"goal"`
+	IP         string `json:"ip"`
+}
+
+// getUserAgent returns the fields of the user agent of the view, parsing it when they were not
+// recorded along with it.
+func (p *ActivityShorcutViewPayload) getUserAgent() analytics.UserAgent {
+	return analytics.RecordedUserAgent(p.UserAgent, p.Browser, p.OS, p.DeviceType)
 }
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// activityStreamPollInterval is the interval at which a followed activity stream queries the new views.
+	activityStreamPollInterval = time.Second
+	// activityStreamMaxFollow is the longest a client can follow an activity stream.
+	activityStreamMaxFollow = 5 * time.Minute
+)
+
+// ShortcutActivityRecord is a view of a shortcut, as a line of the JSON Lines activity stream.
+type ShortcutActivityRecord struct {
+	ID         int32  `json:"id"`
+	ShortcutID int32  `json:"shortcutId"`
+	CreatedTs  int64  `json:"createdTs"`
+	IP         string `json:"ip"`
+	Referer    string `json:"referer"`
+	UserAgent  string `json:"userAgent"`
+}
+
+// streamShortcutActivities streams the views of a shortcut as JSON Lines, one record per line as they are
+// queried, for log processors. With the follow query param, a duration, the new views are streamed too
+// until it elapses or the client disconnects.
+func (s *APIV1Service) streamShortcutActivities(c echo.Context) error {
+	ctx := c.Request().Context()
+	shortcutID, err := strconv.Atoi(c.Param("shortcutId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("shortcut id is not a number: %s", c.Param("shortcutId"))).SetInternal(err)
+	}
+	var follow time.Duration
+	if value := c.QueryParam("follow"); value != "" {
+		follow, err = time.ParseDuration(value)
+		if err != nil || follow < 0 || follow > activityStreamMaxFollow {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("follow must be a duration up to %s", activityStreamMaxFollow))
+		}
+	}
+	shortcut, currentUser, err := s.findAnalyticsShortcut(c, int32(shortcutID))
+	if err != nil {
+		return err
+	}
+	if shortcut.CreatorId != currentUser.ID && currentUser.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "only the creator and admins can stream shortcut activities")
+	}
+	hashIP, err := s.getVisitorIPHasher(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get visitor ip hasher, err: %s", err)).SetInternal(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(c.Response())
+	find := &store.FindActivity{
+		Type:  store.ActivityShortcutView,
+		Where: []string{fmt.Sprintf("json_extract(payload, '$.shortcutId') = %d", shortcut.Id)},
+	}
+	var lastID int32
+	count := 0
+	writeActivity := func(activity *store.Activity) error {
+		payload := &ActivityShorcutViewPayload{}
+		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return errors.Wrap(err, "failed to unmarshal payload")
+		}
+		if err := encoder.Encode(&ShortcutActivityRecord{
+			ID:         activity.ID,
+			ShortcutID: payload.ShortcutID,
+			CreatedTs:  activity.CreatedTs,
+			IP:         hashIP(payload.IP),
+			Referer:    payload.Referer,
+			UserAgent:  payload.UserAgent,
+		}); err != nil {
+			return err
+		}
+		// Views are listed by creation time, which imported views may not follow the ids of.
+		lastID = max(lastID, activity.ID)
+		count++
+		return nil
+	}
+
+	err = s.Store.StreamActivities(ctx, find, writeActivity)
+	c.Response().Flush()
+	if err == nil && follow > 0 {
+		// The write timeout of the server would cut the stream before it is done following.
+		_ = http.NewResponseController(c.Response().Writer).SetWriteDeadline(time.Now().Add(follow + activityStreamPollInterval))
+		ticker := time.NewTicker(activityStreamPollInterval)
+		defer ticker.Stop()
+		timer := time.NewTimer(follow)
+		defer timer.Stop()
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+				break loop
+			case <-timer.C:
+				break loop
+			case <-ticker.C:
+				find.IDAfter = &lastID
+				if err = s.Store.StreamActivities(ctx, find, writeActivity); err != nil {
+					break loop
+				}
+				c.Response().Flush()
+			}
+		}
+	}
+	if err != nil {
+		// The client disconnected, which is how followed streams usually end.
+		if ctx.Err() != nil {
+			slog.InfoContext(ctx, "shortcut activity stream cancelled", "shortcut", shortcut.Name, "rows", count)
+			return nil
+		}
+		// The response is already on its way, so the error can only be logged.
+		return errors.Wrap(err, "failed to stream shortcut activities")
+	}
+	metric.Enqueue("shortcut activity stream")
+	return nil
+}
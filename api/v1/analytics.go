@@ -1,15 +1,22 @@
 package v1
 
 import (
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/mssola/useragent"
+	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 
+	"github.com/yourselfhosted/slash/internal/analytics"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/store"
 )
@@ -29,10 +36,40 @@ type BrowserInfo struct {
 	Count int    `json:"count"`
 }
 
+// ShortcutViewInfo is the number of views of a shortcut.
+type ShortcutViewInfo struct {
+	ShortcutID   int32  `json:"shortcutId"`
+	Name         string `json:"name"`
+	Count        int    `json:"count"`
+	LastViewedTs int64  `json:"lastViewedTs"`
+}
+
+// UserActivity is the recent activity of the shortcuts of a user.
+type UserActivity struct {
+	// Days is the number of days the activity covers.
+	Days           int                `json:"days"`
+	TotalViews     int                `json:"totalViews"`
+	ShortcutViews  []ShortcutViewInfo `json:"shortcutViews"`
+	RecentReferers []ReferenceInfo    `json:"recentReferers"`
+}
+
+const (
+	// userActivityMaxDays is the max number of days of user activity.
+	userActivityMaxDays = 90
+	// userActivityMaxReferers is the max number of recent referers of user activity.
+	userActivityMaxReferers = 10
+)
+
 type AnalysisData struct {
-	ReferenceData []ReferenceInfo `json:"referenceData"`
-	DeviceData    []DeviceInfo    `json:"deviceData"`
-	BrowserData   []BrowserInfo   `json:"browserData"`
+	ReferenceData  []ReferenceInfo `json:"referenceData"`
+	DeviceData     []DeviceInfo    `json:"deviceData"`
+	BrowserData    []BrowserInfo   `json:"browserData"`
+	TotalViews     int             `json:"totalViews"`
+	UniqueVisitors int             `json:"uniqueVisitors"`
+	// Conversions is the number of conversions of the goal of the shortcut, and ConversionRate
+	// the conversions per view.
+	Conversions    int     `json:"conversions"`
+	ConversionRate float64 `json:"conversionRate"`
 }
 
 func (s *APIV1Service) registerAnalyticsRoutes(g *echo.Group) {
@@ -50,23 +87,50 @@ func (s *APIV1Service) registerAnalyticsRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get activities, err: %s", err)).SetInternal(err)
 		}
 
+		// The conversions of the goals the shortcut had before are not the current goal's.
+		id := int32(shortcutID)
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &id,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+		}
+		goal := shortcut.GetGoal()
+		conversions, err := s.Store.ListActivities(ctx, &store.FindActivity{
+			Type:  store.ActivityShortcutConversion,
+			Where: []string{fmt.Sprintf("json_extract(payload, '$.shortcutId') = %d", shortcutID)},
+			Goal:  &goal,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get activities, err: %s", err)).SetInternal(err)
+		}
+
+		uniqueVisitorWindowSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find workspace setting, err: %s", err)).SetInternal(err)
+		}
+
 		referenceMap := make(map[string]int)
 		deviceMap := make(map[string]int)
 		browserMap := make(map[string]int)
+		views := []analytics.View{}
 		for _, activity := range activities {
 			payload := &ActivityShorcutViewPayload{}
 			if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal payload, err: %s", err)).SetInternal(err)
 			}
+			views = append(views, analytics.View{IP: payload.IP, CreatedTs: activity.CreatedTs})
 
 			if _, ok := referenceMap[payload.Referer]; !ok {
 				referenceMap[payload.Referer] = 0
 			}
 			referenceMap[payload.Referer]++
 
-			ua := useragent.New(payload.UserAgent)
-			deviceName := ua.OSInfo().Name
-			browserName, _ := ua.Browser()
+			ua := payload.getUserAgent()
+			deviceName := ua.OS
+			browserName := ua.Browser
 
 			if _, ok := deviceMap[deviceName]; !ok {
 				deviceMap[deviceName] = 0
@@ -81,11 +145,345 @@ func (s *APIV1Service) registerAnalyticsRoutes(g *echo.Group) {
 
 		metric.Enqueue("shortcut analytics")
 		return c.JSON(http.StatusOK, &AnalysisData{
-			ReferenceData: mapToReferenceInfoSlice(referenceMap),
-			DeviceData:    mapToDeviceInfoSlice(deviceMap),
-			BrowserData:   mapToBrowserInfoSlice(browserMap),
+			ReferenceData:  mapToReferenceInfoSlice(referenceMap),
+			DeviceData:     mapToDeviceInfoSlice(deviceMap),
+			BrowserData:    mapToBrowserInfoSlice(browserMap),
+			TotalViews:     len(views),
+			UniqueVisitors: analytics.CountUniqueVisitors(views, uniqueVisitorWindowSetting.GetUniqueVisitorWindow()),
+			Conversions:    len(conversions),
+			ConversionRate: getConversionRate(len(conversions), len(views)),
 		})
 	})
+
+	g.GET("/shortcuts/:shortcutId/analytics\\:export", s.exportShortcutAnalytics)
+	g.GET("/shortcuts/:shortcutId/activities\\:stream", s.streamShortcutActivities)
+
+	g.GET("/me/activity", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+		}
+		days := 1
+		if value := c.QueryParam("days"); value != "" {
+			var err error
+			days, err = strconv.Atoi(value)
+			if err != nil || days < 1 || days > userActivityMaxDays {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("days must be a number between 1 and %d", userActivityMaxDays))
+			}
+		}
+
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+			CreatorID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list shortcuts, err: %s", err)).SetInternal(err)
+		}
+		shortcutViewMap := map[int32]*ShortcutViewInfo{}
+		for _, shortcut := range shortcuts {
+			shortcutViewMap[shortcut.Id] = &ShortcutViewInfo{
+				ShortcutID: shortcut.Id,
+				Name:       shortcut.Name,
+			}
+		}
+
+		createdTsAfter := time.Now().AddDate(0, 0, -days).Unix()
+		totalViews := 0
+		refererMap := map[string]int{}
+		if err := s.Store.StreamActivities(ctx, &store.FindActivity{
+			Type:              store.ActivityShortcutView,
+			CreatedTsAfter:    &createdTsAfter,
+			ShortcutCreatorID: &userID,
+		}, func(activity *store.Activity) error {
+			payload := &ActivityShorcutViewPayload{}
+			if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+				return errors.Wrap(err, "failed to unmarshal payload")
+			}
+			shortcutView, ok := shortcutViewMap[payload.ShortcutID]
+			if !ok {
+				return nil
+			}
+			shortcutView.Count++
+			shortcutView.LastViewedTs = activity.CreatedTs
+			totalViews++
+			if payload.Referer != "" {
+				refererMap[payload.Referer]++
+			}
+			return nil
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list activities, err: %s", err)).SetInternal(err)
+		}
+
+		shortcutViews := []ShortcutViewInfo{}
+		for _, shortcutView := range shortcutViewMap {
+			if shortcutView.Count > 0 {
+				shortcutViews = append(shortcutViews, *shortcutView)
+			}
+		}
+		slices.SortFunc(shortcutViews, func(i, j ShortcutViewInfo) int {
+			if i.Count != j.Count {
+				return j.Count - i.Count
+			}
+			return int(i.ShortcutID - j.ShortcutID)
+		})
+		recentReferers := mapToReferenceInfoSlice(refererMap)
+		slices.SortFunc(recentReferers, func(i, j ReferenceInfo) int {
+			if i.Count != j.Count {
+				return j.Count - i.Count
+			}
+			return strings.Compare(i.Name, j.Name)
+		})
+		if len(recentReferers) > userActivityMaxReferers {
+			recentReferers = recentReferers[:userActivityMaxReferers]
+		}
+
+		return c.JSON(http.StatusOK, &UserActivity{
+			Days:           days,
+			TotalViews:     totalViews,
+			ShortcutViews:  shortcutViews,
+			RecentReferers: recentReferers,
+		})
+	})
+}
+
+// exportShortcutAnalytics streams the views of a shortcut within the from and to dates as CSV,
+// aggregated per day, or as raw events for admins with the raw=true query param.
+func (s *APIV1Service) exportShortcutAnalytics(c echo.Context) error {
+	ctx := c.Request().Context()
+	shortcutID, err := strconv.Atoi(c.Param("shortcutId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("shortcut id is not a number: %s", c.Param("shortcutId"))).SetInternal(err)
+	}
+	if format := c.QueryParam("format"); format != "" && format != "csv" {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported export format: %s", format))
+	}
+	raw := false
+	if value := c.QueryParam("raw"); value != "" {
+		raw, err = strconv.ParseBool(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid raw: %s", value)).SetInternal(err)
+		}
+	}
+	find := &store.FindActivity{
+		Type:  store.ActivityShortcutView,
+		Where: []string{fmt.Sprintf("json_extract(payload, '$.shortcutId') = %d", shortcutID)},
+	}
+	if value := c.QueryParam("from"); value != "" {
+		from, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid from date: %s", value)).SetInternal(err)
+		}
+		createdTsAfter := from.Unix()
+		find.CreatedTsAfter = &createdTsAfter
+	}
+	if value := c.QueryParam("to"); value != "" {
+		to, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid to date: %s", value)).SetInternal(err)
+		}
+		// The to date is inclusive.
+		createdTsBefore := to.AddDate(0, 0, 1).Unix()
+		find.CreatedTsBefore = &createdTsBefore
+	}
+	if find.CreatedTsAfter != nil && find.CreatedTsBefore != nil && *find.CreatedTsAfter >= *find.CreatedTsBefore {
+		return echo.NewHTTPError(http.StatusBadRequest, "from date must not be after to date")
+	}
+
+	shortcut, currentUser, err := s.findAnalyticsShortcut(c, int32(shortcutID))
+	if err != nil {
+		return err
+	}
+	if shortcut.CreatorId != currentUser.ID && currentUser.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "only the creator and admins can export shortcut analytics")
+	}
+	if raw && currentUser.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "only admins can export raw shortcut analytics")
+	}
+
+	hashIP, err := s.getVisitorIPHasher(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get visitor ip hasher, err: %s", err)).SetInternal(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s-analytics.csv"`, shortcut.Name))
+	c.Response().WriteHeader(http.StatusOK)
+	writer := csv.NewWriter(&flushingWriter{response: c.Response()})
+	var rowCount int
+	if raw {
+		rowCount, err = writeRawAnalyticsCSV(ctx, s.Store, find, hashIP, writer)
+	} else {
+		rowCount, err = writeDailyAnalyticsCSV(ctx, s.Store, find, writer)
+	}
+	if err != nil {
+		// The client gave up on the export, which stopped reading the activities.
+		if ctx.Err() != nil {
+			slog.InfoContext(ctx, "shortcut analytics export cancelled", "shortcut", shortcut.Name, "rows", rowCount)
+			return nil
+		}
+		// The response is already on its way, so the error can only be logged.
+		return errors.Wrap(err, "failed to export shortcut analytics")
+	}
+	metric.Enqueue("shortcut analytics export")
+	return nil
+}
+
+// flushingWriter writes to the response and flushes it, so that every flush of the CSV writer reaches
+// the client instead of the whole export at its end.
+type flushingWriter struct {
+	response *echo.Response
+}
+
+func (w *flushingWriter) Write(p []byte) (int, error) {
+	n, err := w.response.Write(p)
+	if err != nil {
+		return n, err
+	}
+	w.response.Flush()
+	return n, nil
+}
+
+// findAnalyticsShortcut returns the shortcut whose analytics are requested and the current user.
+func (s *APIV1Service) findAnalyticsShortcut(c echo.Context, shortcutID int32) (*storepb.Shortcut, *store.User, error) {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	if currentUser == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+	}
+	if shortcut == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+	}
+	return shortcut, currentUser, nil
+}
+
+// writeDailyAnalyticsCSV writes the views and unique visitors of every day with views,
+// and returns the number of days written.
+func writeDailyAnalyticsCSV(ctx context.Context, s *store.Store, find *store.FindActivity, writer *csv.Writer) (int, error) {
+	if err := writer.Write([]string{"date", "views", "unique_visitors"}); err != nil {
+		return 0, err
+	}
+	dayCount := 0
+	day, views, visitors := "", 0, map[string]bool{}
+	writeDay := func() error {
+		if day == "" {
+			return nil
+		}
+		if err := writer.Write([]string{day, strconv.Itoa(views), strconv.Itoa(len(visitors))}); err != nil {
+			return err
+		}
+		dayCount++
+		writer.Flush()
+		return writer.Error()
+	}
+	if err := s.StreamActivities(ctx, find, func(activity *store.Activity) error {
+		payload := &ActivityShorcutViewPayload{}
+		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return errors.Wrap(err, "failed to unmarshal payload")
+		}
+		activityDay := time.Unix(activity.CreatedTs, 0).UTC().Format(time.DateOnly)
+		if activityDay != day {
+			if err := writeDay(); err != nil {
+				return err
+			}
+			day, views, visitors = activityDay, 0, map[string]bool{}
+		}
+		views++
+		visitors[payload.IP] = true
+		return nil
+	}); err != nil {
+		return dayCount, err
+	}
+	if err := writeDay(); err != nil {
+		return dayCount, err
+	}
+	writer.Flush()
+	return dayCount, writer.Error()
+}
+
+// writeRawAnalyticsCSV writes every view, with the IPs hashed by hashIP, and returns the number of views written.
+func writeRawAnalyticsCSV(ctx context.Context, s *store.Store, find *store.FindActivity, hashIP func(string) string, writer *csv.Writer) (int, error) {
+	if err := writer.Write([]string{"id", "created_at", "ip", "referer", "user_agent"}); err != nil {
+		return 0, err
+	}
+	count := 0
+	if err := s.StreamActivities(ctx, find, func(activity *store.Activity) error {
+		payload := &ActivityShorcutViewPayload{}
+		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return errors.Wrap(err, "failed to unmarshal payload")
+		}
+		if err := writer.Write([]string{
+			strconv.Itoa(int(activity.ID)),
+			time.Unix(activity.CreatedTs, 0).UTC().Format(time.RFC3339),
+			hashIP(payload.IP),
+			payload.Referer,
+			payload.UserAgent,
+		}); err != nil {
+			return err
+		}
+		count++
+		if count%100 == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	}); err != nil {
+		return count, err
+	}
+	writer.Flush()
+	return count, writer.Error()
+}
+
+// getVisitorIPHasher returns the function recording visitor IPs, which hashes them
+// with the workspace secret when the workspace hashes visitor IPs.
+func (s *APIV1Service) getVisitorIPHasher(ctx context.Context) (func(string) string, error) {
+	hashVisitorIPsSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+	if !hashVisitorIPsSetting.GetHashVisitorIps() {
+		return func(ip string) string {
+			return ip
+		}, nil
+	}
+	secretSessionSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+	key := secretSessionSetting.GetSecretSession()
+	return func(ip string) string {
+		return analytics.HashIP(key, ip)
+	}, nil
+}
+
+// isVisitorAnonymized returns whether the workspace leaves the IPs and user agents of visitors out of
+// the activities and the redirect log, which is stricter than hashing the IPs.
+func (s *APIV1Service) isVisitorAnonymized(ctx context.Context) (bool, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get workspace setting")
+	}
+	return workspaceSetting.GetAnonymizeVisitors(), nil
 }
 
 func mapToReferenceInfoSlice(m map[string]int) []ReferenceInfo {
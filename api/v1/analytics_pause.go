@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// analyticsFlushBatchSize is the number of buffered views recorded at a time when analytics resume.
+const analyticsFlushBatchSize = 100
+
+// AnalyticsPause is the state of the analytics pause, during which the views are buffered in memory
+// instead of being recorded, until analytics resume.
+type AnalyticsPause struct {
+	Paused bool `json:"paused"`
+	// Buffered is the number of views waiting to be recorded.
+	Buffered int `json:"buffered"`
+	// Dropped is the number of views dropped since the server started, as the buffer was full.
+	Dropped int64 `json:"dropped"`
+}
+
+// analyticsPause buffers the view activities, and the last view times of the shortcuts, while analytics are paused.
+type analyticsPause struct {
+	maxBuffered int
+
+	mu       sync.Mutex
+	paused   bool
+	buffered []*store.Activity
+	dropped  int64
+	// lastViewed is the last view time of the shortcuts viewed while paused, by shortcut id.
+	lastViewed map[int32]int64
+}
+
+func newAnalyticsPause(maxBuffered int) *analyticsPause {
+	return &analyticsPause{
+		maxBuffered: maxBuffered,
+		lastViewed:  map[int32]int64{},
+	}
+}
+
+// add buffers the activity and returns true while analytics are paused, dropping it when the buffer is full.
+func (p *analyticsPause) add(activity *store.Activity) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.paused {
+		return false
+	}
+	if len(p.buffered) >= p.maxBuffered {
+		if p.dropped == 0 {
+			slog.Warn("analytics pause buffer is full, dropping views", "max", p.maxBuffered)
+		}
+		p.dropped++
+		return true
+	}
+	if activity.CreatedTs == 0 {
+		activity.CreatedTs = time.Now().Unix()
+	}
+	p.buffered = append(p.buffered, activity)
+	return true
+}
+
+// addLastView buffers the last view time of the shortcut and returns true while analytics are paused.
+// Only the latest view of each shortcut is kept, so that the buffer is bounded by the shortcuts.
+func (p *analyticsPause) addLastView(shortcutID int32, lastViewedTs int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.paused {
+		return false
+	}
+	p.lastViewed[shortcutID] = max(p.lastViewed[shortcutID], lastViewedTs)
+	return true
+}
+
+func (p *analyticsPause) pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.paused = true
+}
+
+// next returns the next batch of buffered activities with the buffered last view times, or unpauses
+// when there are none left, so that the views buffered while the previous batches are recorded are
+// recorded too.
+func (p *analyticsPause) next() ([]*store.Activity, map[int32]int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := min(len(p.buffered), analyticsFlushBatchSize)
+	if n == 0 && len(p.lastViewed) == 0 {
+		p.paused = false
+		return nil, nil
+	}
+	batch := p.buffered[:n:n]
+	p.buffered = p.buffered[n:]
+	lastViewed := p.lastViewed
+	p.lastViewed = map[int32]int64{}
+	return batch, lastViewed
+}
+
+// requeue puts the activities and the last view times that could not be recorded back in the buffer,
+// the activities at its front.
+func (p *analyticsPause) requeue(activities []*store.Activity, lastViewed map[int32]int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.buffered = append(activities, p.buffered...)
+	for shortcutID, lastViewedTs := range lastViewed {
+		p.lastViewed[shortcutID] = max(p.lastViewed[shortcutID], lastViewedTs)
+	}
+}
+
+func (p *analyticsPause) status() *AnalyticsPause {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &AnalyticsPause{
+		Paused:   p.paused,
+		Buffered: len(p.buffered),
+		Dropped:  p.dropped,
+	}
+}
+
+// createActivity records the activity, or buffers it while analytics are paused.
+func (s *APIV1Service) createActivity(ctx context.Context, activity *store.Activity) error {
+	if s.analyticsPause.add(activity) {
+		return nil
+	}
+	_, err := s.Store.CreateActivity(ctx, activity)
+	return err
+}
+
+// resumeAnalytics records the buffered activities and last view times, and unpauses analytics.
+// Analytics stay paused when one cannot be recorded, with it and the ones after it still buffered.
+func (s *APIV1Service) resumeAnalytics(ctx context.Context) error {
+	for {
+		batch, lastViewed := s.analyticsPause.next()
+		if len(batch) == 0 && len(lastViewed) == 0 {
+			return nil
+		}
+		for i, activity := range batch {
+			if _, err := s.Store.CreateActivity(ctx, activity); err != nil {
+				s.analyticsPause.requeue(batch[i:], lastViewed)
+				return err
+			}
+		}
+		for shortcutID, lastViewedTs := range lastViewed {
+			// The last view times recorded again are left as they are, being never moved back.
+			if err := s.Store.UpdateShortcutLastViewedTs(ctx, shortcutID, lastViewedTs); err != nil {
+				s.analyticsPause.requeue(nil, lastViewed)
+				return err
+			}
+		}
+	}
+}
+
+func (s *APIV1Service) registerAnalyticsPauseRoutes(g *echo.Group) {
+	g.GET("/workspace/analytics/pause", func(c echo.Context) error {
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, s.analyticsPause.status())
+	})
+
+	g.POST("/workspace/analytics/pause", func(c echo.Context) error {
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+		s.analyticsPause.pause()
+		return c.JSON(http.StatusOK, s.analyticsPause.status())
+	})
+
+	g.DELETE("/workspace/analytics/pause", func(c echo.Context) error {
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+		if err := s.resumeAnalytics(c.Request().Context()); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to record buffered views, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, s.analyticsPause.status())
+	})
+}
@@ -1,15 +1,13 @@
 package v1
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/yourselfhosted/slash/api/auth"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
@@ -49,16 +47,25 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group, secret string) {
 			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("user has been archived with email %s", signin.Email))
 		}
 
-		// Compare the stored hashed password, with the hashed version of the password that was received.
-		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(signin.Password)); err != nil {
+		matched, needsRehash, err := s.Store.VerifyPassword(ctx, user.ID, signin.Password)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to verify password, err: %s", err)).SetInternal(err)
+		}
+		if !matched {
 			return echo.NewHTTPError(http.StatusUnauthorized, "unmatched email and password")
 		}
+		if needsRehash {
+			// The sign in succeeds even if the password can't be rehashed.
+			if err := s.Store.RehashPassword(ctx, user.ID, signin.Password); err != nil {
+				slog.WarnContext(ctx, "failed to rehash password", "user", user.ID, "error", err)
+			}
+		}
 
 		accessToken, err := auth.GenerateAccessToken(user.Email, user.ID, time.Now().Add(auth.AccessTokenDuration), []byte(secret))
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate tokens, err: %s", err)).SetInternal(err)
 		}
-		if err := s.UpsertAccessTokenToStore(ctx, user, accessToken); err != nil {
+		if err := s.UpsertAccessTokenToStore(c, user, accessToken); err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert access token, err: %s", err)).SetInternal(err)
 		}
 
@@ -95,7 +102,7 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group, secret string) {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("malformatted signup request, err: %s", err)).SetInternal(err)
 		}
 
-		passwordHash, err := bcrypt.GenerateFromPassword([]byte(signup.Password), bcrypt.DefaultCost)
+		passwordHash, err := store.HashPassword(signup.Password)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
 		}
@@ -103,7 +110,7 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group, secret string) {
 		create := &store.User{
 			Email:        signup.Email,
 			Nickname:     signup.Nickname,
-			PasswordHash: string(passwordHash),
+			PasswordHash: passwordHash,
 		}
 		existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{})
 		if err != nil {
@@ -125,7 +132,7 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group, secret string) {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate tokens, err: %s", err)).SetInternal(err)
 		}
-		if err := s.UpsertAccessTokenToStore(ctx, user, accessToken); err != nil {
+		if err := s.UpsertAccessTokenToStore(c, user, accessToken); err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert access token, err: %s", err)).SetInternal(err)
 		}
 
@@ -140,51 +147,35 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group, secret string) {
 		RemoveTokensAndCookies(c)
 		accessToken := findAccessToken(c)
 		userID, _ := getUserIDFromAccessToken(accessToken, secret)
-		userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, userID)
 		// Auto remove the current access token from the user access tokens.
-		if err == nil && len(userAccessTokens) != 0 {
+		if err := s.Store.UpdateUserAccessTokens(ctx, userID, func(userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
 			accessTokens := []*storepb.AccessTokensUserSetting_AccessToken{}
 			for _, userAccessToken := range userAccessTokens {
 				if accessToken != userAccessToken.AccessToken {
 					accessTokens = append(accessTokens, userAccessToken)
 				}
 			}
-
-			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-				UserId: userID,
-				Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-				Value: &storepb.UserSetting_AccessTokens{
-					AccessTokens: &storepb.AccessTokensUserSetting{
-						AccessTokens: accessTokens,
-					},
-				},
-			}); err != nil {
-				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
-			}
+			return accessTokens
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
 		}
 		c.Response().WriteHeader(http.StatusOK)
 		return nil
 	})
 }
 
-func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
-	}
-	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
+// UpsertAccessTokenToStore records the access token of a sign in of the user, along with the user agent
+// and the IP of the request, which are shown in the sessions of the user.
+func (s *APIV1Service) UpsertAccessTokenToStore(c echo.Context, user *store.User, accessToken string) error {
+	userAccessToken := &storepb.AccessTokensUserSetting_AccessToken{
 		AccessToken: accessToken,
 		Description: "Account sign in",
+		CreatedTs:   time.Now().Unix(),
+		UserAgent:   c.Request().UserAgent(),
+		Ip:          c.RealIP(),
 	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: userAccessTokens,
-			},
-		},
+	if err := s.Store.UpdateUserAccessTokens(c.Request().Context(), user.ID, func(userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+		return append(userAccessTokens, userAccessToken)
 	}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
 	}
@@ -1,5 +1,22 @@
 package v1
 
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// mimeApplicationProtobuf is the content type of the serialized protobuf responses.
+const mimeApplicationProtobuf = "application/x-protobuf"
+
 // RowStatus is the status for a row.
 type RowStatus string
 
@@ -13,3 +30,58 @@ const (
 func (s RowStatus) String() string {
 	return string(s)
 }
+
+// ValidationError is the body of the 400 responses to invalid requests,
+// with the error of each invalid field keyed by its JSON name.
+type ValidationError = store.ValidationError
+
+// newValidationError returns the validation error of the invalid fields of the request.
+func newValidationError(message string, fields map[string]string) *ValidationError {
+	return store.NewValidationError(message, fields)
+}
+
+// newDecodeError returns the validation error of a request body that is not valid JSON,
+// with the field of the wrong type when there is one.
+func newDecodeError(message string, err error) *ValidationError {
+	fields := map[string]string{}
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) && typeError.Field != "" {
+		fields[typeError.Field] = fmt.Sprintf("must not be a %s", typeError.Value)
+	}
+	validationErr := newValidationError(fmt.Sprintf("%s, err: %s", message, err), fields)
+	validationErr.Err = err
+	return validationErr
+}
+
+// isValidationError returns whether the error is a validation error, which the handlers return as is.
+func isValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
+
+func isValidRowStatus(rowStatus RowStatus) bool {
+	return rowStatus == Normal || rowStatus == Archived
+}
+
+// acceptsProtobuf returns whether the request accepts a serialized protobuf response, which
+// clients ask for by listing its content type in the Accept header. The response varies on the
+// header either way, so that caches keep the JSON and protobuf responses apart.
+func acceptsProtobuf(c echo.Context) bool {
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	for _, accept := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == mimeApplicationProtobuf && params["q"] != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// respondProtobuf responds with the serialized message.
+func respondProtobuf(c echo.Context, code int, message proto.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to marshal protobuf response, err: %s", err)).SetInternal(err)
+	}
+	return c.Blob(code, mimeApplicationProtobuf, data)
+}
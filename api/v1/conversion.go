@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// conversionTokenParam is the query param of the token authenticating the conversions of a shortcut.
+const conversionTokenParam = "token"
+
+// ShortcutConversion is the goal of a shortcut and the token its conversion pixel reports back with.
+type ShortcutConversion struct {
+	Goal  string `json:"goal"`
+	Token string `json:"token"`
+}
+
+func (s *APIV1Service) registerConversionRoutes(g *echo.Group, secret string) {
+	g.GET("/shortcuts/:shortcutId/conversion", func(c echo.Context) error {
+		shortcut, currentUser, err := s.findCollaboratorShortcut(c)
+		if err != nil {
+			return err
+		}
+		canEdit, err := s.canEditShortcut(c.Request().Context(), shortcut, currentUser)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to check shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		if !canEdit {
+			return echo.NewHTTPError(http.StatusForbidden, "unauthorized to get shortcut conversion")
+		}
+		if shortcut.Goal == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "shortcut has no goal")
+		}
+		return c.JSON(http.StatusOK, &ShortcutConversion{
+			Goal:  shortcut.Goal,
+			Token: getConversionToken(secret, shortcut.Id, shortcut.Goal),
+		})
+	})
+
+	// The conversions are reported by pixels without a session, which authenticate with the token of the shortcut.
+	g.POST("/shortcuts/:shortcutId/conversion", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		shortcutID, err := util.ConvertStringToInt32(c.Param("shortcutId"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("shortcut ID is not a number: %s", c.Param("shortcutId"))).SetInternal(err)
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &shortcutID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+		}
+		// The shortcuts without a goal are answered as the invalid tokens, so that they cannot be probed.
+		token := c.QueryParam(conversionTokenParam)
+		if shortcut == nil || shortcut.Goal == "" || !hmac.Equal([]byte(token), []byte(getConversionToken(secret, shortcut.Id, shortcut.Goal))) {
+			return echo.NewHTTPError(http.StatusForbidden, "invalid conversion token")
+		}
+		if shortcut.DisableAnalytics {
+			return c.JSON(http.StatusOK, true)
+		}
+
+		hashIP, err := s.getVisitorIPHasher(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get visitor IP hasher, err: %s", err)).SetInternal(err)
+		}
+		anonymized, err := s.isVisitorAnonymized(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+		conversion := &ActivityShortcutConversionPayload{
+			ShortcutID: shortcut.Id,
+			Goal:       shortcut.Goal,
+		}
+		if !anonymized {
+			conversion.IP = hashIP(c.RealIP())
+		}
+		payload, err := json.Marshal(conversion)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to marshal activity payload, err: %s", err)).SetInternal(err)
+		}
+		if err := s.createActivity(ctx, &store.Activity{
+			CreatorID: BotID,
+			Type:      store.ActivityShortcutConversion,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create activity, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, true)
+	})
+}
+
+// getConversionToken returns the token of the conversions of the goal of the shortcut, signed with
+// the secret of the server. Changing the goal of a shortcut changes its token.
+func getConversionToken(secret string, shortcutID int32, goal string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "conversion:%d:%s", shortcutID, goal)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getConversionRate returns the conversions per view, zero without views.
+func getConversionRate(conversions, views int) float64 {
+	if views == 0 {
+		return 0
+	}
+	return float64(conversions) / float64(views)
+}
@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// deadLinkReportTimeout bounds how long a webhook has to accept the dead link report.
+const deadLinkReportTimeout = 10 * time.Second
+
+// DeadLinkReport is the summary of the shortcuts with links that failed the last target health
+// check, grouped by their creator.
+type DeadLinkReport struct {
+	// CheckedTs is when the health check the report is about ran.
+	CheckedTs int64                  `json:"checkedTs"`
+	Owners    []*DeadLinkReportOwner `json:"owners"`
+	// Text is the report in plain text, which chat webhooks display as the message.
+	Text string `json:"text"`
+}
+
+// DeadLinkReportOwner is the shortcuts of a creator in the dead link report.
+type DeadLinkReportOwner struct {
+	CreatorID int32                     `json:"creatorId"`
+	Email     string                    `json:"email"`
+	Nickname  string                    `json:"nickname"`
+	Shortcuts []*DeadLinkReportShortcut `json:"shortcuts"`
+}
+
+// DeadLinkReportShortcut is a shortcut of the dead link report, with its links that failed the check.
+type DeadLinkReportShortcut struct {
+	ID    int32    `json:"id"`
+	Name  string   `json:"name"`
+	Links []string `json:"links"`
+}
+
+// GenerateDeadLinkReport returns the report of the live shortcuts with links that failed the last
+// target health check, owners ordered by id and their shortcuts by name. Only the shortcuts with
+// targets are health checked, so they are the only ones reported.
+func (s *APIV1Service) GenerateDeadLinkReport(ctx context.Context) (*DeadLinkReport, error) {
+	unhealthy, checkedTs := s.targetResolver.getUnhealthy()
+	report := &DeadLinkReport{
+		CheckedTs: checkedTs,
+		Owners:    []*DeadLinkReportOwner{},
+	}
+	if len(unhealthy) > 0 {
+		normalStatus := store.Normal
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+			RowStatus: &normalStatus,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list shortcuts")
+		}
+		owners := map[int32]*DeadLinkReportOwner{}
+		for _, shortcut := range shortcuts {
+			if len(shortcut.Targets.GetLinks()) == 0 {
+				continue
+			}
+			links := []string{}
+			for _, link := range targetLinks(shortcut) {
+				if unhealthy[link] && !slices.Contains(links, link) {
+					links = append(links, link)
+				}
+			}
+			if len(links) == 0 {
+				continue
+			}
+			owner, ok := owners[shortcut.CreatorId]
+			if !ok {
+				user, err := s.Store.GetUser(ctx, &store.FindUser{
+					ID: &shortcut.CreatorId,
+				})
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to get user")
+				}
+				owner = &DeadLinkReportOwner{
+					CreatorID: shortcut.CreatorId,
+					Shortcuts: []*DeadLinkReportShortcut{},
+				}
+				// The shortcuts of deleted users are still reported, without an owner to reach out to.
+				if user != nil {
+					owner.Email, owner.Nickname = user.Email, user.Nickname
+				}
+				owners[shortcut.CreatorId] = owner
+				report.Owners = append(report.Owners, owner)
+			}
+			owner.Shortcuts = append(owner.Shortcuts, &DeadLinkReportShortcut{
+				ID:    shortcut.Id,
+				Name:  shortcut.Name,
+				Links: links,
+			})
+		}
+		slices.SortFunc(report.Owners, func(a, b *DeadLinkReportOwner) int {
+			return int(a.CreatorID - b.CreatorID)
+		})
+		for _, owner := range report.Owners {
+			slices.SortFunc(owner.Shortcuts, func(a, b *DeadLinkReportShortcut) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+		}
+	}
+	report.Text = formatDeadLinkReport(report)
+	return report, nil
+}
+
+// SendDeadLinkReport posts the dead link report as JSON to each webhook of the profile. Nothing is
+// sent before the first health check or when no link failed it. It returns the first failed
+// delivery, after trying all the webhooks.
+func (s *APIV1Service) SendDeadLinkReport(ctx context.Context) error {
+	if len(s.Profile.DeadLinkReportWebhooks) == 0 {
+		return nil
+	}
+	report, err := s.GenerateDeadLinkReport(ctx)
+	if err != nil {
+		return err
+	}
+	if report.CheckedTs == 0 || len(report.Owners) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(report)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal dead link report")
+	}
+
+	// The webhooks are set by the operator, so unlike the links of shortcuts they may be internal.
+	client := &http.Client{Timeout: deadLinkReportTimeout}
+	var deliveryErr error
+	for _, webhook := range s.Profile.DeadLinkReportWebhooks {
+		if err := postDeadLinkReport(ctx, client, webhook, body); err != nil && deliveryErr == nil {
+			deliveryErr = errors.Wrapf(err, "failed to post dead link report to %s", webhook)
+		}
+	}
+	if deliveryErr != nil {
+		return deliveryErr
+	}
+	metric.Enqueue("dead link report sent")
+	return nil
+}
+
+func postDeadLinkReport(ctx context.Context, client *http.Client, webhook string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// formatDeadLinkReport returns the report as plain text, a line per shortcut under its owner.
+func formatDeadLinkReport(report *DeadLinkReport) string {
+	count := 0
+	for _, owner := range report.Owners {
+		count += len(owner.Shortcuts)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%d shortcuts have links that failed the health check", count)
+	if report.CheckedTs != 0 {
+		fmt.Fprintf(&builder, " of %s", time.Unix(report.CheckedTs, 0).UTC().Format(time.RFC3339))
+	}
+	builder.WriteString(".\n")
+	for _, owner := range report.Owners {
+		name := owner.Email
+		if name == "" {
+			name = fmt.Sprintf("deleted user %d", owner.CreatorID)
+		}
+		fmt.Fprintf(&builder, "\n%s:\n", name)
+		for _, shortcut := range owner.Shortcuts {
+			fmt.Fprintf(&builder, "- %s: %s\n", shortcut.Name, strings.Join(shortcut.Links, ", "))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients set to make a create request safe to retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// idempotencyKeyTTL is how long the result of a request is replayed for retries.
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyKeyMaxLength is the max length of an idempotency key.
+	idempotencyKeyMaxLength = 255
+)
+
+// idempotentResponse is the recorded result of a request made with an idempotency key.
+type idempotentResponse struct {
+	// pending is true while the first request is still being processed.
+	pending     bool
+	status      int
+	contentType string
+	body        []byte
+	expiresAt   time.Time
+}
+
+// idempotencyCache keeps the results of the requests made with an idempotency key.
+type idempotencyCache struct {
+	mutex     sync.Mutex
+	responses map[string]*idempotentResponse
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	return &idempotencyCache{
+		responses: map[string]*idempotentResponse{},
+	}
+}
+
+// reserve returns the recorded response of the key, or reserves the key for a new request when none is recorded.
+func (c *idempotencyCache) reserve(key string, now time.Time) (*idempotentResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for k, response := range c.responses {
+		if !response.pending && now.After(response.expiresAt) {
+			delete(c.responses, k)
+		}
+	}
+	if response, ok := c.responses[key]; ok {
+		return response, false
+	}
+	c.responses[key] = &idempotentResponse{pending: true}
+	return nil, true
+}
+
+func (c *idempotencyCache) store(key string, response *idempotentResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.responses[key] = response
+}
+
+func (c *idempotencyCache) release(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.responses, key)
+}
+
+// responseRecorder copies the response body written by a handler.
+type responseRecorder struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotencyMiddleware replays the original response to a retried request with the same idempotency key.
+// Keys are scoped to the current user and the route, and only successful responses are recorded,
+// so a failed request can be retried with the same key.
+func (s *APIV1Service) idempotencyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		idempotencyKey := c.Request().Header.Get(IdempotencyKeyHeader)
+		if idempotencyKey == "" {
+			return next(c)
+		}
+		if len(idempotencyKey) > idempotencyKeyMaxLength {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("idempotency key must be at most %d characters", idempotencyKeyMaxLength))
+		}
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Missing auth session")
+		}
+
+		key := fmt.Sprintf("%d:%s %s:%s", userID, c.Request().Method, c.Path(), idempotencyKey)
+		response, ok := s.idempotencyCache.reserve(key, time.Now())
+		if !ok {
+			if response.pending {
+				return echo.NewHTTPError(http.StatusConflict, "A request with the same idempotency key is in progress")
+			}
+			return c.Blob(response.status, response.contentType, response.body)
+		}
+
+		recorder := &responseRecorder{
+			ResponseWriter: c.Response().Writer,
+			body:           &bytes.Buffer{},
+		}
+		c.Response().Writer = recorder
+		err := next(c)
+		c.Response().Writer = recorder.ResponseWriter
+
+		status := c.Response().Status
+		if err != nil || status < http.StatusOK || status >= http.StatusMultipleChoices {
+			s.idempotencyCache.release(key)
+			return err
+		}
+		s.idempotencyCache.store(key, &idempotentResponse{
+			status:      status,
+			contentType: c.Response().Header().Get(echo.HeaderContentType),
+			body:        recorder.body.Bytes(),
+			expiresAt:   time.Now().Add(idempotencyKeyTTL),
+		})
+		return nil
+	}
+}
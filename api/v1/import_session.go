@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// ImportSession is a chunked import of a dump too large for a single import request. Each batch
+// is a dump with a part of the rows, uploaded at its position, and the session is committed once
+// all of them are uploaded. A failed batch is retried by uploading it again at the same position.
+type ImportSession struct {
+	ID        int32  `json:"id"`
+	CreatorID int32  `json:"creatorId"`
+	CreatedTs int64  `json:"createdTs"`
+	UpdatedTs int64  `json:"updatedTs"`
+	Status    string `json:"status"`
+	// Batches is the uploaded batches in order, the committed sessions have none.
+	Batches []*ImportBatch `json:"batches"`
+	// RowCount is the number of rows of the uploaded batches.
+	RowCount int `json:"rowCount"`
+	// Result is the result of the import, once the session is committed.
+	Result *store.ImportResult `json:"result"`
+}
+
+// ImportBatch is an uploaded batch of an import session.
+type ImportBatch struct {
+	Seq       int32 `json:"seq"`
+	CreatedTs int64 `json:"createdTs"`
+	RowCount  int   `json:"rowCount"`
+}
+
+func (s *APIV1Service) registerImportSessionRoutes(g *echo.Group) {
+	g.POST("/workspace/import/sessions", func(c echo.Context) error {
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+		userID := c.Get(userIDContextKey).(int32)
+		session, err := s.Store.CreateImportSession(c.Request().Context(), userID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create import session, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, convertImportSessionFromStore(session))
+	})
+
+	g.GET("/workspace/import/sessions/:sessionId", func(c echo.Context) error {
+		session, err := s.findImportSession(c)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, convertImportSessionFromStore(session))
+	})
+
+	g.PUT("/workspace/import/sessions/:sessionId/batches/:seq", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		session, err := s.findImportSession(c)
+		if err != nil {
+			return err
+		}
+		seq, err := strconv.Atoi(c.Param("seq"))
+		if err != nil || seq < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("batch seq is not a positive number: %s", c.Param("seq")))
+		}
+		batch := &store.Dump{}
+		if err := json.NewDecoder(c.Request().Body).Decode(batch); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("malformatted import batch, err: %s", err)).SetInternal(err)
+		}
+		if batch.Format != store.DumpFormat {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported dump format: %q", batch.Format))
+		}
+		if err := s.Store.UpsertImportBatch(ctx, session.ID, int32(seq), batch); err != nil {
+			if errors.Is(err, store.ErrImportSessionCommitted) {
+				return echo.NewHTTPError(http.StatusConflict, err.Error()).SetInternal(err)
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upload import batch, err: %s", err)).SetInternal(err)
+		}
+		session, err = s.Store.GetImportSession(ctx, session.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get import session, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, convertImportSessionFromStore(session))
+	})
+
+	g.POST("/workspace/import/sessions/:sessionId/commit", func(c echo.Context) error {
+		session, err := s.findImportSession(c)
+		if err != nil {
+			return err
+		}
+		session, err = s.Store.CommitImportSession(c.Request().Context(), session.ID)
+		if err != nil {
+			if errors.Is(err, store.ErrImportSessionCommitted) || errors.Is(err, store.ErrDumpConflict) {
+				return echo.NewHTTPError(http.StatusConflict, err.Error()).SetInternal(err)
+			}
+			if errors.Is(err, store.ErrImportBatchMissing) {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to commit import session, err: %s", err)).SetInternal(err)
+		}
+		// The imported shortcuts don't emit store events.
+		s.notFoundCache.clear()
+		return c.JSON(http.StatusOK, convertImportSessionFromStore(session))
+	})
+
+	g.DELETE("/workspace/import/sessions/:sessionId", func(c echo.Context) error {
+		session, err := s.findImportSession(c)
+		if err != nil {
+			return err
+		}
+		if err := s.Store.DeleteImportSession(c.Request().Context(), session.ID); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to delete import session, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, true)
+	})
+}
+
+// findImportSession returns the import session of the path, which only admins can access.
+func (s *APIV1Service) findImportSession(c echo.Context) (*store.ImportSession, error) {
+	if err := s.checkCurrentUserIsAdmin(c); err != nil {
+		return nil, err
+	}
+	sessionID, err := strconv.Atoi(c.Param("sessionId"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("import session id is not a number: %s", c.Param("sessionId"))).SetInternal(err)
+	}
+	session, err := s.Store.GetImportSession(c.Request().Context(), int32(sessionID))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get import session, err: %s", err)).SetInternal(err)
+	}
+	if session == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found import session with id: %d", sessionID))
+	}
+	return session, nil
+}
+
+func convertImportSessionFromStore(session *store.ImportSession) *ImportSession {
+	importSession := &ImportSession{
+		ID:        session.ID,
+		CreatorID: session.CreatorID,
+		CreatedTs: session.CreatedTs,
+		UpdatedTs: session.UpdatedTs,
+		Status:    string(session.Status),
+		Batches:   []*ImportBatch{},
+		Result:    session.Result,
+	}
+	for _, batch := range session.Batches {
+		importSession.Batches = append(importSession.Batches, &ImportBatch{
+			Seq:       batch.Seq,
+			CreatedTs: batch.CreatedTs,
+			RowCount:  batch.RowCount,
+		})
+		importSession.RowCount += batch.RowCount
+	}
+	return importSession
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// defaultInternalChainMaxHops is the number of shortcuts followed after the requested one when the
+// workspace does not set a limit.
+const defaultInternalChainMaxHops = 5
+
+// followInternalChain returns the last shortcut of the chain of shortcuts whose link is another
+// shortcut of the instance, and how many were followed. Chains are only followed when the workspace
+// enables it, and stop at the first link that is not a live shortcut the user can follow, which is
+// then redirected to as is. A view is recorded for each followed shortcut when recordViews is set.
+// Chains looping back to one of their shortcuts or longer than the hop limit are rejected.
+func (s *APIV1Service) followInternalChain(c echo.Context, shortcut *storepb.Shortcut, recordViews bool) (*storepb.Shortcut, int, error) {
+	ctx := c.Request().Context()
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN,
+	})
+	if err != nil {
+		return nil, 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+	}
+	internalChain := workspaceSetting.GetInternalChain()
+	if !internalChain.GetEnabled() {
+		return shortcut, 0, nil
+	}
+	maxHops := int(internalChain.GetMaxHops())
+	if maxHops == 0 {
+		maxHops = defaultInternalChainMaxHops
+	}
+	baseURL, err := s.getBaseURL(c)
+	if err != nil {
+		return nil, 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+	}
+
+	visited := map[string]bool{shortcut.Name: true}
+	hops := 0
+	for {
+		name, ok := s.getInternalShortcutName(baseURL, shortcut.Link)
+		if !ok {
+			return shortcut, hops, nil
+		}
+		if visited[name] {
+			return nil, hops, echo.NewHTTPError(http.StatusLoopDetected, fmt.Sprintf("shortcut chain loops back to: %s", name))
+		}
+		if hops == maxHops {
+			return nil, hops, echo.NewHTTPError(http.StatusLoopDetected, fmt.Sprintf("shortcut chain is longer than %d hops", maxHops))
+		}
+
+		next, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return nil, hops, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get shortcut, err: %s", err)).SetInternal(err)
+		}
+		// The redirector answers the links it cannot follow, as when they are visited directly.
+		if next == nil || !next.Enabled || next.ApprovalStatus == storepb.ApprovalStatus_PENDING || store.IsShortcutExpired(next, time.Now()) {
+			return shortcut, hops, nil
+		}
+		if err := s.checkRedirectAccess(c, next); err != nil || !isRefererAllowed(next.RefererPolicy, c.Request().Referer()) {
+			return shortcut, hops, nil
+		}
+		if recordViews {
+			if err := s.createShortcutViewActivity(c, next); err != nil {
+				return nil, hops, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create activity, err: %s", err)).SetInternal(err)
+			}
+		}
+		visited[name] = true
+		hops++
+		shortcut = s.resolveTargets(c, next)
+	}
+}
+
+// getInternalShortcutName returns the name of the shortcut the link redirects to when it is a shortcut
+// of the instance. Links with a query or a fragment are not followed, as they would be dropped.
+func (s *APIV1Service) getInternalShortcutName(baseURL, link string) (string, bool) {
+	path, ok := strings.CutPrefix(link, baseURL+"/s/")
+	if !ok || strings.ContainsAny(path, "?#") {
+		return "", false
+	}
+	name, err := url.PathUnescape(path)
+	if err != nil {
+		return "", false
+	}
+	if s.Profile.StripTrailingSlash {
+		name = strings.TrimRight(name, "/")
+	}
+	return name, name != ""
+}
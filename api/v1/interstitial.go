@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// defaultInterstitialDelay is the delay of the interstitial when the workspace doesn't set one.
+	defaultInterstitialDelay = 5 * time.Second
+	// defaultInterstitialMessage is the message of the interstitial when the workspace doesn't set one.
+	defaultInterstitialMessage = "You are leaving this site."
+	// maxRedirectDelay caps the redirect delay of the shortcuts, so that visitors are never kept waiting long.
+	maxRedirectDelay = 10 * time.Second
+	// redirectDelayMessage is the message of the page shown during the redirect delay of a shortcut.
+	redirectDelayMessage = "Redirecting..."
+)
+
+// getInterstitial returns the interstitial to show before redirecting to the shortcut's link,
+// or nil when the visitor is redirected at once. The links with credentials are always redirected
+// at once, as the page could only follow them by showing their credentials.
+func (s *APIV1Service) getInterstitial(ctx context.Context, shortcut *storepb.Shortcut) (*storepb.InterstitialWorkspaceSetting, error) {
+	// Other schemes, such as javascript:, must never be followed from a page of the workspace.
+	if !isHTTPURLString(shortcut.Link) || hasURLUserinfo(shortcut.Link) {
+		return nil, nil
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+	interstitial := workspaceSetting.GetInterstitial()
+	if !interstitial.GetEnabled() && !shortcut.Interstitial {
+		return nil, nil
+	}
+	if interstitial == nil {
+		interstitial = &storepb.InterstitialWorkspaceSetting{}
+	}
+	return interstitial, nil
+}
+
+// getRedirectDelay returns how long the visitor waits before being redirected to the shortcut's
+// link, capped at maxRedirectDelay, or zero when the visitor is redirected at once, as the links with
+// credentials are.
+func getRedirectDelay(shortcut *storepb.Shortcut) time.Duration {
+	if shortcut.RedirectDelayMs <= 0 || !isHTTPURLString(shortcut.Link) || hasURLUserinfo(shortcut.Link) {
+		return 0
+	}
+	return min(time.Duration(shortcut.RedirectDelayMs)*time.Millisecond, maxRedirectDelay)
+}
+
+// respondInterstitial responds with a page showing the link, which redirects to it after the delay.
+// The page never contains the credentials of the link, which are stripped from it.
+func respondInterstitial(c echo.Context, link string, delay time.Duration, message string) error {
+	link, _ = util.StripURLUserinfo(link)
+	seconds := strconv.FormatFloat(delay.Seconds(), 'f', -1, 64)
+	page := fmt.Sprintf(
+		`<html><head><title>Redirecting</title><meta http-equiv="refresh" content="%s; url=%s" /></head><body><p>%s</p><p><a href="%s">%s</a></p><p>You will be redirected in %s seconds.</p></body></html>`,
+		seconds,
+		html.EscapeString(link),
+		html.EscapeString(message),
+		html.EscapeString(link),
+		html.EscapeString(link),
+		seconds,
+	)
+	return c.HTML(http.StatusOK, page)
+}
+
+func isHTTPURLString(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func hasURLUserinfo(s string) bool {
+	_, hasUserinfo := util.StripURLUserinfo(s)
+	return hasUserinfo
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -66,6 +67,13 @@ func JWTMiddleware(s *APIV1Service, next echo.HandlerFunc, secret string) echo.H
 			if util.HasPrefixes(path, "/s/", "/api/v1/user/") && method == http.MethodGet {
 				return next(c)
 			}
+			if util.HasPrefixes(path, "/api/v1/shortcuts/") && strings.HasSuffix(path, ":redirect") && method == http.MethodGet {
+				return next(c)
+			}
+			// The conversions of shortcuts authenticate with their conversion token.
+			if util.HasPrefixes(path, "/api/v1/shortcuts/") && strings.HasSuffix(path, "/conversion") && method == http.MethodPost {
+				return next(c)
+			}
 			return echo.NewHTTPError(http.StatusUnauthorized, "Missing access token")
 		}
 
@@ -81,6 +89,10 @@ func JWTMiddleware(s *APIV1Service, next echo.HandlerFunc, secret string) echo.H
 		if !validateAccessToken(accessToken, accessTokens) {
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token.")
 		}
+		// Failing to record the use of the access token doesn't fail the request.
+		if err := s.Store.RecordAccessTokenUse(ctx, userID, accessToken, accessTokens); err != nil {
+			slog.WarnContext(ctx, "failed to record access token use", "user", userID, "error", err)
+		}
 
 		// Even if there is no error, we still need to make sure the user still exists.
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
@@ -100,6 +112,20 @@ func JWTMiddleware(s *APIV1Service, next echo.HandlerFunc, secret string) echo.H
 }
 
 func getUserIDFromAccessToken(accessToken, secret string) (int32, error) {
+	claims, err := parseAccessTokenClaims(accessToken, secret)
+	if err != nil {
+		return 0, errors.Wrap(err, "Invalid or expired access token")
+	}
+	// We either have a valid access token or we will attempt to generate new access token.
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return 0, errors.Wrap(err, "Malformed ID in the token")
+	}
+	return userID, nil
+}
+
+// parseAccessTokenClaims returns the claims of the access token when it is valid and not expired.
+func parseAccessTokenClaims(accessToken, secret string) (*auth.ClaimsMessage, error) {
 	claims := &auth.ClaimsMessage{}
 	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
 		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
@@ -113,14 +139,9 @@ func getUserIDFromAccessToken(accessToken, secret string) (int32, error) {
 		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
 	})
 	if err != nil {
-		return 0, errors.Wrap(err, "Invalid or expired access token")
+		return nil, err
 	}
-	// We either have a valid access token or we will attempt to generate new access token.
-	userID, err := util.ConvertStringToInt32(claims.Subject)
-	if err != nil {
-		return 0, errors.Wrap(err, "Malformed ID in the token")
-	}
-	return userID, nil
+	return claims, nil
 }
 
 func validateAccessToken(accessTokenString string, userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) bool {
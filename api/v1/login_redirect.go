@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// defaultLoginURL is the login page of the login redirect when the workspace doesn't set one.
+const defaultLoginURL = "/auth"
+
+// respondRedirectAccessDenied responds to a visitor who cannot follow the shortcut with the error
+// of the access check. Visitors who are not signed in are redirected to the login page instead
+// when the workspace enables the login redirect, so that they come back to the short link after.
+func (s *APIV1Service) respondRedirectAccessDenied(c echo.Context, accessErr error) error {
+	if _, ok := c.Get(userIDContextKey).(int32); ok {
+		return accessErr
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(c.Request().Context(), &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+	}
+	loginRedirect := workspaceSetting.GetLoginRedirect()
+	if !loginRedirect.GetEnabled() {
+		return accessErr
+	}
+	loginURL := &url.URL{Path: defaultLoginURL}
+	if loginRedirect.GetUrl() != "" {
+		if u, err := url.Parse(loginRedirect.GetUrl()); err == nil {
+			loginURL = u
+		}
+	}
+	baseURL, err := s.getBaseURL(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+	}
+	query := loginURL.Query()
+	query.Set("return_to", baseURL+c.Request().URL.RequestURI())
+	loginURL.RawQuery = query.Encode()
+	// The redirect depends on the session of the visitor.
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.Redirect(http.StatusFound, loginURL.String())
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// metadataFetchTimeout is how long the page of a shortcut link is waited for when refreshing its metadata.
+const metadataFetchTimeout = 10 * time.Second
+
+// refreshShortcutMetadata fetches the open graph metadata of the page of the shortcut link again and
+// replaces the metadata of the shortcut with it, for when the page changed. Only the users who can edit
+// the shortcut can refresh it.
+func (s *APIV1Service) refreshShortcutMetadata(c echo.Context, shortcutID int32) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch shortcut by id, err: %s", err)).SetInternal(err)
+	}
+	if shortcut == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+	}
+	if currentUser == nil {
+		return echo.NewHTTPError(http.StatusForbidden, "unauthorized to refresh shortcut metadata")
+	}
+	canEdit, err := s.canEditShortcut(ctx, shortcut, currentUser)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut collaborator, err: %s", err)).SetInternal(err)
+	}
+	if !canEdit {
+		return echo.NewHTTPError(http.StatusForbidden, "unauthorized to refresh shortcut metadata")
+	}
+	if !isHTTPURLString(shortcut.Link) {
+		return echo.NewHTTPError(http.StatusBadRequest, "shortcut link is not an http URL")
+	}
+
+	metadata, err := s.metadataSource(ctx, shortcut.Link)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadGateway, fmt.Sprintf("failed to fetch metadata of the shortcut link, err: %s", err)).SetInternal(err)
+	}
+	// The fetched metadata is stored within the maximum lengths, as the metadata of requests must be.
+	refreshed := s.truncateOpenGraphMetadata(&storepb.Shortcut{
+		OgMetadata: &storepb.OpenGraphMetadata{
+			Title:       metadata.Title,
+			Description: metadata.Description,
+			Image:       metadata.Image,
+		},
+	})
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:                shortcut.Id,
+		OpenGraphMetadata: refreshed.OgMetadata,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to update shortcut, err: %s", err)).SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, &OpenGraphMetadata{
+		Title:       shortcut.OgMetadata.GetTitle(),
+		Description: shortcut.OgMetadata.GetDescription(),
+		Image:       shortcut.OgMetadata.GetImage(),
+	})
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/yourselfhosted/slash/internal/opengraph"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestRefreshShortcutMetadata(t *testing.T) {
+	ctx := context.Background()
+	title := "First title"
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<head><meta property="og:title" content="%s"><meta property="og:image" content="https://example.com/image.png"></head>`, title)
+	}))
+	defer target.Close()
+
+	profile := test.GetTestingProfile(t)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	ts := store.New(database.DBInstance, profile)
+	defer ts.Close(ctx)
+	s := NewAPIV1Service(profile, ts, nil, nil, nil, nil, nil)
+	// The stub target is on the loopback, which the client of the server refuses to connect to.
+	s.metadataSource = opengraph.HTTPSource(target.Client())
+
+	owner, err := ts.CreateUser(ctx, &store.User{Email: "owner@yourselfhosted.com", Nickname: "owner", Role: store.RoleUser})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{Email: "other@yourselfhosted.com", Nickname: "other", Role: store.RoleUser})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  owner.ID,
+		Name:       "test",
+		Link:       target.URL + "/page",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{Title: "Stale title", Description: "Stale description"},
+	})
+	require.NoError(t, err)
+
+	refresh := func(userID int32) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+		c.Set(userIDContextKey, userID)
+		return rec, s.refreshShortcutMetadata(c, shortcut.Id)
+	}
+	requireMetadata := func(rec *httptest.ResponseRecorder, expected *OpenGraphMetadata) {
+		metadata := &OpenGraphMetadata{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), metadata))
+		require.Equal(t, expected, metadata)
+		stored, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+		require.NoError(t, err)
+		require.Equal(t, expected.Title, stored.OgMetadata.GetTitle())
+		require.Equal(t, expected.Description, stored.OgMetadata.GetDescription())
+		require.Equal(t, expected.Image, stored.OgMetadata.GetImage())
+	}
+
+	rec, err := refresh(owner.ID)
+	require.NoError(t, err)
+	requireMetadata(rec, &OpenGraphMetadata{Title: "First title", Image: "https://example.com/image.png"})
+
+	// The metadata follows the changes of the page.
+	title = "Second title"
+	rec, err = refresh(owner.ID)
+	require.NoError(t, err)
+	requireMetadata(rec, &OpenGraphMetadata{Title: "Second title", Image: "https://example.com/image.png"})
+
+	// Only the users who can edit the shortcut can refresh the metadata.
+	_, err = refresh(other.ID)
+	httpErr := &echo.HTTPError{}
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusForbidden, httpErr.Code)
+	_, err = ts.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+		ShortcutID: shortcut.Id,
+		UserID:     other.ID,
+	})
+	require.NoError(t, err)
+	title = "Third title"
+	rec, err = refresh(other.ID)
+	require.NoError(t, err)
+	requireMetadata(rec, &OpenGraphMetadata{Title: "Third title", Image: "https://example.com/image.png"})
+}
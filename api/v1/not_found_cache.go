@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// notFoundCacheTTL is how long a shortcut name that was not found is answered without a lookup.
+	notFoundCacheTTL = time.Minute
+	// notFoundCacheMaxSize is the max number of names in the cache, so that random names can't fill the memory.
+	notFoundCacheMaxSize = 10000
+)
+
+// notFoundCache keeps the shortcut names recently not found by the redirector, which scrapers
+// request repeatedly, with the time their entry expires.
+type notFoundCache struct {
+	ttl time.Duration
+
+	mutex     sync.Mutex
+	expiresAt map[string]time.Time
+}
+
+func newNotFoundCache(ttl time.Duration) *notFoundCache {
+	return &notFoundCache{
+		ttl:       ttl,
+		expiresAt: map[string]time.Time{},
+	}
+}
+
+// contains returns whether the name was not found within the TTL.
+func (c *notFoundCache) contains(name string, now time.Time) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expiresAt, ok := c.expiresAt[name]
+	if !ok {
+		return false
+	}
+	if !now.Before(expiresAt) {
+		delete(c.expiresAt, name)
+		return false
+	}
+	return true
+}
+
+// add records that the name was not found. The name is not recorded when the cache is full of unexpired names.
+func (c *notFoundCache) add(name string, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.expiresAt) >= notFoundCacheMaxSize {
+		for k, expiresAt := range c.expiresAt {
+			if !now.Before(expiresAt) {
+				delete(c.expiresAt, k)
+			}
+		}
+		if len(c.expiresAt) >= notFoundCacheMaxSize {
+			return
+		}
+	}
+	c.expiresAt[name] = now.Add(c.ttl)
+}
+
+// remove drops the name, once a shortcut with the name exists.
+func (c *notFoundCache) remove(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.expiresAt, name)
+}
+
+// clear drops every name, when shortcuts are written without store events.
+func (c *notFoundCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	clear(c.expiresAt)
+}
+
+// invalidateNotFoundCache is the store hook removing the names of the created and renamed shortcuts from the cache.
+func (s *APIV1Service) invalidateNotFoundCache(_ context.Context, event *store.Event) {
+	if event.Shortcut != nil && (event.Type == store.EventShortcutCreated || event.Type == store.EventShortcutUpdated) {
+		s.notFoundCache.remove(event.Shortcut.Name)
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotFoundCache(t *testing.T) {
+	now := time.Now()
+	cache := newNotFoundCache(time.Minute)
+	if cache.contains("missing", now) {
+		t.Errorf("contains(missing) = true before the miss is added")
+	}
+
+	cache.add("missing", now)
+	if !cache.contains("missing", now.Add(30*time.Second)) {
+		t.Errorf("contains(missing) = false within the TTL")
+	}
+	if cache.contains("missing", now.Add(time.Minute)) {
+		t.Errorf("contains(missing) = true after the TTL")
+	}
+
+	cache.add("created", now)
+	cache.remove("created")
+	if cache.contains("created", now) {
+		t.Errorf("contains(created) = true after it is removed")
+	}
+
+	cache.add("imported", now)
+	cache.clear()
+	if cache.contains("imported", now) {
+		t.Errorf("contains(imported) = true after the cache is cleared")
+	}
+}
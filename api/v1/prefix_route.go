@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// getPrefixRouteLink returns the link of the name routed by the prefix routes of the workspace, which
+// is the base of the route of its first segment followed by the rest of the name, or an empty string
+// when no route matches. Names without a slash are never routed, so that they remain shortcuts.
+// The rest of the name is escaped into the path of the base, so that it never changes its host.
+func (s *APIV1Service) getPrefixRouteLink(ctx context.Context, name string) (string, error) {
+	prefix, rest, ok := strings.Cut(name, "/")
+	if !ok {
+		return "", nil
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace setting")
+	}
+	prefix = s.Store.NormalizeName(prefix)
+	for _, route := range workspaceSetting.GetPrefixRoutes().GetRoutes() {
+		if s.Store.NormalizeName(route.Prefix) == prefix {
+			return joinPrefixRouteLink(route.Base, rest)
+		}
+	}
+	return "", nil
+}
+
+// joinPrefixRouteLink returns the base followed by the escaped rest of the name in its path. The bases
+// saved before they were required to end with a slash, such as "https://jira.example.com", get one.
+func joinPrefixRouteLink(base, rest string) (string, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid prefix route base %q", base)
+	}
+	restPath, err := url.PathUnescape(rest)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid prefix route name %q", rest)
+	}
+	link := *baseURL
+	escapedPath := baseURL.EscapedPath()
+	if !strings.HasSuffix(link.Path, "/") {
+		link.Path += "/"
+		escapedPath += "/"
+	}
+	link.Path += restPath
+	link.RawPath = escapedPath + rest
+	linkURL, err := url.Parse(link.String())
+	if err != nil || linkURL.Host != baseURL.Host {
+		return "", errors.Errorf("prefix route of %q leaves the host of its base %q", rest, base)
+	}
+	return linkURL.String(), nil
+}
+
+// redirectPrefixRoute redirects to the link of a prefix route, which is followed as a public
+// shortcut without views.
+func (s *APIV1Service) redirectPrefixRoute(c echo.Context, name, link string) error {
+	if err := s.checkRedirectAccess(c, &storepb.Shortcut{Name: name, Link: link, Visibility: storepb.Visibility_PUBLIC}); err != nil {
+		return s.respondRedirectAccessDenied(c, err)
+	}
+	// The routes saved before their host was denied are not followed either.
+	if s.HostDenylist.ContainsURL(link) {
+		return respondDeniedHost(c, link)
+	}
+	if rawQuery := c.Request().URL.RawQuery; rawQuery != "" {
+		separator := "?"
+		if strings.Contains(link, "?") {
+			separator = "&"
+		}
+		link = fmt.Sprintf("%s%s%s", link, separator, rawQuery)
+	}
+	return c.Redirect(http.StatusSeeOther, link)
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// RateLimitLimitHeader is the header carrying the number of requests a user can make per window.
+	RateLimitLimitHeader = "X-RateLimit-Limit"
+	// RateLimitRemainingHeader is the header carrying the number of requests left in the current window.
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	// RateLimitResetHeader is the header carrying the unix time at which the current window ends.
+	RateLimitResetHeader = "X-RateLimit-Reset"
+	// userRateLimitWindow is the window in which the requests of a user are counted.
+	userRateLimitWindow = time.Minute
+)
+
+// userRateLimitMiddleware limits the requests of each signed in user to the limit of their role,
+// and tells clients their quota with the rate limit headers so that they can throttle themselves.
+func (s *APIV1Service) userRateLimitMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return next(c)
+		}
+		user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+		}
+		limit := s.Profile.UserRateLimit
+		if user != nil && user.Role == store.RoleAdmin {
+			limit = s.Profile.AdminRateLimit
+		}
+		if limit <= 0 {
+			return next(c)
+		}
+
+		now := time.Now()
+		window, allowed := s.userRateLimiter.Take(userID, limit, now)
+		header := c.Response().Header()
+		header.Set(RateLimitLimitHeader, strconv.Itoa(limit))
+		header.Set(RateLimitRemainingHeader, strconv.Itoa(limit-window.Count))
+		header.Set(RateLimitResetHeader, strconv.FormatInt(window.ResetAt.Unix(), 10))
+		if !allowed {
+			header.Set(echo.HeaderRetryAfter, strconv.Itoa(window.RetryAfter(now)))
+			return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+		}
+		return next(c)
+	}
+}
+
+// checkShortcutCreationRate counts a shortcut created by the user, and refuses it once the user
+// created the limit of shortcuts of the current window. Admins are never limited.
+func (s *APIV1Service) checkShortcutCreationRate(c echo.Context, user *store.User) error {
+	if s.Profile.CreationRateLimit <= 0 || user.Role == store.RoleAdmin {
+		return nil
+	}
+	now := time.Now()
+	window, allowed := s.CreationLimiter.Take(user.ID, s.Profile.CreationRateLimit, now)
+	if !allowed {
+		c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(window.RetryAfter(now)))
+		return echo.NewHTTPError(http.StatusTooManyRequests, "too many shortcuts created, try again later")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/requestid"
+	"github.com/yourselfhosted/slash/internal/util"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// RedirectLogLine is a line of the redirect log, written for every redirect, including those of
+// the shortcuts without analytics, and independently from the activities.
+type RedirectLogLine struct {
+	Time         string `json:"time"`
+	RequestID    string `json:"requestId,omitempty"`
+	Method       string `json:"method"`
+	ShortcutID   int32  `json:"shortcutId"`
+	ShortcutName string `json:"shortcutName"`
+	Link         string `json:"link"`
+	IP           string `json:"ip"`
+	Referer      string `json:"referer"`
+	UserAgent    string `json:"userAgent"`
+}
+
+// logRedirect writes the redirect to the shortcut's link to the redirect log, when there is one.
+func (s *APIV1Service) logRedirect(c echo.Context, shortcut *storepb.Shortcut) error {
+	if s.redirectLog == nil {
+		return nil
+	}
+	ctx := c.Request().Context()
+	hashIP, err := s.getVisitorIPHasher(ctx)
+	if err != nil {
+		return err
+	}
+	anonymized, err := s.isVisitorAnonymized(ctx)
+	if err != nil {
+		return err
+	}
+	// The credentials of the link are not written to the log on disk.
+	link, _ := util.StripURLUserinfo(shortcut.Link)
+	logLine := &RedirectLogLine{
+		Time:         time.Now().UTC().Format(time.RFC3339Nano),
+		RequestID:    requestid.FromContext(ctx),
+		Method:       c.Request().Method,
+		ShortcutID:   shortcut.Id,
+		ShortcutName: shortcut.Name,
+		Link:         link,
+		Referer:      c.Request().Referer(),
+	}
+	if !anonymized {
+		logLine.IP, logLine.UserAgent = hashIP(c.RealIP()), c.Request().UserAgent()
+	}
+	line, err := json.Marshal(logLine)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal redirect log line")
+	}
+	return s.redirectLog.WriteLine(line)
+}
@@ -1,29 +1,73 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"html"
+	"html/template"
+	"log/slog"
+	"maps"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/yourselfhosted/slash/internal/analytics"
+	"github.com/yourselfhosted/slash/internal/favicon"
+	"github.com/yourselfhosted/slash/internal/opengraph"
+	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/store"
 )
 
+const (
+	// ShortcutHeader is the debug header carrying the name of the matched shortcut.
+	ShortcutHeader = "X-Slash-Shortcut"
+	// ShortcutTargetHeader is the debug header carrying the link of the matched shortcut.
+	ShortcutTargetHeader = "X-Slash-Shortcut-Target"
+	// faviconCacheTTL is how long the favicon of a site is shown in previews before it is fetched again.
+	faviconCacheTTL = 24 * time.Hour
+	// faviconFetchTimeout bounds how long a preview waits for the favicon of its link.
+	faviconFetchTimeout = 2 * time.Second
+)
+
 func (s *APIV1Service) registerRedirectorRoutes(g *echo.Group) {
-	g.GET("/*", func(c echo.Context) error {
+	// HEAD requests get the same status and headers as GET, without the body.
+	g.Match([]string{http.MethodGet, http.MethodHead}, "/*", func(c echo.Context) error {
 		ctx := c.Request().Context()
 		if len(c.ParamValues()) == 0 {
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid shortcut name")
 		}
 
 		shortcutName := c.ParamValues()[0]
+		if s.Profile.StripTrailingSlash {
+			shortcutName = strings.TrimRight(shortcutName, "/")
+		}
+		if shortcutName == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid shortcut name")
+		}
+		// The prefix routes of the workspace take precedence over the shortcuts.
+		routeLink, err := s.getPrefixRouteLink(ctx, shortcutName)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get prefix routes, err: %s", err)).SetInternal(err)
+		}
+		if routeLink != "" {
+			return s.redirectPrefixRoute(c, shortcutName, routeLink)
+		}
+		// Names recently not found are answered without looking them up again. They are cached in the
+		// form they are stored in, which the store hooks invalidate.
+		normalizedName := s.Store.NormalizeName(shortcutName)
+		if s.notFoundCache.contains(normalizedName, time.Now()) {
+			return c.Redirect(http.StatusSeeOther, fmt.Sprintf("/404?shortcut=%s", shortcutName))
+		}
 		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 			Name: &shortcutName,
 		})
@@ -31,69 +75,690 @@ func (s *APIV1Service) registerRedirectorRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get shortcut, err: %s", err)).SetInternal(err)
 		}
 		if shortcut == nil {
+			s.notFoundCache.add(normalizedName, time.Now())
+			return c.Redirect(http.StatusSeeOther, fmt.Sprintf("/404?shortcut=%s", shortcutName))
+		}
+		// The preview flag is ignored for users who cannot preview the shortcut,
+		// so that it cannot be used to probe private shortcuts.
+		if isPreviewRequested(c, s.Profile.GetPreviewParam()) {
+			canPreview, err := s.canPreviewShortcut(c, shortcut)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+			}
+			if canPreview {
+				// The preview shows the link, which the referers the shortcut blocks must not get either.
+				if !isRefererAllowed(shortcut.RefererPolicy, c.Request().Referer()) {
+					return respondBlockedReferer(c, shortcut.RefererPolicy)
+				}
+				return s.respondShortcutPreview(c, shortcut)
+			}
+		}
+		if !shortcut.Enabled {
+			return s.respondDisabledShortcut(c, shortcutName)
+		}
+		// Pending shortcuts are not live until they are approved, and expired shortcuts no longer are.
+		if shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING || store.IsShortcutExpired(shortcut, time.Now()) {
 			return c.Redirect(http.StatusSeeOther, fmt.Sprintf("/404?shortcut=%s", shortcutName))
 		}
-		if shortcut.Visibility != storepb.Visibility_PUBLIC {
-			userID, ok := c.Get(userIDContextKey).(int32)
-			if !ok {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+		if err := s.checkRedirectAccess(c, shortcut); err != nil {
+			return s.respondRedirectAccessDenied(c, err)
+		}
+		// Blocked referers are not views of the shortcut.
+		if !isRefererAllowed(shortcut.RefererPolicy, c.Request().Referer()) {
+			return respondBlockedReferer(c, shortcut.RefererPolicy)
+		}
+
+		// Link checkers verify shortcuts with HEAD requests, which are not views unless configured so.
+		recordViews := c.Request().Method != http.MethodHead || s.Profile.CountHeadViews
+		if recordViews {
+			if err := s.createShortcutViewActivity(c, shortcut); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create activity, err: %s", err)).SetInternal(err)
+			}
+		}
+
+		if err := s.setRedirectCacheHeaders(c, shortcut); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to set cache headers, err: %s", err)).SetInternal(err)
+		}
+		// Cached redirects must not be served to the referers the shortcut blocks.
+		if hasRefererPolicy(shortcut.RefererPolicy) {
+			c.Response().Header().Add(echo.HeaderVary, "Referer")
+		}
+		shortcut = s.resolveTargets(c, shortcut)
+		shortcut, hops, err := s.followInternalChain(c, shortcut, recordViews)
+		if err != nil {
+			return err
+		}
+		// The followed shortcuts may change independently of the requested one.
+		if hops > 0 {
+			c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+		}
+		// The links created before their host was denied are not followed either.
+		if s.HostDenylist.ContainsURL(shortcut.Link) {
+			return respondDeniedHost(c, shortcut.Link)
+		}
+
+		shortcut = resolveOpenGraphImage(shortcut)
+		shortcut, err = s.applyDefaultOpenGraphMetadata(ctx, shortcut)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to apply default open graph metadata, err: %s", err)).SetInternal(err)
+		}
+		shortcut = s.truncateOpenGraphMetadata(shortcut)
+
+		shortcut, err = s.applyLinkDecorators(ctx, shortcut)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to apply link decorators, err: %s", err)).SetInternal(err)
+		}
+
+		if s.Profile.IsDebugHeadersEnabled() {
+			setRedirectDebugHeaders(c, shortcut)
+		}
+
+		interstitial, err := s.getInterstitial(ctx, shortcut)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get interstitial, err: %s", err)).SetInternal(err)
+		}
+		metric.Enqueue("shortcut redirect")
+		if err := s.logRedirect(c, shortcut); err != nil {
+			slog.ErrorContext(ctx, "failed to log redirect", "shortcut", shortcut.Name, "error", err)
+		}
+		if interstitial != nil {
+			delay := time.Duration(interstitial.DelaySeconds) * time.Second
+			if delay == 0 {
+				delay = defaultInterstitialDelay
 			}
-			if shortcut.Visibility == storepb.Visibility_PRIVATE && shortcut.CreatorId != userID {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+			message := interstitial.Message
+			if message == "" {
+				message = defaultInterstitialMessage
 			}
+			return respondInterstitial(c, shortcut.Link, delay, message)
+		}
+		if delay := getRedirectDelay(shortcut); delay > 0 {
+			return respondInterstitial(c, shortcut.Link, delay, redirectDelayMessage)
+		}
+		// The links that are not URLs, such as text snippets, are responded as text in the text mode
+		// even when the shortcut has open graph metadata.
+		if hasOpenGraphMetadata(shortcut) && !isValidURLString(shortcut.Link) {
+			invalidLinkMode, err := s.getInvalidLinkMode(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+			}
+			if invalidLinkMode == storepb.InvalidLinkMode_INVALID_LINK_MODE_TEXT {
+				return c.String(http.StatusOK, shortcut.Link)
+			}
+		}
+		// The favicon is only fetched for the previews, which are rendered from the open graph metadata.
+		var icon *favicon.Icon
+		if s.faviconCache != nil && hasOpenGraphMetadata(shortcut) {
+			icon = s.faviconCache.Get(ctx, shortcut.Link, time.Now())
 		}
+		redirectStatus, err := s.getRedirectStatus(ctx, shortcut)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+		return redirectToShortcut(c, shortcut, icon, redirectStatus)
+	})
+}
+
+// ShortcutRedirect is the target of a shortcut, resolved for clients that navigate on their own
+// instead of following the redirect.
+type ShortcutRedirect struct {
+	Target string `json:"target"`
+	// Status is the status the redirector responds with, the redirect status of the shortcut for links
+	// and 200 for other texts.
+	Status int `json:"status"`
+}
 
+// resolveShortcutRedirect responds with the target the redirector would send the user to as JSON,
+// without the credentials of the link. The view is recorded unless the recordView query param is false.
+func (s *APIV1Service) resolveShortcutRedirect(c echo.Context, shortcutName string) error {
+	ctx := c.Request().Context()
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name: &shortcutName,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get shortcut, err: %s", err)).SetInternal(err)
+	}
+	// Disabled, pending and expired shortcuts are not live, as for the redirector.
+	if shortcut == nil || !shortcut.Enabled || shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING || store.IsShortcutExpired(shortcut, time.Now()) {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("shortcut not found: %s", shortcutName))
+	}
+	if err := s.checkRedirectAccess(c, shortcut); err != nil {
+		return err
+	}
+	// The target is not resolved for the referers the shortcut blocks, as it is not redirected to.
+	if !isRefererAllowed(shortcut.RefererPolicy, c.Request().Referer()) {
+		return echo.NewHTTPError(http.StatusForbidden, "referer not allowed to follow the shortcut")
+	}
+
+	recordView, err := strconv.ParseBool(c.QueryParam("recordView"))
+	recordView = err != nil || recordView
+	if recordView {
 		if err := s.createShortcutViewActivity(c, shortcut); err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create activity, err: %s", err)).SetInternal(err)
 		}
+	}
 
-		metric.Enqueue("shortcut redirect")
-		return redirectToShortcut(c, shortcut)
+	shortcut = s.resolveTargets(c, shortcut)
+	shortcut, _, err = s.followInternalChain(c, shortcut, recordView)
+	if err != nil {
+		return err
+	}
+	if s.HostDenylist.ContainsURL(shortcut.Link) {
+		return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("shortcut link points to a denied host: %s", shortcutName))
+	}
+	shortcut, err = s.applyLinkDecorators(ctx, shortcut)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to apply link decorators, err: %s", err)).SetInternal(err)
+	}
+	// The credentials of the link are not leaked to the clients, which navigate on their own.
+	target, _ := util.StripURLUserinfo(shortcut.Link)
+	redirect := &ShortcutRedirect{
+		Target: target,
+		Status: http.StatusOK,
+	}
+	if isValidURLString(shortcut.Link) {
+		redirect.Status, err = s.getRedirectStatus(ctx, shortcut)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	metric.Enqueue("shortcut resolve")
+	return c.JSON(http.StatusOK, redirect)
+}
+
+// checkRedirectAccess returns an error unless the current user can follow the shortcut:
+// anyone can follow public shortcuts, signed in users workspace ones, and only creators private ones.
+// When the workspace requires auth for all redirects, public shortcuts are for signed in users too.
+func (s *APIV1Service) checkRedirectAccess(c echo.Context, shortcut *storepb.Shortcut) error {
+	if shortcut.Visibility == storepb.Visibility_PUBLIC {
+		requireAuth, err := s.isAuthRequiredForRedirects(c.Request().Context())
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+		if !requireAuth {
+			return nil
+		}
+	}
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if shortcut.Visibility == storepb.Visibility_PRIVATE && shortcut.CreatorId != userID {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	return nil
+}
+
+// isAuthRequiredForRedirects returns whether the workspace requires signing in to follow any shortcut.
+func (s *APIV1Service) isAuthRequiredForRedirects(ctx context.Context) (bool, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get workspace setting")
+	}
+	return workspaceSetting.GetRequireAuthForAllRedirects(), nil
+}
+
+// getInvalidLinkMode returns the response to visits of the shortcuts whose link is not a valid URL,
+// the preview mode when the workspace does not set it.
+func (s *APIV1Service) getInvalidLinkMode(ctx context.Context) (storepb.InvalidLinkMode, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get workspace setting")
+	}
+	if mode := workspaceSetting.GetInvalidLinkMode(); mode != storepb.InvalidLinkMode_INVALID_LINK_MODE_UNSPECIFIED {
+		return mode, nil
+	}
+	return storepb.InvalidLinkMode_INVALID_LINK_MODE_PREVIEW, nil
+}
+
+// getRedirectStatus returns the status the shortcut redirects with, which the workspace sets for each
+// visibility, 303 when it does not.
+func (s *APIV1Service) getRedirectStatus(ctx context.Context, shortcut *storepb.Shortcut) (int, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS,
 	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get workspace setting")
+	}
+	redirectStatus := workspaceSetting.GetRedirectStatus()
+	var status int32
+	switch shortcut.Visibility {
+	case storepb.Visibility_PUBLIC:
+		status = redirectStatus.GetPublic()
+	case storepb.Visibility_WORKSPACE:
+		status = redirectStatus.GetWorkspace()
+	case storepb.Visibility_PRIVATE:
+		status = redirectStatus.GetPrivate()
+	}
+	if status == 0 {
+		return http.StatusSeeOther, nil
+	}
+	return int(status), nil
+}
+
+// ShortcutPreview is the metadata of a shortcut responded instead of its redirect when previewing it.
+type ShortcutPreview struct {
+	Name              string             `json:"name"`
+	Link              string             `json:"link"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	Visibility        Visibility         `json:"visibility"`
+	Enabled           bool               `json:"enabled"`
+	ApprovalStatus    ApprovalStatus     `json:"approvalStatus"`
+	OpenGraphMetadata *OpenGraphMetadata `json:"openGraphMetadata"`
 }
 
-func redirectToShortcut(c echo.Context, shortcut *storepb.Shortcut) error {
+func isPreviewRequested(c echo.Context, previewParam string) bool {
+	preview, err := strconv.ParseBool(c.QueryParam(previewParam))
+	return err == nil && preview
+}
+
+// canPreviewShortcut returns whether the current user is the creator of the shortcut or an admin.
+func (s *APIV1Service) canPreviewShortcut(c echo.Context, shortcut *storepb.Shortcut) (bool, error) {
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return false, nil
+	}
+	if shortcut.CreatorId == userID {
+		return true, nil
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return user != nil && user.Role == store.RoleAdmin, nil
+}
+
+// respondShortcutPreview responds with the metadata of the shortcut as JSON, or as HTML for browsers,
+// without redirecting or counting a view.
+func (s *APIV1Service) respondShortcutPreview(c echo.Context, shortcut *storepb.Shortcut) error {
+	shortcut, err := s.applyDefaultOpenGraphMetadata(c.Request().Context(), resolveOpenGraphImage(shortcut))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to apply default open graph metadata, err: %s", err)).SetInternal(err)
+	}
+	shortcut = s.truncateOpenGraphMetadata(shortcut)
+	preview := &ShortcutPreview{
+		Name:           shortcut.Name,
+		Link:           shortcut.Link,
+		Title:          shortcut.Title,
+		Description:    shortcut.Description,
+		Visibility:     Visibility(shortcut.Visibility.String()),
+		Enabled:        shortcut.Enabled,
+		ApprovalStatus: ApprovalStatus(shortcut.ApprovalStatus.String()),
+		OpenGraphMetadata: &OpenGraphMetadata{
+			Title:       shortcut.GetOgMetadata().GetTitle(),
+			Description: shortcut.GetOgMetadata().GetDescription(),
+			Image:       shortcut.GetOgMetadata().GetImage(),
+		},
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	if !strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMETextHTML) {
+		return c.JSON(http.StatusOK, preview)
+	}
+	rows := []string{}
+	for _, field := range [][2]string{
+		{"Name", preview.Name},
+		{"Link", preview.Link},
+		{"Title", preview.Title},
+		{"Description", preview.Description},
+		{"Visibility", preview.Visibility.String()},
+		{"Enabled", strconv.FormatBool(preview.Enabled)},
+		{"Approval status", string(preview.ApprovalStatus)},
+		{"Open graph title", preview.OpenGraphMetadata.Title},
+		{"Open graph description", preview.OpenGraphMetadata.Description},
+		{"Open graph image", preview.OpenGraphMetadata.Image},
+	} {
+		rows = append(rows, fmt.Sprintf("<tr><th>%s</th><td>%s</td></tr>", field[0], html.EscapeString(field[1])))
+	}
+	return c.HTML(http.StatusOK, fmt.Sprintf(`<html><head><title>%s</title></head><body><table>%s</table></body></html>`, html.EscapeString(preview.Name), strings.Join(rows, "")))
+}
+
+// respondDisabledShortcut responds to a visit of a disabled shortcut with the workspace's
+// disabled shortcut message, or as if the shortcut did not exist when there is none.
+func (s *APIV1Service) respondDisabledShortcut(c echo.Context, shortcutName string) error {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(c.Request().Context(), &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+	}
+	message := workspaceSetting.GetDisabledShortcutMessage()
+	if message == "" {
+		return c.Redirect(http.StatusSeeOther, fmt.Sprintf("/404?shortcut=%s", shortcutName))
+	}
+	return c.String(http.StatusNotFound, message)
+}
+
+// respondDeniedHost responds with a warning page instead of redirecting to the link, whose host
+// is denied. The page names the host without linking to it.
+func respondDeniedHost(c echo.Context, link string) error {
+	host := link
+	if linkURL, err := url.Parse(link); err == nil {
+		host = linkURL.Hostname()
+	}
+	// The denylist can be reloaded, so the warning must not outlive it in caches.
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	page := fmt.Sprintf(
+		`<html><head><title>Link blocked</title></head><body><p>This shortcut points to %s, which is not allowed by this workspace.</p></body></html>`,
+		html.EscapeString(host),
+	)
+	return c.HTML(http.StatusForbidden, page)
+}
+
+// resolveOpenGraphImage returns a copy of the shortcut whose relative open graph image is resolved
+// against the shortcut link, which the image of the page is relative to. The shortcuts whose image
+// is absolute, or whose link is not an http URL, are returned untouched.
+func resolveOpenGraphImage(shortcut *storepb.Shortcut) *storepb.Shortcut {
+	image := shortcut.GetOgMetadata().GetImage()
+	link, err := url.Parse(shortcut.Link)
+	if err != nil {
+		return shortcut
+	}
+	resolved := opengraph.ResolveURL(link, image)
+	if resolved == image {
+		return shortcut
+	}
+	shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+	shortcut.OgMetadata.Image = resolved
+	return shortcut
+}
+
+// applyDefaultOpenGraphMetadata returns a copy of the shortcut whose empty open graph metadata
+// fields are filled from the workspace default, leaving the cached shortcut untouched.
+func (s *APIV1Service) applyDefaultOpenGraphMetadata(ctx context.Context, shortcut *storepb.Shortcut) (*storepb.Shortcut, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get workspace setting")
+	}
+	defaultOgMetadata := workspaceSetting.GetDefaultOgMetadata()
+	if defaultOgMetadata == nil {
+		return shortcut, nil
+	}
+
+	link, _ := util.StripURLUserinfo(shortcut.Link)
+	replacer := strings.NewReplacer("{name}", shortcut.Name, "{title}", shortcut.Title, "{link}", link)
+	ogMetadata := &storepb.OpenGraphMetadata{
+		Title:       shortcut.GetOgMetadata().GetTitle(),
+		Description: shortcut.GetOgMetadata().GetDescription(),
+		Image:       shortcut.GetOgMetadata().GetImage(),
+	}
+	if ogMetadata.Title == "" {
+		ogMetadata.Title = replacer.Replace(defaultOgMetadata.Title)
+	}
+	if ogMetadata.Description == "" {
+		ogMetadata.Description = replacer.Replace(defaultOgMetadata.Description)
+	}
+	if ogMetadata.Image == "" {
+		ogMetadata.Image = replacer.Replace(defaultOgMetadata.Image)
+	}
+
+	shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+	shortcut.OgMetadata = ogMetadata
+	return shortcut, nil
+}
+
+// truncateOpenGraphMetadata returns a copy of the shortcut whose open graph metadata fits the maximum
+// lengths, which shortcuts stored before the lengths were enforced or with defaults may exceed.
+// Titles and descriptions are cut at a character boundary, and too long images are dropped as a
+// cut URL would not load anyway.
+func (s *APIV1Service) truncateOpenGraphMetadata(shortcut *storepb.Shortcut) *storepb.Shortcut {
+	ogMetadata := shortcut.GetOgMetadata()
+	maxTitleLength, maxDescriptionLength := s.Profile.GetMaxOgTitleLength(), s.Profile.GetMaxOgDescriptionLength()
+	maxImageLength := s.Profile.GetMaxOgImageLength()
+	if len(ogMetadata.GetTitle()) <= maxTitleLength && len(ogMetadata.GetDescription()) <= maxDescriptionLength && len(ogMetadata.GetImage()) <= maxImageLength {
+		return shortcut
+	}
+
+	shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+	shortcut.OgMetadata.Title = truncateString(shortcut.OgMetadata.Title, maxTitleLength)
+	shortcut.OgMetadata.Description = truncateString(shortcut.OgMetadata.Description, maxDescriptionLength)
+	if len(shortcut.OgMetadata.Image) > maxImageLength {
+		shortcut.OgMetadata.Image = ""
+	}
+	return shortcut
+}
+
+// truncateString returns the longest prefix of the string of at most maxLength bytes that does not
+// cut a character.
+func truncateString(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+	for maxLength > 0 && !utf8.RuneStart(value[maxLength]) {
+		maxLength--
+	}
+	return value[:maxLength]
+}
+
+// applyLinkDecorators returns a copy of the shortcut whose link carries the params of the workspace
+// link decorator and of the decorators of its collections, leaving the cached shortcut untouched.
+// Collection params override the workspace ones, the first created collection winning, and the
+// params already in the link are always kept. Only the collections of the shortcut's creator and
+// of admins apply, so that others can't decorate a shortcut by adding it to their collection.
+func (s *APIV1Service) applyLinkDecorators(ctx context.Context, shortcut *storepb.Shortcut) (*storepb.Shortcut, error) {
+	if shortcut.LinkDecoratorDisabled {
+		return shortcut, nil
+	}
+	link, err := url.Parse(shortcut.Link)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+		return shortcut, nil
+	}
+
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get workspace setting")
+	}
+	params := map[string]string{}
+	maps.Copy(params, workspaceSetting.GetLinkDecorator().GetParams())
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list collections")
+	}
+	// Collections are listed newest first, so the first created one is copied last.
+	for _, collection := range collections {
+		if collection.CreatorId != shortcut.CreatorId {
+			creator, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: &collection.CreatorId,
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "Failed to get user")
+			}
+			if creator == nil || creator.Role != store.RoleAdmin {
+				continue
+			}
+		}
+		maps.Copy(params, collection.GetLinkDecorator().GetParams())
+	}
+
+	query := link.Query()
+	addedParams := url.Values{}
+	for key, value := range params {
+		if !query.Has(key) {
+			addedParams.Set(key, value)
+		}
+	}
+	if len(addedParams) == 0 {
+		return shortcut, nil
+	}
+	// The added params are appended so that the link's own query is left as it was written.
+	if link.RawQuery == "" {
+		link.RawQuery = addedParams.Encode()
+	} else {
+		link.RawQuery += "&" + addedParams.Encode()
+	}
+	shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+	shortcut.Link = link.String()
+	return shortcut, nil
+}
+
+// setRedirectCacheHeaders lets browsers and CDNs cache the redirect of public shortcuts
+// when the workspace has a redirect cache max age. Cached redirects never reach the
+// server, so they are not counted as views. No cache shortcuts are never cached.
+func (s *APIV1Service) setRedirectCacheHeaders(c echo.Context, shortcut *storepb.Shortcut) error {
+	// The redirects of shortcuts with targets are resolved again on each request.
+	if shortcut.NoCache || len(shortcut.Targets.GetLinks()) > 0 || len(shortcut.Targets.GetLocaleLinks()) > 0 {
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return nil
+	}
+	if shortcut.Visibility != storepb.Visibility_PUBLIC {
+		return nil
+	}
+	// Shared caches must not serve redirects that require auth to anonymous users.
+	requireAuth, err := s.isAuthRequiredForRedirects(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	if requireAuth {
+		return nil
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(c.Request().Context(), &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Failed to get workspace setting")
+	}
+	maxAge := workspaceSetting.GetRedirectCacheMaxAge()
+	if maxAge <= 0 {
+		return nil
+	}
+	c.Response().Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	c.Response().Header().Set("Expires", time.Now().Add(time.Duration(maxAge)*time.Second).UTC().Format(http.TimeFormat))
+	return nil
+}
+
+// setRedirectDebugHeaders tells which shortcut a redirect matched, with the credentials of its link removed.
+func setRedirectDebugHeaders(c echo.Context, shortcut *storepb.Shortcut) {
+	target, _ := util.StripURLUserinfo(shortcut.Link)
+	c.Response().Header().Set(ShortcutHeader, shortcut.Name)
+	c.Response().Header().Set(ShortcutTargetHeader, target)
+}
+
+func hasOpenGraphMetadata(shortcut *storepb.Shortcut) bool {
+	return shortcut.OgMetadata != nil && (shortcut.OgMetadata.Title != "" || shortcut.OgMetadata.Description != "" || shortcut.OgMetadata.Image != "")
+}
+
+// redirectToShortcut redirects to the link of the shortcut, or responds with a preview of the
+// open graph metadata with the favicon of the link, when given, which redirects browsers. The
+// preview of a link that is not a valid URL tells visitors that the shortcut has no destination.
+func redirectToShortcut(c echo.Context, shortcut *storepb.Shortcut, icon *favicon.Icon, redirectStatus int) error {
 	isValidURL := isValidURLString(shortcut.Link)
-	if shortcut.OgMetadata == nil || (shortcut.OgMetadata.Title == "" && shortcut.OgMetadata.Description == "" && shortcut.OgMetadata.Image == "") {
+	if !hasOpenGraphMetadata(shortcut) {
 		if isValidURL {
-			return c.Redirect(http.StatusSeeOther, shortcut.Link)
+			return c.Redirect(redirectStatus, shortcut.Link)
 		}
 		return c.String(http.StatusOK, shortcut.Link)
 	}
 
+	// Credentials in the link must not leak into the preview, so the preview is rendered
+	// with the stripped link and the full link is only sent in the Location header.
+	previewLink, hasUserinfo := util.StripURLUserinfo(shortcut.Link)
+
 	htmlTemplate := `<html><head>%s</head><body>%s</body></html>`
 	metadataList := []string{
-		fmt.Sprintf(`<title>%s</title>`, shortcut.OgMetadata.Title),
-		fmt.Sprintf(`<meta name="description" content="%s" />`, shortcut.OgMetadata.Description),
-		fmt.Sprintf(`<meta property="og:title" content="%s" />`, shortcut.OgMetadata.Title),
-		fmt.Sprintf(`<meta property="og:description" content="%s" />`, shortcut.OgMetadata.Description),
-		fmt.Sprintf(`<meta property="og:image" content="%s" />`, shortcut.OgMetadata.Image),
+		fmt.Sprintf(`<title>%s</title>`, html.EscapeString(shortcut.OgMetadata.Title)),
+		fmt.Sprintf(`<meta name="description" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Description)),
+		fmt.Sprintf(`<meta property="og:title" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Title)),
+		fmt.Sprintf(`<meta property="og:description" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Description)),
+		fmt.Sprintf(`<meta property="og:image" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Image)),
 		`<meta property="og:type" content="website" />`,
 		// Twitter related metadata.
-		fmt.Sprintf(`<meta name="twitter:title" content="%s" />`, shortcut.OgMetadata.Title),
-		fmt.Sprintf(`<meta name="twitter:description" content="%s" />`, shortcut.OgMetadata.Description),
-		fmt.Sprintf(`<meta name="twitter:image" content="%s" />`, shortcut.OgMetadata.Image),
+		fmt.Sprintf(`<meta name="twitter:title" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Title)),
+		fmt.Sprintf(`<meta name="twitter:description" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Description)),
+		fmt.Sprintf(`<meta name="twitter:image" content="%s" />`, html.EscapeString(shortcut.OgMetadata.Image)),
 		`<meta name="twitter:card" content="summary_large_image" />`,
 	}
 	if isValidURL {
-		metadataList = append(metadataList, fmt.Sprintf(`<meta property="og:url" content="%s" />`, shortcut.Link))
+		metadataList = append(metadataList, fmt.Sprintf(`<meta property="og:url" content="%s" />`, html.EscapeString(previewLink)))
+	}
+	if icon != nil {
+		metadataList = append(metadataList, fmt.Sprintf(`<link rel="icon" type="%s" href="%s" />`, html.EscapeString(icon.ContentType), icon.DataURL()))
 	}
 	body := ""
-	if isValidURL {
-		body = fmt.Sprintf(`<script>window.location.href = "%s";</script>`, shortcut.Link)
+	if hasUserinfo {
+		body = html.EscapeString(previewLink)
+	} else if isValidURL {
+		body = fmt.Sprintf(`<script>window.location.href = "%s";</script>`, template.JSEscapeString(shortcut.Link))
 	} else {
-		body = html.EscapeString(shortcut.Link)
+		body = renderNoDestination(shortcut)
 	}
 	htmlString := fmt.Sprintf(htmlTemplate, strings.Join(metadataList, ""), body)
+	if hasUserinfo {
+		c.Response().Header().Set(echo.HeaderLocation, shortcut.Link)
+		return c.HTML(redirectStatus, htmlString)
+	}
 	return c.HTML(http.StatusOK, htmlString)
 }
 
+// noDestinationMessage is shown on the previews of the shortcuts whose link is not a valid URL.
+const noDestinationMessage = "This shortcut has no destination to redirect to."
+
+// renderNoDestination returns the body of the preview of a shortcut whose link is not a valid URL,
+// which shows its metadata and its link as text instead of redirecting.
+func renderNoDestination(shortcut *storepb.Shortcut) string {
+	title := shortcut.OgMetadata.Title
+	if title == "" {
+		title = shortcut.Name
+	}
+	body := fmt.Sprintf(`<h1>%s</h1>`, html.EscapeString(title))
+	if shortcut.OgMetadata.Description != "" {
+		body += fmt.Sprintf(`<p>%s</p>`, html.EscapeString(shortcut.OgMetadata.Description))
+	}
+	return body + fmt.Sprintf(`<p>%s</p><pre>%s</pre>`, noDestinationMessage, html.EscapeString(shortcut.Link))
+}
+
+// createShortcutViewActivity records a view of the shortcut: its last view time, and its view
+// activity unless its analytics are disabled.
 func (s *APIV1Service) createShortcutViewActivity(c echo.Context, shortcut *storepb.Shortcut) error {
+	// The last view time is analytics too, which the shortcuts disabling them do not record.
+	if shortcut.DisableAnalytics {
+		return nil
+	}
+	// It is buffered along with the views while analytics are paused.
+	if lastViewedTs := time.Now().Unix(); !s.analyticsPause.addLastView(shortcut.Id, lastViewedTs) {
+		if err := s.Store.UpdateShortcutLastViewedTs(c.Request().Context(), shortcut.Id, lastViewedTs); err != nil {
+			return errors.Wrap(err, "Failed to update shortcut last view time")
+		}
+	}
+	hashIP, err := s.getVisitorIPHasher(c.Request().Context())
+	if err != nil {
+		return err
+	}
 	payload := &ActivityShorcutViewPayload{
 		ShortcutID: shortcut.Id,
-		IP:         c.RealIP(),
+		IP:         hashIP(c.RealIP()),
 		Referer:    c.Request().Referer(),
 		UserAgent:  c.Request().UserAgent(),
+		Locale:     getShortcutLocale(c, shortcut),
+	}
+	if s.Profile.ParseUserAgents {
+		ua := analytics.ParseUserAgent(payload.UserAgent)
+		payload.Browser, payload.OS, payload.DeviceType = ua.Browser, ua.OS, ua.DeviceType
+	}
+	if s.Profile.DiscardRawUserAgents {
+		payload.UserAgent = ""
+	}
+	anonymized, err := s.isVisitorAnonymized(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	if anonymized {
+		// The view is still counted, without anything telling its visitor apart.
+		payload.IP, payload.UserAgent = "", ""
+		payload.Browser, payload.OS, payload.DeviceType = "", "", ""
 	}
 	payloadStr, err := json.Marshal(payload)
 	if err != nil {
@@ -105,8 +770,7 @@ func (s *APIV1Service) createShortcutViewActivity(c echo.Context, shortcut *stor
 		Level:     store.ActivityInfo,
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(c.Request().Context(), activity)
-	if err != nil {
+	if err := s.createActivity(c.Request().Context(), activity); err != nil {
 		return errors.Wrap(err, "Failed to create activity")
 	}
 	return nil
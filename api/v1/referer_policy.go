@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// RefererPolicy is the referers allowed to follow a shortcut, protecting its link from hotlinking.
+// Hosts match their subdomains too, and a policy without hosts allows any referer.
+type RefererPolicy struct {
+	AllowedHosts []string `json:"allowedHosts"`
+	DeniedHosts  []string `json:"deniedHosts"`
+	// AllowEmpty is whether requests without a referer are allowed when allowed hosts are set.
+	AllowEmpty bool `json:"allowEmpty"`
+	// BlockedLink is the link the blocked requests are redirected to, they are forbidden when it is empty.
+	BlockedLink string `json:"blockedLink"`
+}
+
+// hasRefererPolicy returns whether the policy restricts the referers of the shortcut.
+func hasRefererPolicy(policy *storepb.RefererPolicy) bool {
+	return len(policy.GetAllowedHosts()) > 0 || len(policy.GetDeniedHosts()) > 0
+}
+
+// isRefererAllowed returns whether the policy lets requests with the referer follow the shortcut.
+// Referers that are not URLs with a host count as empty.
+func isRefererAllowed(policy *storepb.RefererPolicy, referer string) bool {
+	if !hasRefererPolicy(policy) {
+		return true
+	}
+	host := ""
+	if refererURL, err := url.Parse(referer); err == nil {
+		host = strings.ToLower(refererURL.Hostname())
+	}
+	if host == "" {
+		return len(policy.GetAllowedHosts()) == 0 || policy.GetAllowEmpty()
+	}
+	if matchRefererHost(policy.GetDeniedHosts(), host) {
+		return false
+	}
+	return len(policy.GetAllowedHosts()) == 0 || matchRefererHost(policy.GetAllowedHosts(), host)
+}
+
+// matchRefererHost returns whether the host is one of the hosts or a subdomain of one.
+func matchRefererHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// respondBlockedReferer responds to a request whose referer is not allowed to follow the shortcut,
+// with a redirect to the blocked link of the policy or as forbidden.
+func respondBlockedReferer(c echo.Context, policy *storepb.RefererPolicy) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().Header().Add(echo.HeaderVary, "Referer")
+	if policy.GetBlockedLink() != "" {
+		return c.Redirect(http.StatusSeeOther, policy.GetBlockedLink())
+	}
+	return echo.NewHTTPError(http.StatusForbidden, "referer not allowed to follow the shortcut")
+}
+
+// validateRefererPolicy adds the error of the invalid referer policy to the fields.
+func validateRefererPolicy(fields map[string]string, policy *RefererPolicy, maxLinkLength int) {
+	if policy == nil {
+		return
+	}
+	for _, host := range append(append([]string{}, policy.AllowedHosts...), policy.DeniedHosts...) {
+		if strings.TrimSpace(host) == "" || strings.ContainsAny(host, "/:") {
+			fields["refererPolicy"] = fmt.Sprintf("invalid referer host: %q", host)
+			return
+		}
+	}
+	if policy.BlockedLink != "" {
+		if len(policy.BlockedLink) > maxLinkLength {
+			fields["refererPolicy"] = fmt.Sprintf("blocked link must not be longer than %d bytes", maxLinkLength)
+		} else if !isHTTPURLString(policy.BlockedLink) {
+			fields["refererPolicy"] = "blocked link must be an http or https URL"
+		}
+	}
+}
+
+// convertRefererPolicyToStorepb returns the stored referer policy, and nil for nil.
+func convertRefererPolicyToStorepb(policy *RefererPolicy) *storepb.RefererPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &storepb.RefererPolicy{
+		AllowedHosts: policy.AllowedHosts,
+		DeniedHosts:  policy.DeniedHosts,
+		AllowEmpty:   policy.AllowEmpty,
+		BlockedLink:  policy.BlockedLink,
+	}
+}
+
+func convertRefererPolicyFromStorepb(policy *storepb.RefererPolicy) *RefererPolicy {
+	return &RefererPolicy{
+		AllowedHosts: append([]string{}, policy.GetAllowedHosts()...),
+		DeniedHosts:  append([]string{}, policy.GetDeniedHosts()...),
+		AllowEmpty:   policy.GetAllowEmpty(),
+		BlockedLink:  policy.GetBlockedLink(),
+	}
+}
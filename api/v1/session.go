@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// UserSession is an active access token of a user, the sign ins as well as the API tokens.
+type UserSession struct {
+	// ID identifies the session, it is derived from the access token, which is never responded.
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	CreatedTs   int64  `json:"createdTs"`
+	LastUsedTs  int64  `json:"lastUsedTs"`
+	// ExpiresTs is zero for the access tokens that never expire.
+	ExpiresTs int64  `json:"expiresTs"`
+	UserAgent string `json:"userAgent"`
+	IP        string `json:"ip"`
+	// Current is whether the session is the one of the request.
+	Current bool `json:"current"`
+}
+
+func (s *APIV1Service) registerSessionRoutes(g *echo.Group, secret string) {
+	g.GET("/users/:id/sessions", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, err := s.checkSessionsAccess(c)
+		if err != nil {
+			return err
+		}
+
+		accessTokens, err := s.Store.GetUserAccessTokens(ctx, userID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get user access tokens, err: %s", err)).SetInternal(err)
+		}
+		currentAccessToken := findAccessToken(c)
+		sessions := []*UserSession{}
+		for _, accessToken := range accessTokens {
+			claims, err := parseAccessTokenClaims(accessToken.AccessToken, secret)
+			if err != nil {
+				// The expired and invalid access tokens are no longer sessions.
+				continue
+			}
+			session := &UserSession{
+				ID:          getSessionID(accessToken.AccessToken),
+				Description: accessToken.Description,
+				CreatedTs:   accessToken.CreatedTs,
+				LastUsedTs:  accessToken.LastUsedTs,
+				UserAgent:   accessToken.UserAgent,
+				IP:          accessToken.Ip,
+				Current:     accessToken.AccessToken == currentAccessToken,
+			}
+			if claims.ExpiresAt != nil {
+				session.ExpiresTs = claims.ExpiresAt.Unix()
+			}
+			sessions = append(sessions, session)
+		}
+		// Sort by the creation time in descending order.
+		slices.SortStableFunc(sessions, func(i, j *UserSession) int {
+			return int(j.CreatedTs - i.CreatedTs)
+		})
+		return c.JSON(http.StatusOK, sessions)
+	})
+
+	g.DELETE("/users/:id/sessions/:sessionId", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, err := s.checkSessionsAccess(c)
+		if err != nil {
+			return err
+		}
+
+		sessionID := c.Param("sessionId")
+		found := false
+		if err := s.Store.UpdateUserAccessTokens(ctx, userID, func(accessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+			remaining := []*storepb.AccessTokensUserSetting_AccessToken{}
+			for _, accessToken := range accessTokens {
+				if getSessionID(accessToken.AccessToken) == sessionID {
+					found = true
+					continue
+				}
+				remaining = append(remaining, accessToken)
+			}
+			return remaining
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
+		}
+		if !found {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("session not found: %s", sessionID))
+		}
+		return c.JSON(http.StatusOK, true)
+	})
+}
+
+// checkSessionsAccess returns the id of the user whose sessions are requested, which are accessible
+// to the user themselves and to the admins.
+func (s *APIV1Service) checkSessionsAccess(c echo.Context) (int32, error) {
+	currentUserID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return 0, echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	userID, err := util.ConvertStringToInt32(c.Param("id"))
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("user id is not a number: %s", c.Param("id"))).SetInternal(err)
+	}
+	if userID != currentUserID {
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return 0, err
+		}
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	if user == nil {
+		return 0, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("user not found with ID: %d", userID))
+	}
+	return userID, nil
+}
+
+// getSessionID returns the id of the session of the access token, a short hash of it, so that the
+// sessions can be revoked without the access tokens being responded.
+func getSessionID(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:8])
+}
@@ -4,15 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/internal/language"
+	"github.com/yourselfhosted/slash/internal/slug"
+	"github.com/yourselfhosted/slash/internal/timezone"
 	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -32,6 +42,37 @@ func (v Visibility) String() string {
 	return string(v)
 }
 
+// ApprovalStatus is the type of a shortcut approval status.
+type ApprovalStatus string
+
+const (
+	// ApprovalStatusApproved is the APPROVED approval status.
+	ApprovalStatusApproved ApprovalStatus = "APPROVED"
+	// ApprovalStatusPending is the PENDING approval status.
+	ApprovalStatusPending ApprovalStatus = "PENDING"
+)
+
+// TargetMode is the type of how the redirects of a shortcut are spread over its targets.
+type TargetMode string
+
+const (
+	// TargetModeRoundRobin is the ROUND_ROBIN target mode.
+	TargetModeRoundRobin TargetMode = "ROUND_ROBIN"
+	// TargetModeFailover is the FAILOVER target mode.
+	TargetModeFailover TargetMode = "FAILOVER"
+	// TargetModeRandom is the RANDOM target mode.
+	TargetModeRandom TargetMode = "RANDOM"
+)
+
+// ShortcutTargets is the additional links of a shortcut, which its redirects are spread over along
+// with its link. An empty mode is round robin. The redirects of the requests whose Accept-Language
+// prefers a language of LocaleLinks, such as "fr" or "pt-BR", go to its link instead.
+type ShortcutTargets struct {
+	Links       []string          `json:"links"`
+	Mode        TargetMode        `json:"mode"`
+	LocaleLinks map[string]string `json:"localeLinks"`
+}
+
 type OpenGraphMetadata struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
@@ -49,35 +90,92 @@ type Shortcut struct {
 	RowStatus RowStatus `json:"rowStatus"`
 
 	// Domain specific fields
-	Name              string             `json:"name"`
-	Link              string             `json:"link"`
-	Title             string             `json:"title"`
-	Description       string             `json:"description"`
-	Visibility        Visibility         `json:"visibility"`
-	Tags              []string           `json:"tags"`
-	View              int                `json:"view"`
-	OpenGraphMetadata *OpenGraphMetadata `json:"openGraphMetadata"`
+	Name                  string             `json:"name"`
+	Link                  string             `json:"link"`
+	Title                 string             `json:"title"`
+	Description           string             `json:"description"`
+	Visibility            Visibility         `json:"visibility"`
+	Tags                  []string           `json:"tags"`
+	View                  int                `json:"view"`
+	OpenGraphMetadata     *OpenGraphMetadata `json:"openGraphMetadata"`
+	Enabled               bool               `json:"enabled"`
+	ApprovalStatus        ApprovalStatus     `json:"approvalStatus"`
+	LinkDecoratorDisabled bool               `json:"linkDecoratorDisabled"`
+	NoCache               bool               `json:"noCache"`
+	Interstitial          bool               `json:"interstitial"`
+	DisableAnalytics      bool               `json:"disableAnalytics"`
+	RedirectDelayMs       int32              `json:"redirectDelayMs"`
+	Goal                  string             `json:"goal"`
+	LastViewedTs          int64              `json:"lastViewedTs"`
+	ExpiresTs             int64              `json:"expiresTs"`
+	Targets               *ShortcutTargets   `json:"targets"`
+	RefererPolicy         *RefererPolicy     `json:"refererPolicy"`
+	// ExpiresAt is the RFC 3339 time of ExpiresTs in the workspace timezone, empty when the shortcut never expires.
+	ExpiresAt string `json:"expiresAt,omitempty"`
 }
 
 type CreateShortcutRequest struct {
-	Name              string             `json:"name"`
-	Link              string             `json:"link"`
-	Title             string             `json:"title"`
-	Description       string             `json:"description"`
-	Visibility        Visibility         `json:"visibility"`
-	Tags              []string           `json:"tags"`
-	OpenGraphMetadata *OpenGraphMetadata `json:"openGraphMetadata"`
+	Name                  string             `json:"name"`
+	Link                  string             `json:"link"`
+	Title                 string             `json:"title"`
+	Description           string             `json:"description"`
+	Visibility            Visibility         `json:"visibility"`
+	Tags                  []string           `json:"tags"`
+	OpenGraphMetadata     *OpenGraphMetadata `json:"openGraphMetadata"`
+	LinkDecoratorDisabled bool               `json:"linkDecoratorDisabled"`
+	NoCache               bool               `json:"noCache"`
+	Interstitial          bool               `json:"interstitial"`
+	DisableAnalytics      bool               `json:"disableAnalytics"`
+	RedirectDelayMs       int32              `json:"redirectDelayMs"`
+	Goal                  string             `json:"goal"`
+	ExpiresTs             int64              `json:"expiresTs"`
+	Targets               *ShortcutTargets   `json:"targets"`
+	RefererPolicy         *RefererPolicy     `json:"refererPolicy"`
+	// ExpiresAt sets ExpiresTs from an RFC 3339 time, or from a time without an offset such as
+	// "2024-03-31T09:00" in the workspace timezone, and cannot be combined with ExpiresTs.
+	ExpiresAt string `json:"expiresAt"`
+	// CreatorID is the owner of the shortcut, which only admins may set to another user.
+	CreatorID *int32 `json:"creatorId"`
 }
 
 type PatchShortcutRequest struct {
-	RowStatus         *RowStatus         `json:"rowStatus"`
-	Name              *string            `json:"name"`
-	Link              *string            `json:"link"`
-	Title             *string            `json:"title"`
-	Description       *string            `json:"description"`
-	Visibility        *Visibility        `json:"visibility"`
-	Tags              []string           `json:"tags"`
-	OpenGraphMetadata *OpenGraphMetadata `json:"openGraphMetadata"`
+	RowStatus             *RowStatus         `json:"rowStatus"`
+	Name                  *string            `json:"name"`
+	Link                  *string            `json:"link"`
+	Title                 *string            `json:"title"`
+	Description           *string            `json:"description"`
+	Visibility            *Visibility        `json:"visibility"`
+	Tags                  []string           `json:"tags"`
+	OpenGraphMetadata     *OpenGraphMetadata `json:"openGraphMetadata"`
+	Enabled               *bool              `json:"enabled"`
+	LinkDecoratorDisabled *bool              `json:"linkDecoratorDisabled"`
+	NoCache               *bool              `json:"noCache"`
+	Interstitial          *bool              `json:"interstitial"`
+	DisableAnalytics      *bool              `json:"disableAnalytics"`
+	RedirectDelayMs       *int32             `json:"redirectDelayMs"`
+	Goal                  *string            `json:"goal"`
+	ExpiresTs             *int64             `json:"expiresTs"`
+	Targets               *ShortcutTargets   `json:"targets"`
+	RefererPolicy         *RefererPolicy     `json:"refererPolicy"`
+	// ExpiresAt sets ExpiresTs as in CreateShortcutRequest, an empty ExpiresAt never expires.
+	ExpiresAt *string `json:"expiresAt"`
+	// AddTags and RemoveTags edit the current tags instead of replacing them, and cannot be
+	// combined with Tags.
+	AddTags    []string `json:"addTags"`
+	RemoveTags []string `json:"removeTags"`
+}
+
+// CloneShortcutRequest is the request to copy a shortcut into a new shortcut of the current user.
+// The name defaults to a free "<name>-copy" name, and the visibility to PRIVATE.
+type CloneShortcutRequest struct {
+	Name       string     `json:"name"`
+	Visibility Visibility `json:"visibility"`
+}
+
+// UpdateShortcutTagsRequest is the request to add or remove tags of many shortcuts at once.
+type UpdateShortcutTagsRequest struct {
+	ShortcutIDs []int32  `json:"shortcutIds"`
+	Tags        []string `json:"tags"`
 }
 
 func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
@@ -89,21 +187,83 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		}
 		create := &CreateShortcutRequest{}
 		if err := json.NewDecoder(c.Request().Body).Decode(create); err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("malformatted post shortcut request, err: %s", err)).SetInternal(err)
+			return newDecodeError("malformatted post shortcut request", err)
+		}
+		if strings.TrimSpace(create.Name) == "" && s.SlugGenerator != nil {
+			name, err := s.generateShortcutName(ctx)
+			if err != nil {
+				return err
+			}
+			create.Name = name
+		}
+		if fields := validateCreateShortcutRequest(create, s.Profile); len(fields) > 0 {
+			return newValidationError("invalid shortcut", fields)
+		}
+		if create.ExpiresAt != "" {
+			expiresTs, err := s.parseExpiresAt(ctx, create.ExpiresAt)
+			if err != nil {
+				return err
+			}
+			create.ExpiresTs = expiresTs
 		}
 
 		shortcut := &storepb.Shortcut{
-			CreatorId:   userID,
-			Name:        create.Name,
-			Link:        create.Link,
-			Title:       create.Title,
-			Description: create.Description,
-			Visibility:  convertVisibilityToStorepb(create.Visibility),
-			Tags:        create.Tags,
-			OgMetadata:  &storepb.OpenGraphMetadata{},
-		}
-		if create.Name == "" {
-			return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+			CreatorId:             userID,
+			Name:                  create.Name,
+			Link:                  create.Link,
+			Title:                 create.Title,
+			Description:           create.Description,
+			Visibility:            convertVisibilityToStorepb(create.Visibility),
+			Tags:                  create.Tags,
+			OgMetadata:            &storepb.OpenGraphMetadata{},
+			LinkDecoratorDisabled: create.LinkDecoratorDisabled,
+			NoCache:               create.NoCache,
+			Interstitial:          create.Interstitial,
+			DisableAnalytics:      create.DisableAnalytics,
+			RedirectDelayMs:       create.RedirectDelayMs,
+			Goal:                  create.Goal,
+			ExpiresTs:             create.ExpiresTs,
+			Targets:               convertShortcutTargetsToStorepb(create.Targets),
+			RefererPolicy:         convertRefererPolicyToStorepb(create.RefererPolicy),
+		}
+		currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+		}
+		if create.CreatorID != nil && *create.CreatorID != userID {
+			if currentUser.Role != store.RoleAdmin {
+				return echo.NewHTTPError(http.StatusForbidden, "only admins can set the creator of a shortcut")
+			}
+			creator, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: create.CreatorID,
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+			}
+			if creator == nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("not found user with id: %d", *create.CreatorID))
+			}
+			shortcut.CreatorId = creator.ID
+		}
+		if err := s.checkShortcutName(currentUser, create.Name); err != nil {
+			return err
+		}
+		if err := s.checkShortcutLinks(shortcut); err != nil {
+			return err
+		}
+		if err := s.checkShortcutCreationRate(c, currentUser); err != nil {
+			return err
+		}
+		if shortcut.Visibility == storepb.Visibility_PUBLIC {
+			approvalRequired, err := s.isPublicShortcutApprovalRequired(ctx, currentUser)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+			}
+			if approvalRequired {
+				shortcut.ApprovalStatus = storepb.ApprovalStatus_PENDING
+			}
 		}
 		if create.OpenGraphMetadata != nil {
 			shortcut.OgMetadata = &storepb.OpenGraphMetadata{
@@ -112,7 +272,13 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 				Image:       create.OpenGraphMetadata.Image,
 			}
 		}
-		shortcut, err := s.Store.CreateShortcut(ctx, shortcut)
+		if _, hasUserinfo := util.StripURLUserinfo(shortcut.Link); hasUserinfo {
+			slog.WarnContext(ctx, "shortcut link contains credentials, they are hidden from previews but sent on redirect", "name", shortcut.Name)
+		}
+		shortcut, err = s.Store.CreateShortcut(ctx, shortcut)
+		if isValidationError(err) {
+			return err
+		}
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create shortcut, err: %s", err)).SetInternal(err)
 		}
@@ -127,7 +293,7 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		}
 		metric.Enqueue("shortcut create")
 		return c.JSON(http.StatusOK, shortcutMessage)
-	})
+	}, s.idempotencyMiddleware)
 
 	g.PATCH("/shortcut/:shortcutId", func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -139,6 +305,20 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		if !ok {
 			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
 		}
+		patch := &PatchShortcutRequest{}
+		if err := json.NewDecoder(c.Request().Body).Decode(patch); err != nil {
+			return newDecodeError("failed to decode patch shortcut request", err)
+		}
+		if fields := validatePatchShortcutRequest(patch, s.Profile); len(fields) > 0 {
+			return newValidationError("invalid shortcut", fields)
+		}
+		if patch.ExpiresAt != nil {
+			expiresTs, err := s.parseExpiresAt(ctx, *patch.ExpiresAt)
+			if err != nil {
+				return err
+			}
+			patch.ExpiresTs = &expiresTs
+		}
 		currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
 			ID: &userID,
 		})
@@ -155,21 +335,49 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		if shortcut == nil {
 			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
 		}
-		if shortcut.CreatorId != userID && currentUser.Role != store.RoleAdmin {
+		canEdit, err := s.canEditShortcut(ctx, shortcut, currentUser)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		if !canEdit {
 			return echo.NewHTTPError(http.StatusForbidden, "unauthorized to update shortcut")
 		}
 
-		patch := &PatchShortcutRequest{}
-		if err := json.NewDecoder(c.Request().Body).Decode(patch); err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("failed to decode patch shortcut request, err: %s", err)).SetInternal(err)
+		if patch.Name != nil && *patch.Name != shortcut.Name {
+			if err := s.checkShortcutName(currentUser, *patch.Name); err != nil {
+				return err
+			}
+		}
+		// Only the patched links are checked, so that the other fields of a shortcut whose link was
+		// denied later can still be edited.
+		patchedLinks := &storepb.Shortcut{
+			Targets: convertShortcutTargetsToStorepb(patch.Targets),
+		}
+		if patch.Link != nil {
+			patchedLinks.Link = *patch.Link
+		}
+		if err := s.checkShortcutLinks(patchedLinks); err != nil {
+			return err
 		}
 
 		shortcutUpdate := &store.UpdateShortcut{
-			ID:          shortcutID,
-			Name:        patch.Name,
-			Link:        patch.Link,
-			Title:       patch.Title,
-			Description: patch.Description,
+			ID:                    shortcutID,
+			Name:                  patch.Name,
+			Link:                  patch.Link,
+			Title:                 patch.Title,
+			Description:           patch.Description,
+			Enabled:               patch.Enabled,
+			LinkDecoratorDisabled: patch.LinkDecoratorDisabled,
+			NoCache:               patch.NoCache,
+			Interstitial:          patch.Interstitial,
+			DisableAnalytics:      patch.DisableAnalytics,
+			RedirectDelayMs:       patch.RedirectDelayMs,
+			Goal:                  patch.Goal,
+			ExpiresTs:             patch.ExpiresTs,
+			Targets:               convertShortcutTargetsToStorepb(patch.Targets),
+			RefererPolicy:         convertRefererPolicyToStorepb(patch.RefererPolicy),
+			AddTags:               patch.AddTags,
+			RemoveTags:            patch.RemoveTags,
 		}
 		if patch.RowStatus != nil {
 			shortcutUpdate.RowStatus = (*store.RowStatus)(patch.RowStatus)
@@ -177,8 +385,18 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		if patch.Visibility != nil {
 			shortcutUpdate.Visibility = (*store.Visibility)(patch.Visibility)
 		}
+		if shortcutUpdate.ChangesApprovedShortcut(shortcut) {
+			approvalRequired, err := s.isPublicShortcutApprovalRequired(ctx, currentUser)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+			}
+			if approvalRequired {
+				approvalStatus := storepb.ApprovalStatus_PENDING
+				shortcutUpdate.ApprovalStatus = &approvalStatus
+			}
+		}
 		if patch.Tags != nil {
-			tag := strings.Join(patch.Tags, " ")
+			tag := strings.Join(s.Store.NormalizeTags(patch.Tags), " ")
 			shortcutUpdate.Tag = &tag
 		}
 		if patch.OpenGraphMetadata != nil {
@@ -189,6 +407,14 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 			}
 		}
 		shortcut, err = s.Store.UpdateShortcut(ctx, shortcutUpdate)
+		if errors.Is(err, store.ErrTooManyTags) {
+			return newValidationError("invalid shortcut", map[string]string{
+				"addTags": fmt.Sprintf("a shortcut must not have more than %d tags", s.Profile.GetMaxTagsPerShortcut()),
+			})
+		}
+		if isValidationError(err) {
+			return err
+		}
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to patch shortcut, err: %s", err)).SetInternal(err)
 		}
@@ -207,26 +433,32 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
 		}
 
-		find := &store.FindShortcut{}
-		if tag := c.QueryParam("tag"); tag != "" {
-			find.Tag = &tag
+		currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
 		}
 
-		list := []*storepb.Shortcut{}
-		find.VisibilityList = []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic}
-		visibleShortcutList, err := s.Store.ListShortcuts(ctx, find)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch shortcut list, err: %s", err)).SetInternal(err)
+		find := &store.FindShortcut{}
+		if tags := s.Store.NormalizeTags([]string{c.QueryParam("tag")}); len(tags) != 0 {
+			find.Tag = &tags[0]
+		}
+		if v := c.QueryParam("notViewedSince"); v != "" {
+			notViewedSince, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("notViewedSince must be a unix timestamp: %s", v))
+			}
+			find.NotViewedSince = &notViewedSince
 		}
-		list = append(list, visibleShortcutList...)
 
-		find.VisibilityList = []store.Visibility{store.VisibilityPrivate}
-		find.CreatorID = &userID
-		privateShortcutList, err := s.Store.ListShortcuts(ctx, find)
+		list, err := s.listVisibleShortcuts(ctx, currentUser, find)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch private shortcut list, err: %s", err)).SetInternal(err)
+			return err
+		}
+		if acceptsProtobuf(c) {
+			return respondProtobuf(c, http.StatusOK, &storepb.ShortcutList{Shortcuts: list})
 		}
-		list = append(list, privateShortcutList...)
 
 		shortcutMessageList := []*Shortcut{}
 		for _, shortcut := range list {
@@ -255,6 +487,21 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		if shortcut == nil {
 			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
 		}
+		if shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING {
+			userID, _ := c.Get(userIDContextKey).(int32)
+			currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: &userID,
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+			}
+			if !canViewPendingShortcut(shortcut, currentUser) {
+				return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+			}
+		}
+		if acceptsProtobuf(c) {
+			return respondProtobuf(c, http.StatusOK, shortcut)
+		}
 
 		shortcutMessage, err := s.composeShortcut(ctx, convertShortcutFromStorepb(shortcut))
 		if err != nil {
@@ -263,6 +510,52 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 		return c.JSON(http.StatusOK, shortcutMessage)
 	})
 
+	// The actions on a shortcut are routed as "/shortcuts/:id:<action>", as the router cannot
+	// match a static suffix after a path parameter.
+	g.POST("/shortcuts/:idAction", func(c echo.Context) error {
+		id, action, _ := strings.Cut(c.Param("idAction"), ":")
+		shortcutID, err := util.ConvertStringToInt32(id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("shortcut id is not a number: %s", id)).SetInternal(err)
+		}
+		switch action {
+		case "approve":
+			return s.approveShortcut(c, shortcutID)
+		case "clone":
+			return s.cloneShortcut(c, shortcutID)
+		case "refreshMetadata":
+			return s.refreshShortcutMetadata(c, shortcutID)
+		default:
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown shortcut action: %s", action))
+		}
+	})
+
+	// The shortcut names are resolved as "/shortcuts/:name:redirect", so that apps can navigate on their own.
+	g.GET("/shortcuts/:nameAction", func(c echo.Context) error {
+		nameAction := c.Param("nameAction")
+		index := strings.LastIndex(nameAction, ":")
+		if index < 0 || nameAction[index+1:] != "redirect" {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown shortcut action: %s", nameAction))
+		}
+		return s.resolveShortcutRedirect(c, nameAction[:index])
+	})
+
+	g.POST("/shortcuts\\:addTags", func(c echo.Context) error {
+		return s.updateShortcutTags(c, true)
+	})
+
+	g.POST("/shortcuts\\:removeTags", func(c echo.Context) error {
+		return s.updateShortcutTags(c, false)
+	})
+
+	g.GET("/shortcuts\\:expiring", func(c echo.Context) error {
+		return s.listExpiringShortcuts(c)
+	})
+
+	g.GET("/shortcuts\\:hosts", func(c echo.Context) error {
+		return s.listShortcutHosts(c)
+	})
+
 	g.DELETE("/shortcut/:id", func(c echo.Context) error {
 		ctx := c.Request().Context()
 		shortcutID, err := util.ConvertStringToInt32(c.Param("id"))
@@ -301,6 +594,404 @@ func (s *APIV1Service) registerShortcutRoutes(g *echo.Group) {
 	})
 }
 
+// approveShortcut lets a pending shortcut go live, only admins can approve shortcuts.
+func (s *APIV1Service) approveShortcut(c echo.Context, shortcutID int32) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	if currentUser == nil || currentUser.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "only admins can approve shortcuts")
+	}
+
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+	}
+	if shortcut == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+	}
+	if shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING {
+		approvalStatus := storepb.ApprovalStatus_APPROVED
+		shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:             shortcutID,
+			ApprovalStatus: &approvalStatus,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to approve shortcut, err: %s", err)).SetInternal(err)
+		}
+	}
+
+	shortcutMessage, err := s.composeShortcut(ctx, convertShortcutFromStorepb(shortcut))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to compose shortcut, err: %s", err)).SetInternal(err)
+	}
+	return c.JSON(http.StatusOK, shortcutMessage)
+}
+
+// maxCloneNameAttempts is the number of generated names tried for a clone before giving up.
+const maxCloneNameAttempts = 100
+
+// cloneShortcut copies the link, metadata and tags of a shortcut the current user can view into
+// a new shortcut owned by the current user.
+func (s *APIV1Service) cloneShortcut(c echo.Context, shortcutID int32) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	clone := &CloneShortcutRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(clone); err != nil && !errors.Is(err, io.EOF) {
+		return newDecodeError("malformatted clone shortcut request", err)
+	}
+	if clone.Visibility == "" {
+		clone.Visibility = VisibilityPrivate
+	}
+	if !isValidVisibility(clone.Visibility) {
+		return newValidationError("invalid shortcut", map[string]string{
+			"visibility": fmt.Sprintf("invalid visibility: %s", clone.Visibility),
+		})
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+
+	source, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+	}
+	// The shortcuts the user cannot view are not found, so that they cannot be probed.
+	if source == nil || !canViewPendingShortcut(source, currentUser) || (source.Visibility == storepb.Visibility_PRIVATE && source.CreatorId != userID) {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+	}
+
+	name := strings.TrimSpace(clone.Name)
+	if name == "" {
+		name, err = s.findCloneName(ctx, source.Name)
+		if err != nil {
+			return err
+		}
+	} else {
+		existing, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+		}
+		if existing != nil {
+			return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("shortcut name %q is already taken", name))
+		}
+	}
+	if err := s.checkShortcutName(currentUser, name); err != nil {
+		return err
+	}
+	if err := s.checkShortcutLinks(source); err != nil {
+		return err
+	}
+	if err := s.checkShortcutCreationRate(c, currentUser); err != nil {
+		return err
+	}
+
+	shortcut := &storepb.Shortcut{
+		CreatorId:             userID,
+		Name:                  name,
+		Link:                  source.Link,
+		Title:                 source.Title,
+		Description:           source.Description,
+		Visibility:            convertVisibilityToStorepb(clone.Visibility),
+		Tags:                  source.Tags,
+		OgMetadata:            &storepb.OpenGraphMetadata{},
+		LinkDecoratorDisabled: source.LinkDecoratorDisabled,
+		NoCache:               source.NoCache,
+		Interstitial:          source.Interstitial,
+		DisableAnalytics:      source.DisableAnalytics,
+		RedirectDelayMs:       source.RedirectDelayMs,
+		Goal:                  source.Goal,
+		ExpiresTs:             source.ExpiresTs,
+		Targets:               source.Targets,
+		RefererPolicy:         source.RefererPolicy,
+	}
+	if source.OgMetadata != nil {
+		shortcut.OgMetadata = &storepb.OpenGraphMetadata{
+			Title:       source.OgMetadata.Title,
+			Description: source.OgMetadata.Description,
+			Image:       source.OgMetadata.Image,
+		}
+	}
+	if shortcut.Visibility == storepb.Visibility_PUBLIC {
+		approvalRequired, err := s.isPublicShortcutApprovalRequired(ctx, currentUser)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+		if approvalRequired {
+			shortcut.ApprovalStatus = storepb.ApprovalStatus_PENDING
+		}
+	}
+	shortcut, err = s.Store.CreateShortcut(ctx, shortcut)
+	if isValidationError(err) {
+		return err
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create shortcut, err: %s", err)).SetInternal(err)
+	}
+	if err := s.createShortcutCreateActivity(ctx, shortcut); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create shortcut activity, err: %s", err)).SetInternal(err)
+	}
+
+	shortcutMessage, err := s.composeShortcut(ctx, convertShortcutFromStorepb(shortcut))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to compose shortcut, err: %s", err)).SetInternal(err)
+	}
+	metric.Enqueue("shortcut clone")
+	return c.JSON(http.StatusOK, shortcutMessage)
+}
+
+// findCloneName returns the first free name among "<name>-copy", "<name>-copy-2" and so on.
+func (s *APIV1Service) findCloneName(ctx context.Context, sourceName string) (string, error) {
+	for i := 1; i <= maxCloneNameAttempts; i++ {
+		name := sourceName + "-copy"
+		if i > 1 {
+			name = fmt.Sprintf("%s-copy-%d", sourceName, i)
+		}
+		existing, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+		}
+		if existing == nil {
+			return name, nil
+		}
+	}
+	return "", echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("no free name to clone shortcut %q", sourceName))
+}
+
+// generateShortcutName returns a generated name that is free and allowed by the blocklist.
+func (s *APIV1Service) generateShortcutName(ctx context.Context) (string, error) {
+	name, err := slug.GenerateShortcutName(ctx, s.SlugGenerator, s.Blocklist, s.Store)
+	if errors.Is(err, slug.ErrNoFreeName) {
+		return "", echo.NewHTTPError(http.StatusConflict, "no free name generated for the shortcut")
+	}
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+	}
+	return name, nil
+}
+
+// isPublicShortcutApprovalRequired returns whether the public shortcuts of the user wait for the approval of an admin.
+func (s *APIV1Service) isPublicShortcutApprovalRequired(ctx context.Context, user *store.User) (bool, error) {
+	if user.Role == store.RoleAdmin {
+		return false, nil
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return workspaceSetting.GetRequirePublicShortcutApproval(), nil
+}
+
+// canViewPendingShortcut returns whether the user can see the shortcut, pending shortcuts are
+// only visible to their creator and to admins.
+func canViewPendingShortcut(shortcut *storepb.Shortcut, user *store.User) bool {
+	if shortcut.ApprovalStatus != storepb.ApprovalStatus_PENDING {
+		return true
+	}
+	return user != nil && (shortcut.CreatorId == user.ID || user.Role == store.RoleAdmin)
+}
+
+// checkShortcutLinks returns an error if the link, a target link or a locale link of the shortcut
+// points to a denied host.
+func (s *APIV1Service) checkShortcutLinks(shortcut *storepb.Shortcut) error {
+	for _, link := range append(targetLinks(shortcut), localeLinks(shortcut)...) {
+		if s.HostDenylist.ContainsURL(link) {
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("shortcut link %q points to a denied host", link))
+		}
+	}
+	return nil
+}
+
+// checkShortcutName returns an error if the name is blocked, or requires a review that the user cannot give.
+// Admins can use the names that require a review.
+func (s *APIV1Service) checkShortcutName(user *store.User, name string) error {
+	switch s.Blocklist.Check(name) {
+	case blocklist.Blocked:
+		return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("shortcut name %q is not allowed", name))
+	case blocklist.ReviewRequired:
+		if user.Role != store.RoleAdmin {
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("shortcut name %q requires the approval of an admin", name))
+		}
+	}
+	return nil
+}
+
+// listVisibleShortcuts returns the shortcuts matching the find that the user can view: the workspace
+// and public ones, and the private ones the user created. Pending shortcuts are left out for others than
+// their creator and admins.
+func (s *APIV1Service) listVisibleShortcuts(ctx context.Context, currentUser *store.User, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
+	list := []*storepb.Shortcut{}
+	find.VisibilityList = []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic}
+	visibleShortcutList, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch shortcut list, err: %s", err)).SetInternal(err)
+	}
+	list = append(list, visibleShortcutList...)
+
+	find.VisibilityList = []store.Visibility{store.VisibilityPrivate}
+	find.CreatorID = &currentUser.ID
+	privateShortcutList, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch private shortcut list, err: %s", err)).SetInternal(err)
+	}
+	list = append(list, privateShortcutList...)
+
+	return slices.DeleteFunc(list, func(shortcut *storepb.Shortcut) bool {
+		return !canViewPendingShortcut(shortcut, currentUser)
+	}), nil
+}
+
+// ShortcutHost is a host the links of shortcuts point at, with the number of those shortcuts.
+type ShortcutHost struct {
+	Host  string `json:"host"`
+	Count int    `json:"count"`
+}
+
+// listShortcutHosts responds with the distinct hosts of the links of the shortcuts the current user
+// can view, the most linked first. Links without a host, such as plain texts, are left out.
+func (s *APIV1Service) listShortcutHosts(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	list, err := s.listVisibleShortcuts(ctx, currentUser, &store.FindShortcut{})
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	for _, shortcut := range list {
+		link, err := url.Parse(shortcut.Link)
+		if err != nil || link.Hostname() == "" {
+			continue
+		}
+		counts[strings.ToLower(link.Hostname())]++
+	}
+	hosts := []*ShortcutHost{}
+	for host, count := range counts {
+		hosts = append(hosts, &ShortcutHost{
+			Host:  host,
+			Count: count,
+		})
+	}
+	slices.SortFunc(hosts, func(a, b *ShortcutHost) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.Host, b.Host)
+	})
+	return c.JSON(http.StatusOK, hosts)
+}
+
+// updateShortcutTags adds or removes the tags of the requested shortcuts in a single transaction.
+// The shortcuts that are missing or that the current user cannot update are skipped, and only
+// the updated shortcuts are returned.
+func (s *APIV1Service) updateShortcutTags(c echo.Context, add bool) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+
+	request := &UpdateShortcutTagsRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("malformatted update shortcut tags request, err: %s", err)).SetInternal(err)
+	}
+	if len(request.Tags) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "tags are required")
+	}
+	for _, tag := range request.Tags {
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid tag: %q", tag))
+		}
+		if len(tag) > s.Profile.GetMaxTagLength() {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("tags must not be longer than %d bytes", s.Profile.GetMaxTagLength()))
+		}
+	}
+
+	update := &store.UpdateShortcutTags{}
+	if add {
+		update.AddTags = request.Tags
+	} else {
+		update.RemoveTags = request.Tags
+	}
+	for _, shortcutID := range request.ShortcutIDs {
+		shortcutID := shortcutID
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &shortcutID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+		}
+		if shortcut == nil {
+			continue
+		}
+		canEdit, err := s.canEditShortcut(ctx, shortcut, currentUser)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		if !canEdit {
+			continue
+		}
+		update.IDList = append(update.IDList, shortcutID)
+	}
+
+	shortcuts, err := s.Store.UpdateShortcutTags(ctx, update)
+	if errors.Is(err, store.ErrTooManyTags) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("a shortcut must not have more than %d tags, err: %s", s.Profile.GetMaxTagsPerShortcut(), err)).SetInternal(err)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to update shortcut tags, err: %s", err)).SetInternal(err)
+	}
+
+	shortcutMessageList := []*Shortcut{}
+	for _, shortcut := range shortcuts {
+		shortcutMessage, err := s.composeShortcut(ctx, convertShortcutFromStorepb(shortcut))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to compose shortcut, err: %s", err)).SetInternal(err)
+		}
+		shortcutMessageList = append(shortcutMessageList, shortcutMessage)
+	}
+	return c.JSON(http.StatusOK, shortcutMessageList)
+}
+
 func (s *APIV1Service) composeShortcut(ctx context.Context, shortcut *Shortcut) (*Shortcut, error) {
 	if shortcut == nil {
 		return nil, nil
@@ -326,6 +1017,13 @@ func (s *APIV1Service) composeShortcut(ctx context.Context, shortcut *Shortcut)
 		return nil, errors.Wrap(err, "Failed to list activities")
 	}
 	shortcut.View = len(activityList)
+	if shortcut.ExpiresTs != 0 {
+		location, err := s.getWorkspaceLocation(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to get workspace timezone")
+		}
+		shortcut.ExpiresAt = timezone.Format(shortcut.ExpiresTs, location)
+	}
 
 	return shortcut, nil
 }
@@ -348,7 +1046,167 @@ func convertShortcutFromStorepb(shortcut *storepb.Shortcut) *Shortcut {
 			Description: shortcut.OgMetadata.Description,
 			Image:       shortcut.OgMetadata.Image,
 		},
+		Enabled:               shortcut.Enabled,
+		ApprovalStatus:        ApprovalStatus(shortcut.ApprovalStatus.String()),
+		LinkDecoratorDisabled: shortcut.LinkDecoratorDisabled,
+		NoCache:               shortcut.NoCache,
+		Interstitial:          shortcut.Interstitial,
+		DisableAnalytics:      shortcut.DisableAnalytics,
+		RedirectDelayMs:       shortcut.RedirectDelayMs,
+		Goal:                  shortcut.Goal,
+		LastViewedTs:          shortcut.LastViewedTs,
+		ExpiresTs:             shortcut.ExpiresTs,
+		Targets: &ShortcutTargets{
+			Links:       shortcut.Targets.GetLinks(),
+			Mode:        convertTargetModeFromStorepb(shortcut.Targets.GetMode()),
+			LocaleLinks: shortcut.Targets.GetLocaleLinks(),
+		},
+		RefererPolicy: convertRefererPolicyFromStorepb(shortcut.RefererPolicy),
+	}
+}
+
+// validateCreateShortcutRequest returns the errors of the invalid fields of the request.
+// An empty visibility is valid and defaults to public.
+func validateCreateShortcutRequest(create *CreateShortcutRequest, profile *profile.Profile) map[string]string {
+	fields := map[string]string{}
+	maxLinkLength := profile.GetMaxLinkLength()
+	if strings.TrimSpace(create.Name) == "" {
+		fields["name"] = "name is required"
+	}
+	if strings.TrimSpace(create.Link) == "" {
+		fields["link"] = "link is required"
+	} else if len(create.Link) > maxLinkLength {
+		fields["link"] = fmt.Sprintf("link must not be longer than %d bytes", maxLinkLength)
+	}
+	if create.Visibility != "" && !isValidVisibility(create.Visibility) {
+		fields["visibility"] = fmt.Sprintf("invalid visibility: %s", create.Visibility)
+	}
+	if create.ExpiresTs < 0 {
+		fields["expiresTs"] = "expiresTs must not be negative"
 	}
+	if create.ExpiresTs != 0 && create.ExpiresAt != "" {
+		fields["expiresAt"] = "expiresAt cannot be set along with expiresTs"
+	}
+	if create.RedirectDelayMs < 0 {
+		fields["redirectDelayMs"] = "redirectDelayMs must not be negative"
+	}
+	validateShortcutTags(fields, "tags", create.Tags, profile)
+	validateShortcutTargets(fields, create.Targets, maxLinkLength)
+	validateRefererPolicy(fields, create.RefererPolicy, maxLinkLength)
+	validateOpenGraphMetadata(fields, create.OpenGraphMetadata, profile)
+	return fields
+}
+
+// validatePatchShortcutRequest returns the errors of the invalid fields of the request.
+func validatePatchShortcutRequest(patch *PatchShortcutRequest, profile *profile.Profile) map[string]string {
+	fields := map[string]string{}
+	maxLinkLength := profile.GetMaxLinkLength()
+	if patch.RowStatus != nil && !isValidRowStatus(*patch.RowStatus) {
+		fields["rowStatus"] = fmt.Sprintf("invalid row status: %s", *patch.RowStatus)
+	}
+	if patch.Name != nil && strings.TrimSpace(*patch.Name) == "" {
+		fields["name"] = "name must not be empty"
+	}
+	if patch.Link != nil && strings.TrimSpace(*patch.Link) == "" {
+		fields["link"] = "link must not be empty"
+	} else if patch.Link != nil && len(*patch.Link) > maxLinkLength {
+		fields["link"] = fmt.Sprintf("link must not be longer than %d bytes", maxLinkLength)
+	}
+	if patch.Visibility != nil && !isValidVisibility(*patch.Visibility) {
+		fields["visibility"] = fmt.Sprintf("invalid visibility: %s", *patch.Visibility)
+	}
+	if patch.Tags != nil && (len(patch.AddTags) > 0 || len(patch.RemoveTags) > 0) {
+		fields["tags"] = "tags cannot be set along with addTags or removeTags"
+	}
+	if patch.ExpiresTs != nil && *patch.ExpiresTs < 0 {
+		fields["expiresTs"] = "expiresTs must not be negative"
+	}
+	if patch.ExpiresTs != nil && patch.ExpiresAt != nil {
+		fields["expiresAt"] = "expiresAt cannot be set along with expiresTs"
+	}
+	if patch.RedirectDelayMs != nil && *patch.RedirectDelayMs < 0 {
+		fields["redirectDelayMs"] = "redirectDelayMs must not be negative"
+	}
+	validateShortcutTags(fields, "tags", patch.Tags, profile)
+	validateShortcutTags(fields, "addTags", patch.AddTags, profile)
+	validateShortcutTargets(fields, patch.Targets, maxLinkLength)
+	validateRefererPolicy(fields, patch.RefererPolicy, maxLinkLength)
+	validateOpenGraphMetadata(fields, patch.OpenGraphMetadata, profile)
+	return fields
+}
+
+// validateShortcutTags adds the error of the tags to the fields when there are too many tags or
+// when a tag is too long. The number of added tags is checked against the current tags by the store.
+func validateShortcutTags(fields map[string]string, field string, tags []string, profile *profile.Profile) {
+	if maxTags := profile.GetMaxTagsPerShortcut(); len(tags) > maxTags {
+		fields[field] = fmt.Sprintf("a shortcut must not have more than %d tags", maxTags)
+		return
+	}
+	for _, tag := range tags {
+		if maxTagLength := profile.GetMaxTagLength(); len(tag) > maxTagLength {
+			fields[field] = fmt.Sprintf("tags must not be longer than %d bytes", maxTagLength)
+			return
+		}
+	}
+}
+
+// validateOpenGraphMetadata adds the error of the open graph metadata to the fields when one of its
+// values is too long.
+func validateOpenGraphMetadata(fields map[string]string, metadata *OpenGraphMetadata, profile *profile.Profile) {
+	if metadata == nil {
+		return
+	}
+	if maxLength := profile.GetMaxOgTitleLength(); len(metadata.Title) > maxLength {
+		fields["openGraphMetadata"] = fmt.Sprintf("open graph title must not be longer than %d bytes", maxLength)
+	} else if maxLength := profile.GetMaxOgDescriptionLength(); len(metadata.Description) > maxLength {
+		fields["openGraphMetadata"] = fmt.Sprintf("open graph description must not be longer than %d bytes", maxLength)
+	} else if maxLength := profile.GetMaxOgImageLength(); len(metadata.Image) > maxLength {
+		fields["openGraphMetadata"] = fmt.Sprintf("open graph image must not be longer than %d bytes", maxLength)
+	}
+}
+
+// validateShortcutTargets adds the errors of the invalid targets to the fields.
+func validateShortcutTargets(fields map[string]string, targets *ShortcutTargets, maxLinkLength int) {
+	if targets == nil {
+		return
+	}
+	for _, link := range targets.Links {
+		if strings.TrimSpace(link) == "" {
+			fields["targets"] = "target links must not be empty"
+		} else if len(link) > maxLinkLength {
+			fields["targets"] = fmt.Sprintf("target links must not be longer than %d bytes", maxLinkLength)
+		}
+	}
+	if targets.Mode != "" && targets.Mode != TargetModeRoundRobin && targets.Mode != TargetModeFailover && targets.Mode != TargetModeRandom {
+		fields["targets"] = fmt.Sprintf("invalid target mode: %s", targets.Mode)
+	}
+	if message := language.ValidateLinks(targets.LocaleLinks, maxLinkLength); message != "" {
+		fields["targets"] = message
+	}
+}
+
+// convertTargetModeFromStorepb returns the target mode, unspecified modes being round robin.
+func convertTargetModeFromStorepb(mode storepb.TargetMode) TargetMode {
+	if mode == storepb.TargetMode_TARGET_MODE_UNSPECIFIED {
+		return TargetModeRoundRobin
+	}
+	return TargetMode(mode.String())
+}
+
+// convertShortcutTargetsToStorepb returns the stored targets, and nil for nil.
+func convertShortcutTargetsToStorepb(targets *ShortcutTargets) *storepb.ShortcutTargets {
+	if targets == nil {
+		return nil
+	}
+	return &storepb.ShortcutTargets{
+		Links:       targets.Links,
+		Mode:        storepb.TargetMode(storepb.TargetMode_value[string(targets.Mode)]),
+		LocaleLinks: targets.LocaleLinks,
+	}
+}
+
+func isValidVisibility(visibility Visibility) bool {
+	return visibility == VisibilityPublic || visibility == VisibilityWorkspace || visibility == VisibilityPrivate
 }
 
 func convertVisibilityToStorepb(visibility Visibility) storepb.Visibility {
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+type ShortcutCollaborator struct {
+	ShortcutID int32 `json:"shortcutId"`
+	UserID     int32 `json:"userId"`
+	CreatedTs  int64 `json:"createdTs"`
+}
+
+type AddShortcutCollaboratorRequest struct {
+	UserID int32 `json:"userId"`
+}
+
+func (s *APIV1Service) registerShortcutCollaboratorRoutes(g *echo.Group) {
+	g.GET("/shortcut/:shortcutId/collaborator", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		shortcut, currentUser, err := s.findCollaboratorShortcut(c)
+		if err != nil {
+			return err
+		}
+		canEdit, err := s.canEditShortcut(ctx, shortcut, currentUser)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		if !canEdit {
+			return echo.NewHTTPError(http.StatusForbidden, "unauthorized to list shortcut collaborators")
+		}
+
+		collaborators, err := s.Store.ListShortcutCollaborators(ctx, &store.FindShortcutCollaborator{
+			ShortcutID: &shortcut.Id,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list shortcut collaborators, err: %s", err)).SetInternal(err)
+		}
+		collaboratorMessageList := []*ShortcutCollaborator{}
+		for _, collaborator := range collaborators {
+			collaboratorMessageList = append(collaboratorMessageList, convertShortcutCollaboratorFromStore(collaborator))
+		}
+		return c.JSON(http.StatusOK, collaboratorMessageList)
+	})
+
+	g.POST("/shortcut/:shortcutId/collaborator", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		shortcut, currentUser, err := s.findCollaboratorShortcut(c)
+		if err != nil {
+			return err
+		}
+		// Only the creator and admins manage the collaborators, collaborators cannot add others.
+		if shortcut.CreatorId != currentUser.ID && currentUser.Role != store.RoleAdmin {
+			return echo.NewHTTPError(http.StatusForbidden, "unauthorized to add shortcut collaborator")
+		}
+
+		request := &AddShortcutCollaboratorRequest{}
+		if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+			return newDecodeError("failed to decode add shortcut collaborator request", err)
+		}
+		if request.UserID == shortcut.CreatorId {
+			return echo.NewHTTPError(http.StatusBadRequest, "the creator cannot be a collaborator of the shortcut")
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &request.UserID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+		}
+		if user == nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("not found user with id: %d", request.UserID))
+		}
+
+		collaborator, err := s.Store.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+			ShortcutID: shortcut.Id,
+			UserID:     user.ID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to add shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, convertShortcutCollaboratorFromStore(collaborator))
+	})
+
+	g.DELETE("/shortcut/:shortcutId/collaborator/:userId", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		shortcut, currentUser, err := s.findCollaboratorShortcut(c)
+		if err != nil {
+			return err
+		}
+		userID, err := util.ConvertStringToInt32(c.Param("userId"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("user id is not a number: %s", c.Param("userId"))).SetInternal(err)
+		}
+		// Collaborators can leave a shortcut by themselves.
+		if shortcut.CreatorId != currentUser.ID && currentUser.Role != store.RoleAdmin && userID != currentUser.ID {
+			return echo.NewHTTPError(http.StatusForbidden, "unauthorized to remove shortcut collaborator")
+		}
+
+		if err := s.Store.DeleteShortcutCollaborator(ctx, &store.DeleteShortcutCollaborator{
+			ShortcutID: shortcut.Id,
+			UserID:     userID,
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to remove shortcut collaborator, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, true)
+	})
+}
+
+// findCollaboratorShortcut returns the shortcut of the collaborator routes and the current user.
+func (s *APIV1Service) findCollaboratorShortcut(c echo.Context) (*storepb.Shortcut, *store.User, error) {
+	ctx := c.Request().Context()
+	shortcutID, err := util.ConvertStringToInt32(c.Param("shortcutId"))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("shortcut ID is not a number: %s", c.Param("shortcutId"))).SetInternal(err)
+	}
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+	}
+	if shortcut == nil {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("not found shortcut with id: %d", shortcutID))
+	}
+	return shortcut, currentUser, nil
+}
+
+// canEditShortcut returns whether the user can update the shortcut, which its creator, admins and
+// its collaborators can. Deleting the shortcut stays limited to its creator and admins.
+func (s *APIV1Service) canEditShortcut(ctx context.Context, shortcut *storepb.Shortcut, user *store.User) (bool, error) {
+	if shortcut.CreatorId == user.ID || user.Role == store.RoleAdmin {
+		return true, nil
+	}
+	return s.Store.IsShortcutCollaborator(ctx, shortcut.Id, user.ID)
+}
+
+func convertShortcutCollaboratorFromStore(collaborator *store.ShortcutCollaborator) *ShortcutCollaborator {
+	return &ShortcutCollaborator{
+		ShortcutID: collaborator.ShortcutID,
+		UserID:     collaborator.UserID,
+		CreatedTs:  collaborator.CreatedTs,
+	}
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// defaultExpiringDays is the number of days in which the listed shortcuts expire, unless requested otherwise.
+const defaultExpiringDays = 7
+
+// listExpiringShortcuts lists the shortcuts expiring in the next days, soonest first. Admins get all
+// the expiring shortcuts, and users the ones they can edit.
+func (s *APIV1Service) listExpiringShortcuts(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+
+	days := defaultExpiringDays
+	if v := c.QueryParam("days"); v != "" {
+		days, err = strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("days must be a positive number: %s", v))
+		}
+	}
+	// The days are calendar days of the workspace timezone, which are not all 24 hours long.
+	location, err := s.getWorkspaceLocation(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace timezone, err: %s", err)).SetInternal(err)
+	}
+	now := time.Now().In(location)
+	expiringAfter, expiringBefore := now.Unix(), now.AddDate(0, 0, days).Unix()
+	find := &store.FindShortcut{
+		ExpiringAfter:  &expiringAfter,
+		ExpiringBefore: &expiringBefore,
+	}
+	if currentUser.Role != store.RoleAdmin {
+		find.CreatorID = &userID
+	}
+	list, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to fetch shortcut list, err: %s", err)).SetInternal(err)
+	}
+	if currentUser.Role != store.RoleAdmin {
+		collaborators, err := s.Store.ListShortcutCollaborators(ctx, &store.FindShortcutCollaborator{
+			UserID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list shortcut collaborators, err: %s", err)).SetInternal(err)
+		}
+		for _, collaborator := range collaborators {
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+				ID: &collaborator.ShortcutID,
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find shortcut, err: %s", err)).SetInternal(err)
+			}
+			if shortcut != nil && shortcut.ExpiresTs >= expiringAfter && shortcut.ExpiresTs < expiringBefore {
+				list = append(list, shortcut)
+			}
+		}
+	}
+	slices.SortFunc(list, func(a, b *storepb.Shortcut) int {
+		return int(a.ExpiresTs - b.ExpiresTs)
+	})
+
+	shortcutMessageList := []*Shortcut{}
+	for _, shortcut := range list {
+		shortcutMessage, err := s.composeShortcut(ctx, convertShortcutFromStorepb(shortcut))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to compose shortcut, err: %s", err)).SetInternal(err)
+		}
+		shortcutMessageList = append(shortcutMessageList, shortcutMessage)
+	}
+	return c.JSON(http.StatusOK, shortcutMessageList)
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// sitemapMaxURLs is the maximum number of URLs of a sitemap, as per the sitemap protocol.
+const sitemapMaxURLs = 50000
+
+func (s *APIV1Service) registerSitemapRoutes(g *echo.Group) {
+	g.GET("/sitemap.xml", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		// The host of the request is chosen by the client, so the sitemap is only served
+		// with the absolute URLs of the configured custom domain.
+		customDomain, err := s.getCustomDomain(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+		if customDomain == "" {
+			return echo.NewHTTPError(http.StatusNotFound, "The sitemap requires the custom domain setting")
+		}
+		requireAuth, err := s.isAuthRequiredForRedirects(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace setting, err: %s", err)).SetInternal(err)
+		}
+
+		// The sitemap is buffered, so that errors are sent as such instead of a truncated sitemap.
+		buffer := &bytes.Buffer{}
+		buffer.WriteString(xml.Header + `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n")
+		// When the workspace requires signing in to follow any shortcut, none of them is public.
+		if !requireAuth {
+			if err := s.Store.WalkPublicShortcuts(ctx, sitemapMaxURLs, func(shortcut *storepb.Shortcut) error {
+				buffer.WriteString("  <url><loc>")
+				if err := xml.EscapeText(buffer, []byte(customDomain+"/s/"+url.PathEscape(shortcut.Name))); err != nil {
+					return err
+				}
+				buffer.WriteString("</loc><lastmod>" + time.Unix(shortcut.UpdatedTs, 0).UTC().Format(time.RFC3339) + "</lastmod></url>\n")
+				return nil
+			}); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list shortcuts, err: %s", err)).SetInternal(err)
+			}
+		}
+		buffer.WriteString("</urlset>\n")
+		return c.Blob(http.StatusOK, "application/xml; charset=utf-8", buffer.Bytes())
+	})
+}
+
+// getBaseURL returns the base URL of the absolute URLs of shortcuts, which is the custom domain of the
+// workspace, or the scheme and host of the request when the workspace has none.
+func (s *APIV1Service) getBaseURL(c echo.Context) (string, error) {
+	customDomain, err := s.getCustomDomain(c.Request().Context())
+	if err != nil {
+		return "", err
+	}
+	if customDomain != "" {
+		return customDomain, nil
+	}
+	return c.Scheme() + "://" + c.Request().Host, nil
+}
+
+// getCustomDomain returns the custom domain of the workspace, empty when the workspace has none.
+func (s *APIV1Service) getCustomDomain(ctx context.Context) (string, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN,
+	})
+	if err != nil {
+		return "", err
+	}
+	return workspaceSetting.GetCustomDomain(), nil
+}
@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yourselfhosted/slash/internal/language"
+	"github.com/yourselfhosted/slash/internal/safehttp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// targetHealthCheckTimeout bounds how long a target link has to answer a health check.
+const targetHealthCheckTimeout = 5 * time.Second
+
+// targetResolver picks the link each redirect of a shortcut with targets goes to. It counts the
+// redirects of the shortcuts for round robin, and skips the links last checked unhealthy.
+type targetResolver struct {
+	mutex     sync.Mutex
+	counters  map[int32]uint64
+	unhealthy map[string]bool
+	// checkedTs is when the links were last health checked, zero until the first check.
+	checkedTs int64
+}
+
+func newTargetResolver() *targetResolver {
+	return &targetResolver{
+		counters:  map[int32]uint64{},
+		unhealthy: map[string]bool{},
+	}
+}
+
+// resolve returns the shortcut unchanged when it has no targets, and otherwise a copy whose link
+// is the one picked by its mode, leaving the cached shortcut untouched. When all the links are
+// unhealthy, they are all used as if they were healthy.
+func (r *targetResolver) resolve(shortcut *storepb.Shortcut) *storepb.Shortcut {
+	if len(shortcut.Targets.GetLinks()) == 0 {
+		return shortcut
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	links := []string{}
+	for _, link := range targetLinks(shortcut) {
+		if !r.unhealthy[link] {
+			links = append(links, link)
+		}
+	}
+	if len(links) == 0 {
+		links = targetLinks(shortcut)
+	}
+
+	var link string
+	switch shortcut.Targets.GetMode() {
+	case storepb.TargetMode_FAILOVER:
+		link = links[0]
+	case storepb.TargetMode_RANDOM:
+		link = links[rand.Intn(len(links))]
+	default:
+		link = links[r.counters[shortcut.Id]%uint64(len(links))]
+		r.counters[shortcut.Id]++
+	}
+	shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+	shortcut.Link = link
+	return shortcut
+}
+
+// setUnhealthy replaces the links that redirects skip with the links of a health check.
+func (r *targetResolver) setUnhealthy(links map[string]bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.unhealthy = links
+	r.checkedTs = time.Now().Unix()
+}
+
+// getUnhealthy returns the links of the last health check that were unhealthy, and when it ran.
+func (r *targetResolver) getUnhealthy() (map[string]bool, int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Each check replaces the map rather than updating it, so it is safe to share.
+	return r.unhealthy, r.checkedTs
+}
+
+// targetLinks returns the links of the shortcut in order, its own link first.
+func targetLinks(shortcut *storepb.Shortcut) []string {
+	return append([]string{shortcut.Link}, shortcut.Targets.GetLinks()...)
+}
+
+// resolveTargets returns the shortcut whose link is the one the redirect of the request goes to: the
+// locale link of the language the request prefers, or else the link picked by the target resolver.
+func (s *APIV1Service) resolveTargets(c echo.Context, shortcut *storepb.Shortcut) *storepb.Shortcut {
+	if locale := getShortcutLocale(c, shortcut); locale != "" {
+		link := shortcut.Targets.LocaleLinks[locale]
+		shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+		shortcut.Link = link
+		return shortcut
+	}
+	return s.targetResolver.resolve(shortcut)
+}
+
+// getShortcutLocale returns the language of the locale links of the shortcut that the Accept-Language
+// of the request prefers, empty when there is none.
+func getShortcutLocale(c echo.Context, shortcut *storepb.Shortcut) string {
+	localeLinks := shortcut.Targets.GetLocaleLinks()
+	if len(localeLinks) == 0 {
+		return ""
+	}
+	return language.Match(c.Request().Header.Get("Accept-Language"), maps.Keys(localeLinks))
+}
+
+// localeLinks returns the locale links of the shortcut, ordered by language.
+func localeLinks(shortcut *storepb.Shortcut) []string {
+	locales := maps.Keys(shortcut.Targets.GetLocaleLinks())
+	sort.Strings(locales)
+	links := []string{}
+	for _, locale := range locales {
+		links = append(links, shortcut.Targets.LocaleLinks[locale])
+	}
+	return links
+}
+
+// CheckTargetHealth sends a HEAD request to the http links of the shortcuts with targets, and
+// marks the links that fail or answer with a server error unhealthy until the next check. The links
+// on private and loopback addresses are only checked when the profile allows it, and are otherwise
+// of unknown health, which is never unhealthy.
+func (s *APIV1Service) CheckTargetHealth(ctx context.Context) error {
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+
+	client := safehttp.NewClient(targetHealthCheckTimeout)
+	if s.Profile.HealthCheckPrivateTargets {
+		// The checks only send HEAD requests, whose responses are never shown to users.
+		client = &http.Client{Timeout: targetHealthCheckTimeout}
+	}
+	checked, unhealthy := map[string]bool{}, map[string]bool{}
+	for _, shortcut := range shortcuts {
+		if len(shortcut.Targets.GetLinks()) == 0 {
+			continue
+		}
+		for _, link := range targetLinks(shortcut) {
+			if checked[link] || !isHTTPURLString(link) {
+				continue
+			}
+			checked[link] = true
+			if !isTargetHealthy(ctx, client, link) {
+				unhealthy[link] = true
+			}
+		}
+	}
+	s.targetResolver.setUnhealthy(unhealthy)
+	return nil
+}
+
+func isTargetHealthy(ctx context.Context, client *http.Client, link string) bool {
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, link, nil)
+	if err != nil {
+		return false
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		// The links refused by the client are of unknown health, as are the internal hosts of go links.
+		return errors.Is(err, safehttp.ErrNotPublic)
+	}
+	response.Body.Close()
+	return response.StatusCode < http.StatusInternalServerError
+}
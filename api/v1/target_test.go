@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"sync"
+	"testing"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+func TestTargetResolverRoundRobin(t *testing.T) {
+	resolver := newTargetResolver()
+	shortcut := &storepb.Shortcut{
+		Id:   1,
+		Link: "https://a.com",
+		Targets: &storepb.ShortcutTargets{
+			Links: []string{"https://b.com", "https://c.com"},
+			Mode:  storepb.TargetMode_ROUND_ROBIN,
+		},
+	}
+	for i, want := range []string{"https://a.com", "https://b.com", "https://c.com", "https://a.com"} {
+		if got := resolver.resolve(shortcut).Link; got != want {
+			t.Errorf("resolve() #%d = %s, want %s", i, got, want)
+		}
+	}
+	if shortcut.Link != "https://a.com" {
+		t.Errorf("resolve() changed the link of the shortcut to %s", shortcut.Link)
+	}
+
+	// Unhealthy links are skipped.
+	resolver.setUnhealthy(map[string]bool{"https://b.com": true})
+	for i, want := range []string{"https://a.com", "https://c.com", "https://a.com"} {
+		if got := resolver.resolve(shortcut).Link; got != want {
+			t.Errorf("resolve() #%d with b unhealthy = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestTargetResolverRoundRobinConcurrent(t *testing.T) {
+	resolver := newTargetResolver()
+	shortcut := &storepb.Shortcut{
+		Id:   1,
+		Link: "https://a.com",
+		Targets: &storepb.ShortcutTargets{
+			Links: []string{"https://b.com"},
+		},
+	}
+	var mutex sync.Mutex
+	counts := map[string]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			link := resolver.resolve(shortcut).Link
+			mutex.Lock()
+			counts[link]++
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+	if counts["https://a.com"] != 50 || counts["https://b.com"] != 50 {
+		t.Errorf("resolve() counts = %v, want 50 each", counts)
+	}
+}
+
+func TestTargetResolverFailover(t *testing.T) {
+	resolver := newTargetResolver()
+	shortcut := &storepb.Shortcut{
+		Id:   1,
+		Link: "https://primary.com",
+		Targets: &storepb.ShortcutTargets{
+			Links: []string{"https://secondary.com", "https://tertiary.com"},
+			Mode:  storepb.TargetMode_FAILOVER,
+		},
+	}
+	if got := resolver.resolve(shortcut).Link; got != "https://primary.com" {
+		t.Errorf("resolve() = %s, want the primary", got)
+	}
+
+	resolver.setUnhealthy(map[string]bool{"https://primary.com": true})
+	for i := 0; i < 2; i++ {
+		if got := resolver.resolve(shortcut).Link; got != "https://secondary.com" {
+			t.Errorf("resolve() with the primary unhealthy = %s, want the secondary", got)
+		}
+	}
+
+	// When all the links are unhealthy, the primary is still tried.
+	resolver.setUnhealthy(map[string]bool{"https://primary.com": true, "https://secondary.com": true, "https://tertiary.com": true})
+	if got := resolver.resolve(shortcut).Link; got != "https://primary.com" {
+		t.Errorf("resolve() with all unhealthy = %s, want the primary", got)
+	}
+
+	resolver.setUnhealthy(map[string]bool{})
+	if got := resolver.resolve(shortcut).Link; got != "https://primary.com" {
+		t.Errorf("resolve() after the primary recovers = %s, want the primary", got)
+	}
+}
+
+func TestTargetResolverRandom(t *testing.T) {
+	resolver := newTargetResolver()
+	shortcut := &storepb.Shortcut{
+		Id:   1,
+		Link: "https://a.com",
+		Targets: &storepb.ShortcutTargets{
+			Links: []string{"https://b.com"},
+			Mode:  storepb.TargetMode_RANDOM,
+		},
+	}
+	resolver.setUnhealthy(map[string]bool{"https://a.com": true})
+	for i := 0; i < 10; i++ {
+		if got := resolver.resolve(shortcut).Link; got != "https://b.com" {
+			t.Errorf("resolve() with a unhealthy = %s, want https://b.com", got)
+		}
+	}
+}
+
+func TestTargetResolverNoTargets(t *testing.T) {
+	resolver := newTargetResolver()
+	shortcut := &storepb.Shortcut{
+		Id:   1,
+		Link: "https://a.com",
+	}
+	if got := resolver.resolve(shortcut); got != shortcut {
+		t.Errorf("resolve() = %v, want the shortcut itself", got)
+	}
+}
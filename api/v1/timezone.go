@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/yourselfhosted/slash/internal/timezone"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// getWorkspaceLocation returns the location of the workspace timezone, UTC when there is none.
+func (s *APIV1Service) getWorkspaceLocation(ctx context.Context) (*time.Location, error) {
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return timezone.Load(workspaceSetting.GetTimezone())
+}
+
+// parseExpiresAt returns the unix timestamp of the expiresAt of a request, which is interpreted in
+// the workspace timezone unless it has an offset. An empty expiresAt is zero, which never expires.
+func (s *APIV1Service) parseExpiresAt(ctx context.Context, expiresAt string) (int64, error) {
+	if expiresAt == "" {
+		return 0, nil
+	}
+	location, err := s.getWorkspaceLocation(ctx)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get workspace timezone, err: %s", err)).SetInternal(err)
+	}
+	t, err := timezone.ParseTime(expiresAt, location)
+	if err != nil {
+		return 0, newValidationError("invalid shortcut", map[string]string{
+			"expiresAt": err.Error(),
+		})
+	}
+	return t.Unix(), nil
+}
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/mail"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/yourselfhosted/slash/internal/util"
 	"github.com/yourselfhosted/slash/server/metric"
@@ -122,7 +122,7 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusBadRequest, "Invalid user create format").SetInternal(err)
 		}
 
-		passwordHash, err := bcrypt.GenerateFromPassword([]byte(userCreate.Password), bcrypt.DefaultCost)
+		passwordHash, err := store.HashPassword(userCreate.Password)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
 		}
@@ -131,7 +131,7 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 			Role:         store.Role(userCreate.Role),
 			Email:        userCreate.Email,
 			Nickname:     userCreate.Nickname,
-			PasswordHash: string(passwordHash),
+			PasswordHash: passwordHash,
 		})
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user").SetInternal(err)
@@ -144,7 +144,33 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 
 	g.GET("/user", func(c echo.Context) error {
 		ctx := c.Request().Context()
-		list, err := s.Store.ListUsers(ctx, &store.FindUser{})
+		find := &store.FindUser{
+			OrderBy:   store.UserOrderBy(c.QueryParam("orderBy")),
+			OrderDesc: c.QueryParam("order") == "desc",
+		}
+		if search := c.QueryParam("search"); search != "" {
+			find.Search = &search
+		}
+		if !store.IsValidUserOrderBy(find.OrderBy) {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid orderBy: %s", find.OrderBy))
+		}
+		for param, filter := range map[string]**int64{
+			"createdTsAfter":  &find.CreatedTsAfter,
+			"createdTsBefore": &find.CreatedTsBefore,
+			"updatedTsAfter":  &find.UpdatedTsAfter,
+			"updatedTsBefore": &find.UpdatedTsBefore,
+		} {
+			value := c.QueryParam(param)
+			if value == "" {
+				continue
+			}
+			ts, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid %s: %s", param, value)).SetInternal(err)
+			}
+			*filter = &ts
+		}
+		list, err := s.Store.ListUsers(ctx, find)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to list users, err: %s", err)).SetInternal(err)
 		}
@@ -194,7 +220,7 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 			userMessage.Email = ""
 		}
 		return c.JSON(http.StatusOK, userMessage)
-	})
+	}, s.idempotencyMiddleware)
 
 	g.PATCH("/user/:id", func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -237,35 +263,26 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 			updateUser.Nickname = userPatch.Nickname
 		}
 		if userPatch.Password != nil && *userPatch.Password != "" {
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(*userPatch.Password), bcrypt.DefaultCost)
+			passwordHash, err := store.HashPassword(*userPatch.Password)
 			if err != nil {
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to hash password, err: %s", err)).SetInternal(err)
 			}
-
-			passwordHashStr := string(passwordHash)
-			updateUser.PasswordHash = &passwordHashStr
+			updateUser.PasswordHash = &passwordHash
 		}
 		if userPatch.RowStatus != nil {
 			rowStatus := store.RowStatus(*userPatch.RowStatus)
 			updateUser.RowStatus = &rowStatus
 		}
 		if userPatch.Role != nil {
-			adminRole := store.RoleAdmin
-			adminUsers, err := s.Store.ListUsers(ctx, &store.FindUser{
-				Role: &adminRole,
-			})
-			if err != nil {
-				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to list admin users, err: %s", err)).SetInternal(err)
-			}
-			if len(adminUsers) == 1 && adminUsers[0].ID == userID && *userPatch.Role != RoleAdmin {
-				return echo.NewHTTPError(http.StatusBadRequest, "cannot remove admin role from the last admin user")
-			}
 			role := store.Role(*userPatch.Role)
 			updateUser.Role = &role
 		}
 
 		user, err := s.Store.UpdateUser(ctx, updateUser)
 		if err != nil {
+			if errors.Is(err, store.ErrLastAdmin) {
+				return echo.NewHTTPError(http.StatusBadRequest, "cannot remove admin role from the last admin user")
+			}
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to update user, err: %s", err)).SetInternal(err)
 		}
 
@@ -304,13 +321,13 @@ func (s *APIV1Service) registerUserRoutes(g *echo.Group) {
 		if user == nil {
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("user not found with ID: %d", userID)).SetInternal(err)
 		}
-		if user.Role == store.RoleAdmin {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("cannot delete admin user with ID: %d", userID)).SetInternal(err)
-		}
 
 		if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
 			ID: userID,
 		}); err != nil {
+			if errors.Is(err, store.ErrLastAdmin) {
+				return echo.NewHTTPError(http.StatusBadRequest, "cannot delete the last admin user")
+			}
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to delete user, err: %s", err)).SetInternal(err)
 		}
 
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// UserPreferences is the UI preferences of the current user, empty values leave the choice to the UI.
+type UserPreferences struct {
+	DefaultVisibility Visibility `json:"defaultVisibility"`
+	// ListDensity is COMFORTABLE or COMPACT.
+	ListDensity string `json:"listDensity"`
+	// ShortcutSort is UPDATED, NAME or VIEWS.
+	ShortcutSort string `json:"shortcutSort"`
+}
+
+// PatchUserPreferencesRequest updates the given preferences, an empty value unsets one.
+type PatchUserPreferencesRequest struct {
+	DefaultVisibility *Visibility `json:"defaultVisibility"`
+	ListDensity       *string     `json:"listDensity"`
+	ShortcutSort      *string     `json:"shortcutSort"`
+}
+
+func (s *APIV1Service) registerUserPreferencesRoutes(g *echo.Group) {
+	g.GET("/me/settings", func(c echo.Context) error {
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+		}
+		preferences, err := s.getUserPreferences(c, userID)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, convertUserPreferencesFromStorepb(preferences))
+	})
+
+	g.PATCH("/me/settings", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+		}
+		patch := &PatchUserPreferencesRequest{}
+		if err := json.NewDecoder(c.Request().Body).Decode(patch); err != nil {
+			return newDecodeError("failed to decode patch user preferences request", err)
+		}
+		fields := map[string]string{}
+		if patch.DefaultVisibility != nil && *patch.DefaultVisibility != "" && !isValidVisibility(*patch.DefaultVisibility) {
+			fields["defaultVisibility"] = fmt.Sprintf("invalid visibility: %s", *patch.DefaultVisibility)
+		}
+		if _, ok := storepb.PreferencesUserSetting_ListDensity_value["LIST_DENSITY_"+valueOrUnspecified(patch.ListDensity)]; !ok {
+			fields["listDensity"] = fmt.Sprintf("invalid list density: %s", *patch.ListDensity)
+		}
+		if _, ok := storepb.PreferencesUserSetting_ShortcutSort_value["SHORTCUT_SORT_"+valueOrUnspecified(patch.ShortcutSort)]; !ok {
+			fields["shortcutSort"] = fmt.Sprintf("invalid shortcut sort: %s", *patch.ShortcutSort)
+		}
+		if len(fields) > 0 {
+			return newValidationError("invalid user preferences", fields)
+		}
+
+		preferences, err := s.getUserPreferences(c, userID)
+		if err != nil {
+			return err
+		}
+		if patch.DefaultVisibility != nil {
+			preferences.DefaultVisibility = storepb.Visibility(storepb.Visibility_value[string(*patch.DefaultVisibility)])
+		}
+		if patch.ListDensity != nil {
+			preferences.ListDensity = storepb.PreferencesUserSetting_ListDensity(storepb.PreferencesUserSetting_ListDensity_value["LIST_DENSITY_"+valueOrUnspecified(patch.ListDensity)])
+		}
+		if patch.ShortcutSort != nil {
+			preferences.ShortcutSort = storepb.PreferencesUserSetting_ShortcutSort(storepb.PreferencesUserSetting_ShortcutSort_value["SHORTCUT_SORT_"+valueOrUnspecified(patch.ShortcutSort)])
+		}
+		if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: userID,
+			Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+			Value: &storepb.UserSetting_Preferences{
+				Preferences: preferences,
+			},
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, convertUserPreferencesFromStorepb(preferences))
+	})
+
+	g.DELETE("/me/settings", func(c echo.Context) error {
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+		}
+		if err := s.Store.DeleteUserSetting(c.Request().Context(), &store.DeleteUserSetting{
+			UserID: userID,
+			Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to delete user setting, err: %s", err)).SetInternal(err)
+		}
+		return c.JSON(http.StatusOK, &UserPreferences{})
+	})
+}
+
+// getUserPreferences returns a copy of the stored preferences of the user, empty when unset.
+func (s *APIV1Service) getUserPreferences(c echo.Context, userID int32) (*storepb.PreferencesUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(c.Request().Context(), &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to get user setting, err: %s", err)).SetInternal(err)
+	}
+	// The cached setting is shared, so it is copied before being patched.
+	preferences := userSetting.GetPreferences()
+	return &storepb.PreferencesUserSetting{
+		DefaultVisibility: preferences.GetDefaultVisibility(),
+		ListDensity:       preferences.GetListDensity(),
+		ShortcutSort:      preferences.GetShortcutSort(),
+	}, nil
+}
+
+// valueOrUnspecified returns the enum suffix of the patched value, UNSPECIFIED when it is unset or empty.
+func valueOrUnspecified(value *string) string {
+	if value == nil || *value == "" {
+		return "UNSPECIFIED"
+	}
+	return *value
+}
+
+func convertUserPreferencesFromStorepb(preferences *storepb.PreferencesUserSetting) *UserPreferences {
+	userPreferences := &UserPreferences{}
+	if preferences.DefaultVisibility != storepb.Visibility_VISIBILITY_UNSPECIFIED {
+		userPreferences.DefaultVisibility = Visibility(preferences.DefaultVisibility.String())
+	}
+	if preferences.ListDensity != storepb.PreferencesUserSetting_LIST_DENSITY_UNSPECIFIED {
+		userPreferences.ListDensity = strings.TrimPrefix(preferences.ListDensity.String(), "LIST_DENSITY_")
+	}
+	if preferences.ShortcutSort != storepb.PreferencesUserSetting_SHORTCUT_SORT_UNSPECIFIED {
+		userPreferences.ShortcutSort = strings.TrimPrefix(preferences.ShortcutSort.String(), "SHORTCUT_SORT_")
+	}
+	return userPreferences
+}
@@ -1,8 +1,19 @@
 package v1
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/labstack/echo/v4"
 
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/internal/favicon"
+	"github.com/yourselfhosted/slash/internal/filelog"
+	"github.com/yourselfhosted/slash/internal/hostlist"
+	"github.com/yourselfhosted/slash/internal/opengraph"
+	"github.com/yourselfhosted/slash/internal/ratelimit"
+	"github.com/yourselfhosted/slash/internal/safehttp"
+	"github.com/yourselfhosted/slash/internal/slug"
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
@@ -12,14 +23,60 @@ type APIV1Service struct {
 	Profile        *profile.Profile
 	Store          *store.Store
 	LicenseService *license.LicenseService
+	Blocklist      *blocklist.Blocklist
+	// HostDenylist is the hosts that the links of shortcuts cannot point to.
+	HostDenylist    *hostlist.List
+	CreationLimiter *ratelimit.Limiter
+	// SlugGenerator generates the names of the shortcuts created without one, nil requires names.
+	SlugGenerator slug.Generator
+
+	idempotencyCache *idempotencyCache
+	userRateLimiter  *ratelimit.Limiter
+	notFoundCache    *notFoundCache
+	faviconCache     *favicon.Cache
+	metadataSource   opengraph.Source
+	targetResolver   *targetResolver
+	redirectLog      *filelog.Writer
+	analyticsPause   *analyticsPause
 }
 
-func NewAPIV1Service(profile *profile.Profile, store *store.Store, licenseService *license.LicenseService) *APIV1Service {
-	return &APIV1Service{
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
+func NewAPIV1Service(profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, blocklist *blocklist.Blocklist, hostDenylist *hostlist.List, creationLimiter *ratelimit.Limiter, slugGenerator slug.Generator) *APIV1Service {
+	s := &APIV1Service{
+		Profile:         profile,
+		Store:           store,
+		LicenseService:  licenseService,
+		Blocklist:       blocklist,
+		HostDenylist:    hostDenylist,
+		CreationLimiter: creationLimiter,
+		SlugGenerator:   slugGenerator,
+
+		idempotencyCache: newIdempotencyCache(),
+		userRateLimiter:  ratelimit.New(userRateLimitWindow),
+		notFoundCache:    newNotFoundCache(notFoundCacheTTL),
+		targetResolver:   newTargetResolver(),
+		analyticsPause:   newAnalyticsPause(profile.GetAnalyticsPauseBufferSize()),
+		metadataSource:   opengraph.HTTPSource(safehttp.NewClient(metadataFetchTimeout)),
 	}
+	if profile.PreviewFavicon {
+		s.faviconCache = favicon.NewCache(favicon.HTTPSource(safehttp.NewClient(faviconFetchTimeout)), faviconCacheTTL)
+	}
+	if profile.RedirectLog != "" {
+		s.redirectLog = filelog.New(profile.RedirectLog, profile.RedirectLogMaxSize<<20, profile.RedirectLogMaxAge)
+	}
+	store.RegisterHook(s.invalidateNotFoundCache)
+	return s
+}
+
+// Close records the views buffered while analytics are paused, which would be lost with the server,
+// and closes the files of the service.
+func (s *APIV1Service) Close(ctx context.Context) error {
+	if err := s.resumeAnalytics(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to record the views buffered while analytics are paused", "dropped", s.analyticsPause.status().Buffered, "error", err)
+	}
+	if s.redirectLog == nil {
+		return nil
+	}
+	return s.redirectLog.Close()
 }
 
 func (s *APIV1Service) Start(apiGroup *echo.Group, secret string) {
@@ -27,15 +84,26 @@ func (s *APIV1Service) Start(apiGroup *echo.Group, secret string) {
 	apiV1Group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return JWTMiddleware(s, next, secret)
 	})
+	apiV1Group.Use(s.userRateLimitMiddleware)
 	s.registerWorkspaceRoutes(apiV1Group)
+	s.registerImportSessionRoutes(apiV1Group)
 	s.registerAuthRoutes(apiV1Group, secret)
 	s.registerUserRoutes(apiV1Group)
+	s.registerUserPreferencesRoutes(apiV1Group)
+	s.registerSessionRoutes(apiV1Group, secret)
 	s.registerShortcutRoutes(apiV1Group)
+	s.registerShortcutCollaboratorRoutes(apiV1Group)
 	s.registerAnalyticsRoutes(apiV1Group)
+	s.registerConversionRoutes(apiV1Group, secret)
+	s.registerAnalyticsPauseRoutes(apiV1Group)
 
 	redirectorGroup := apiGroup.Group("/s")
 	redirectorGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return JWTMiddleware(s, next, secret)
 	})
 	s.registerRedirectorRoutes(redirectorGroup)
+
+	if s.Profile.Sitemap {
+		s.registerSitemapRoutes(apiGroup)
+	}
 }
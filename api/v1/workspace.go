@@ -1,10 +1,14 @@
 package v1
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/profile"
@@ -16,6 +20,13 @@ type WorkspaceProfile struct {
 	DisallowSignUp bool             `json:"disallowSignUp"`
 }
 
+type WorkspaceStats struct {
+	ShortcutCount             int                `json:"shortcutCount"`
+	ShortcutCountByVisibility map[Visibility]int `json:"shortcutCountByVisibility"`
+	UserCount                 int                `json:"userCount"`
+	UserCountByRole           map[Role]int       `json:"userCountByRole"`
+}
+
 func (s *APIV1Service) registerWorkspaceRoutes(g *echo.Group) {
 	g.GET("/workspace/profile", func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -36,4 +47,116 @@ func (s *APIV1Service) registerWorkspaceRoutes(g *echo.Group) {
 
 		return c.JSON(http.StatusOK, workspaceProfile)
 	})
+
+	g.GET("/workspace/stats", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		userID, ok := c.Get(userIDContextKey).(int32)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+		}
+		if user == nil || user.Role != store.RoleAdmin {
+			return echo.NewHTTPError(http.StatusForbidden, "access forbidden for current session user")
+		}
+
+		workspaceStats := &WorkspaceStats{
+			ShortcutCountByVisibility: map[Visibility]int{},
+			UserCountByRole:           map[Role]int{},
+		}
+		workspaceStats.ShortcutCount, err = s.Store.CountShortcuts(ctx, &store.FindShortcut{})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to count shortcuts, err: %s", err)).SetInternal(err)
+		}
+		for _, visibility := range []store.Visibility{store.VisibilityPublic, store.VisibilityWorkspace, store.VisibilityPrivate} {
+			count, err := s.Store.CountShortcuts(ctx, &store.FindShortcut{
+				VisibilityList: []store.Visibility{visibility},
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to count shortcuts, err: %s", err)).SetInternal(err)
+			}
+			workspaceStats.ShortcutCountByVisibility[Visibility(visibility)] = count
+		}
+		workspaceStats.UserCount, err = s.Store.CountUsers(ctx, &store.FindUser{})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to count users, err: %s", err)).SetInternal(err)
+		}
+		for _, role := range []store.Role{store.RoleAdmin, store.RoleUser} {
+			role := role
+			count, err := s.Store.CountUsers(ctx, &store.FindUser{
+				Role: &role,
+			})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to count users, err: %s", err)).SetInternal(err)
+			}
+			workspaceStats.UserCountByRole[Role(role)] = count
+		}
+
+		return c.JSON(http.StatusOK, workspaceStats)
+	})
+
+	g.GET("/workspace/export", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+
+		dump, err := s.Store.Export(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				slog.InfoContext(ctx, "workspace export cancelled")
+				return nil
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to export workspace, err: %s", err)).SetInternal(err)
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="slash-%s.json"`, time.Unix(dump.CreatedTs, 0).UTC().Format("20060102-150405")))
+		return c.JSON(http.StatusOK, dump)
+	})
+
+	g.POST("/workspace/import", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if err := s.checkCurrentUserIsAdmin(c); err != nil {
+			return err
+		}
+
+		dump := &store.Dump{}
+		if err := json.NewDecoder(c.Request().Body).Decode(dump); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("malformatted import request, err: %s", err)).SetInternal(err)
+		}
+		if dump.Format != store.DumpFormat {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported dump format: %q", dump.Format))
+		}
+		result, err := s.Store.Import(ctx, dump)
+		if err != nil {
+			if errors.Is(err, store.ErrDumpConflict) {
+				return echo.NewHTTPError(http.StatusConflict, err.Error()).SetInternal(err)
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to import workspace, err: %s", err)).SetInternal(err)
+		}
+		// The imported shortcuts don't emit store events.
+		s.notFoundCache.clear()
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+// checkCurrentUserIsAdmin returns an HTTP error unless the current user is an admin.
+func (s *APIV1Service) checkCurrentUserIsAdmin(c echo.Context) error {
+	userID, ok := c.Get(userIDContextKey).(int32)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "missing user in session")
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, err: %s", err)).SetInternal(err)
+	}
+	if user == nil || user.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "access forbidden for current session user")
+	}
+	return nil
 }
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -128,6 +129,10 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 	if !validateAccessToken(accessToken, accessTokens) {
 		return 0, status.Errorf(codes.Unauthenticated, "invalid access token")
 	}
+	// Failing to record the use of the access token doesn't fail the request.
+	if err := in.Store.RecordAccessTokenUse(ctx, user.ID, accessToken, accessTokens); err != nil {
+		slog.WarnContext(ctx, "failed to record access token use", "user", user.ID, "error", err)
+	}
 
 	return userID, nil
 }
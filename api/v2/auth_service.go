@@ -3,10 +3,10 @@ package v2
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -33,10 +33,19 @@ func (s *APIV2Service) SignIn(ctx context.Context, request *apiv2pb.SignInReques
 		return nil, status.Errorf(http.StatusForbidden, fmt.Sprintf("user has been archived with email %s", request.Email))
 	}
 
-	// Compare the stored hashed password, with the hashed version of the password that was received.
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+	matched, needsRehash, err := s.Store.VerifyPassword(ctx, user.ID, request.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify password, err: %s", err)
+	}
+	if !matched {
 		return nil, status.Errorf(http.StatusUnauthorized, "unmatched email and password")
 	}
+	if needsRehash {
+		// The sign in succeeds even if the password can't be rehashed.
+		if err := s.Store.RehashPassword(ctx, user.ID, request.Password); err != nil {
+			slog.WarnContext(ctx, "failed to rehash password", "user", user.ID, "error", err)
+		}
+	}
 
 	accessToken, err := auth.GenerateAccessToken(user.Email, user.ID, time.Now().Add(auth.AccessTokenDuration), []byte(s.Secret))
 	if err != nil {
@@ -79,7 +88,7 @@ func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpReques
 		}
 	}
 
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	passwordHash, err := store.HashPassword(request.Password)
 	if err != nil {
 		return nil, status.Errorf(http.StatusInternalServerError, fmt.Sprintf("failed to generate password hash, err: %s", err))
 	}
@@ -87,7 +96,7 @@ func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpReques
 	create := &store.User{
 		Email:        request.Email,
 		Nickname:     request.Nickname,
-		PasswordHash: string(passwordHash),
+		PasswordHash: passwordHash,
 	}
 	existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{})
 	if err != nil {
@@ -119,6 +119,9 @@ func (s *APIV2Service) CreateCollection(ctx context.Context, request *apiv2pb.Cr
 		Description: request.Collection.Description,
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  storepb.Visibility(request.Collection.Visibility),
+		LinkDecorator: &storepb.LinkDecorator{
+			Params: request.Collection.LinkDecorator.GetParams(),
+		},
 	}
 	collection, err := s.Store.CreateCollection(ctx, collection)
 	if err != nil {
@@ -173,6 +176,10 @@ func (s *APIV2Service) UpdateCollection(ctx context.Context, request *apiv2pb.Up
 		case "visibility":
 			visibility := store.Visibility(request.Collection.Visibility.String())
 			update.Visibility = &visibility
+		case "link_decorator":
+			update.LinkDecorator = &storepb.LinkDecorator{
+				Params: request.Collection.LinkDecorator.GetParams(),
+			}
 		}
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
@@ -228,5 +235,8 @@ func convertCollectionFromStore(collection *storepb.Collection) *apiv2pb.Collect
 		Description: collection.Description,
 		ShortcutIds: collection.ShortcutIds,
 		Visibility:  apiv2pb.Visibility(collection.Visibility),
+		LinkDecorator: &apiv2pb.LinkDecorator{
+			Params: collection.LinkDecorator.GetParams(),
+		},
 	}
 }
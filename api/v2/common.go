@@ -1,6 +1,16 @@
 package v2
 
 import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	apiv2pb "github.com/yourselfhosted/slash/proto/gen/api/v2"
 	"github.com/yourselfhosted/slash/store"
 )
@@ -15,3 +25,42 @@ func convertRowStatusFromStore(rowStatus store.RowStatus) apiv2pb.RowStatus {
 		return apiv2pb.RowStatus_ROW_STATUS_UNSPECIFIED
 	}
 }
+
+// newInvalidArgumentError returns an InvalidArgument status whose details carry the error of each invalid field.
+func newInvalidArgumentError(message string, fields map[string]string) error {
+	badRequest := &errdetails.BadRequest{}
+	keys := maps.Keys(fields)
+	slices.Sort(keys)
+	for _, field := range keys {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: fields[field],
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(badRequest)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, fields)
+	}
+	return st.Err()
+}
+
+// validationErrorInterceptor is the unary interceptor responding to the validation errors returned
+// by the handlers with an InvalidArgument status listing the invalid fields.
+func validationErrorInterceptor(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	response, err := handler(ctx, request)
+	var validationErr *store.ValidationError
+	if errors.As(err, &validationErr) {
+		return nil, newInvalidArgumentError(validationErr.Message, validationErr.Fields)
+	}
+	return response, err
+}
+
+// isValidationError returns whether the error is a validation error, which the handlers return as is.
+func isValidationError(err error) bool {
+	var validationErr *store.ValidationError
+	return errors.As(err, &validationErr)
+}
+
+func isValidVisibility(visibility apiv2pb.Visibility) bool {
+	return visibility == apiv2pb.Visibility_PRIVATE || visibility == apiv2pb.Visibility_WORKSPACE || visibility == apiv2pb.Visibility_PUBLIC
+}
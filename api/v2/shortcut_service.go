@@ -3,25 +3,41 @@ package v2
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/url"
 	"strings"
 	"time"
 
-	"github.com/mssola/useragent"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/analytics"
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/internal/language"
+	"github.com/yourselfhosted/slash/internal/slug"
+	"github.com/yourselfhosted/slash/internal/util"
 	apiv2pb "github.com/yourselfhosted/slash/proto/gen/api/v2"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
+	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
 )
 
 func (s *APIV2Service) ListShortcuts(ctx context.Context, _ *apiv2pb.ListShortcutsRequest) (*apiv2pb.ListShortcutsResponse, error) {
 	userID := ctx.Value(userIDContextKey).(int32)
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user, err: %v", err)
+	}
 	find := &store.FindShortcut{}
 	find.VisibilityList = []store.Visibility{store.VisibilityWorkspace, store.VisibilityPublic}
 	visibleShortcutList, err := s.Store.ListShortcuts(ctx, find)
@@ -39,6 +55,9 @@ func (s *APIV2Service) ListShortcuts(ctx context.Context, _ *apiv2pb.ListShortcu
 	shortcutList = append(shortcutList, visibleShortcutList...)
 	shortcuts := []*apiv2pb.Shortcut{}
 	for _, shortcut := range shortcutList {
+		if !canViewPendingShortcut(shortcut, currentUser) {
+			continue
+		}
 		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
@@ -73,6 +92,17 @@ func (s *APIV2Service) GetShortcut(ctx context.Context, request *apiv2pb.GetShor
 			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 		}
 	}
+	if shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING {
+		currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user, err: %v", err)
+		}
+		if !canViewPendingShortcut(shortcut, currentUser) {
+			return nil, status.Errorf(codes.NotFound, "shortcut not found")
+		}
+	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 	if err != nil {
@@ -84,17 +114,112 @@ func (s *APIV2Service) GetShortcut(ctx context.Context, request *apiv2pb.GetShor
 	return response, nil
 }
 
+func (s *APIV2Service) ResolveShortcut(ctx context.Context, request *apiv2pb.ResolveShortcutRequest) (*apiv2pb.ResolveShortcutResponse, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name: &request.Name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if shortcut == nil || !shortcut.Enabled || shortcut.ApprovalStatus == storepb.ApprovalStatus_PENDING || store.IsShortcutExpired(shortcut, time.Now()) {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	userID, ok := ctx.Value(userIDContextKey).(int32)
+	if ok {
+		if shortcut.Visibility == storepb.Visibility_PRIVATE && shortcut.CreatorId != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+	} else {
+		if shortcut.Visibility != storepb.Visibility_PUBLIC {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+		// When the workspace requires auth for all redirects, public shortcuts are for signed in users too.
+		workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+		}
+		if workspaceSetting.GetRequireAuthForAllRedirects() {
+			return nil, status.Errorf(codes.Unauthenticated, "sign in is required to resolve shortcuts")
+		}
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	response := &apiv2pb.ResolveShortcutResponse{
+		Shortcut: composedShortcut,
+	}
+	return response, nil
+}
+
 func (s *APIV2Service) CreateShortcut(ctx context.Context, request *apiv2pb.CreateShortcutRequest) (*apiv2pb.CreateShortcutResponse, error) {
+	if request.Shortcut == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shortcut is required")
+	}
+	if strings.TrimSpace(request.Shortcut.Name) == "" && s.SlugGenerator != nil {
+		name, err := s.generateShortcutName(ctx)
+		if err != nil {
+			return nil, err
+		}
+		request.Shortcut.Name = name
+	}
+	if fields := validateShortcut(request.Shortcut, []string{"name", "link", "visibility", "tags", "og_metadata", "targets", "referer_policy", "redirect_delay_ms"}, s.Profile); len(fields) > 0 {
+		return nil, newInvalidArgumentError("invalid shortcut", fields)
+	}
 	userID := ctx.Value(userIDContextKey).(int32)
+	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user, err: %v", err)
+	}
+	if err := s.checkShortcutName(currentUser, request.Shortcut.Name); err != nil {
+		return nil, err
+	}
+	if err := s.checkShortcutLinks(append(append([]string{request.Shortcut.Link}, request.Shortcut.Targets.GetLinks()...), maps.Values(request.Shortcut.Targets.GetLocaleLinks())...)); err != nil {
+		return nil, err
+	}
+	if err := s.checkShortcutCreationRate(currentUser); err != nil {
+		return nil, err
+	}
 	shortcut := &storepb.Shortcut{
-		CreatorId:   userID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  storepb.Visibility(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
+		CreatorId:             userID,
+		Name:                  request.Shortcut.Name,
+		Link:                  request.Shortcut.Link,
+		Title:                 request.Shortcut.Title,
+		Tags:                  request.Shortcut.Tags,
+		Description:           request.Shortcut.Description,
+		Visibility:            storepb.Visibility(request.Shortcut.Visibility),
+		OgMetadata:            &storepb.OpenGraphMetadata{},
+		LinkDecoratorDisabled: request.Shortcut.LinkDecoratorDisabled,
+		NoCache:               request.Shortcut.NoCache,
+		Interstitial:          request.Shortcut.Interstitial,
+		DisableAnalytics:      request.Shortcut.DisableAnalytics,
+		RedirectDelayMs:       request.Shortcut.RedirectDelayMs,
+		Goal:                  request.Shortcut.Goal,
+		ExpiresTs:             convertExpireTimeToTs(request.Shortcut.ExpireTime),
+		Targets:               convertShortcutTargetsToStorepb(request.Shortcut.Targets),
+		RefererPolicy:         convertRefererPolicyToStorepb(request.Shortcut.RefererPolicy),
+	}
+	// Only admins may create shortcuts on behalf of other users.
+	if request.Shortcut.CreatorId != 0 && request.Shortcut.CreatorId != userID {
+		if currentUser.Role != store.RoleAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins can set the creator of a shortcut")
+		}
+		creator, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &request.Shortcut.CreatorId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user, err: %v", err)
+		}
+		if creator == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "not found user with id: %d", request.Shortcut.CreatorId)
+		}
+		shortcut.CreatorId = creator.ID
 	}
 	if request.Shortcut.OgMetadata != nil {
 		shortcut.OgMetadata = &storepb.OpenGraphMetadata{
@@ -103,7 +228,22 @@ func (s *APIV2Service) CreateShortcut(ctx context.Context, request *apiv2pb.Crea
 			Image:       request.Shortcut.OgMetadata.Image,
 		}
 	}
-	shortcut, err := s.Store.CreateShortcut(ctx, shortcut)
+	if shortcut.Visibility == storepb.Visibility_PUBLIC {
+		approvalRequired, err := s.isPublicShortcutApprovalRequired(ctx, currentUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+		}
+		if approvalRequired {
+			shortcut.ApprovalStatus = storepb.ApprovalStatus_PENDING
+		}
+	}
+	if _, hasUserinfo := util.StripURLUserinfo(shortcut.Link); hasUserinfo {
+		slog.WarnContext(ctx, "shortcut link contains credentials, they are hidden from previews but sent on redirect", "name", shortcut.Name)
+	}
+	shortcut, err = s.Store.CreateShortcut(ctx, shortcut)
+	if isValidationError(err) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
 	}
@@ -122,9 +262,23 @@ func (s *APIV2Service) CreateShortcut(ctx context.Context, request *apiv2pb.Crea
 }
 
 func (s *APIV2Service) UpdateShortcut(ctx context.Context, request *apiv2pb.UpdateShortcutRequest) (*apiv2pb.UpdateShortcutResponse, error) {
-	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+	editTags := len(request.AddTags) > 0 || len(request.RemoveTags) > 0
+	if len(request.UpdateMask.GetPaths()) == 0 && !editTags {
 		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
 	}
+	if request.Shortcut == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shortcut is required")
+	}
+	fields := validateShortcut(request.Shortcut, request.UpdateMask.GetPaths(), s.Profile)
+	if message := validateShortcutTags(request.AddTags, s.Profile); message != "" {
+		fields["add_tags"] = message
+	}
+	if editTags && slices.Contains(request.UpdateMask.GetPaths(), "tags") {
+		fields["tags"] = "tags cannot be updated along with addTags or removeTags"
+	}
+	if len(fields) > 0 {
+		return nil, newInvalidArgumentError("invalid shortcut", fields)
+	}
 
 	userID := ctx.Value(userIDContextKey).(int32)
 	currentUser, err := s.Store.GetUser(ctx, &store.FindUser{
@@ -143,24 +297,40 @@ func (s *APIV2Service) UpdateShortcut(ctx context.Context, request *apiv2pb.Upda
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 	if shortcut.CreatorId != userID && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		isCollaborator, err := s.Store.IsShortcutCollaborator(ctx, shortcut.Id, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find shortcut collaborator, err: %v", err)
+		}
+		if !isCollaborator {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
 	}
 
 	update := &store.UpdateShortcut{
-		ID: shortcut.Id,
+		ID:         shortcut.Id,
+		AddTags:    request.AddTags,
+		RemoveTags: request.RemoveTags,
 	}
-	for _, path := range request.UpdateMask.Paths {
+	for _, path := range request.UpdateMask.GetPaths() {
 		switch path {
 		case "name":
+			if request.Shortcut.Name != shortcut.Name {
+				if err := s.checkShortcutName(currentUser, request.Shortcut.Name); err != nil {
+					return nil, err
+				}
+			}
 			update.Name = &request.Shortcut.Name
 		case "link":
+			if err := s.checkShortcutLinks([]string{request.Shortcut.Link}); err != nil {
+				return nil, err
+			}
 			update.Link = &request.Shortcut.Link
 		case "title":
 			update.Title = &request.Shortcut.Title
 		case "description":
 			update.Description = &request.Shortcut.Description
 		case "tags":
-			tag := strings.Join(request.Shortcut.Tags, " ")
+			tag := strings.Join(s.Store.NormalizeTags(request.Shortcut.Tags), " ")
 			update.Tag = &tag
 		case "visibility":
 			visibility := store.Visibility(request.Shortcut.Visibility.String())
@@ -173,9 +343,51 @@ func (s *APIV2Service) UpdateShortcut(ctx context.Context, request *apiv2pb.Upda
 					Image:       request.Shortcut.OgMetadata.Image,
 				}
 			}
+		case "enabled":
+			update.Enabled = &request.Shortcut.Enabled
+		case "link_decorator_disabled":
+			update.LinkDecoratorDisabled = &request.Shortcut.LinkDecoratorDisabled
+		case "no_cache":
+			update.NoCache = &request.Shortcut.NoCache
+		case "interstitial":
+			update.Interstitial = &request.Shortcut.Interstitial
+		case "disable_analytics":
+			update.DisableAnalytics = &request.Shortcut.DisableAnalytics
+		case "redirect_delay_ms":
+			update.RedirectDelayMs = &request.Shortcut.RedirectDelayMs
+		case "goal":
+			update.Goal = &request.Shortcut.Goal
+		case "expire_time":
+			expiresTs := convertExpireTimeToTs(request.Shortcut.ExpireTime)
+			update.ExpiresTs = &expiresTs
+		case "targets":
+			if err := s.checkShortcutLinks(append(maps.Values(request.Shortcut.Targets.GetLocaleLinks()), request.Shortcut.Targets.GetLinks()...)); err != nil {
+				return nil, err
+			}
+			update.Targets = convertShortcutTargetsToStorepb(request.Shortcut.Targets)
+		case "referer_policy":
+			update.RefererPolicy = convertRefererPolicyToStorepb(request.Shortcut.RefererPolicy)
+		}
+	}
+	if update.ChangesApprovedShortcut(shortcut) {
+		approvalRequired, err := s.isPublicShortcutApprovalRequired(ctx, currentUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+		}
+		if approvalRequired {
+			approvalStatus := storepb.ApprovalStatus_PENDING
+			update.ApprovalStatus = &approvalStatus
 		}
 	}
 	shortcut, err = s.Store.UpdateShortcut(ctx, update)
+	if errors.Is(err, store.ErrTooManyTags) {
+		return nil, newInvalidArgumentError("invalid shortcut", map[string]string{
+			"add_tags": fmt.Sprintf("a shortcut must not have more than %d tags", s.Profile.GetMaxTagsPerShortcut()),
+		})
+	}
+	if isValidationError(err) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
 	}
@@ -240,23 +452,32 @@ func (s *APIV2Service) GetShortcutAnalytics(ctx context.Context, request *apiv2p
 		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
 	}
 
+	uniqueVisitorWindowSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+	}
+
 	referenceMap := make(map[string]int32)
 	deviceMap := make(map[string]int32)
 	browserMap := make(map[string]int32)
+	views := []analytics.View{}
 	for _, activity := range activities {
 		payload := &storepb.ActivityShorcutViewPayload{}
 		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
 		}
+		views = append(views, analytics.View{IP: payload.Ip, CreatedTs: activity.CreatedTs})
 
 		if _, ok := referenceMap[payload.Referer]; !ok {
 			referenceMap[payload.Referer] = 0
 		}
 		referenceMap[payload.Referer]++
 
-		ua := useragent.New(payload.UserAgent)
-		deviceName := ua.OSInfo().Name
-		browserName, _ := ua.Browser()
+		ua := analytics.RecordedUserAgent(payload.UserAgent, payload.Browser, payload.Os, payload.DeviceType)
+		deviceName := ua.OS
+		browserName := ua.Browser
 
 		if _, ok := deviceMap[deviceName]; !ok {
 			deviceMap[deviceName] = 0
@@ -271,9 +492,11 @@ func (s *APIV2Service) GetShortcutAnalytics(ctx context.Context, request *apiv2p
 
 	metric.Enqueue("shortcut analytics")
 	response := &apiv2pb.GetShortcutAnalyticsResponse{
-		References: mapToAnalyticsSlice(referenceMap),
-		Devices:    mapToAnalyticsSlice(deviceMap),
-		Browsers:   mapToAnalyticsSlice(browserMap),
+		References:     mapToAnalyticsSlice(referenceMap),
+		Devices:        mapToAnalyticsSlice(deviceMap),
+		Browsers:       mapToAnalyticsSlice(browserMap),
+		TotalViews:     int32(len(views)),
+		UniqueVisitors: int32(analytics.CountUniqueVisitors(views, uniqueVisitorWindowSetting.GetUniqueVisitorWindow())),
 	}
 	return response, nil
 }
@@ -313,6 +536,221 @@ func (s *APIV2Service) createShortcutCreateActivity(ctx context.Context, shortcu
 	return nil
 }
 
+// isPublicShortcutApprovalRequired returns whether the public shortcuts of the user wait for the approval of an admin.
+func (s *APIV2Service) isPublicShortcutApprovalRequired(ctx context.Context, user *store.User) (bool, error) {
+	if user.Role == store.RoleAdmin {
+		return false, nil
+	}
+	workspaceSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL,
+	})
+	if err != nil {
+		return false, err
+	}
+	return workspaceSetting.GetRequirePublicShortcutApproval(), nil
+}
+
+// canViewPendingShortcut returns whether the user can see the shortcut, pending shortcuts are
+// only visible to their creator and to admins.
+func canViewPendingShortcut(shortcut *storepb.Shortcut, user *store.User) bool {
+	if shortcut.ApprovalStatus != storepb.ApprovalStatus_PENDING {
+		return true
+	}
+	return user != nil && (shortcut.CreatorId == user.ID || user.Role == store.RoleAdmin)
+}
+
+// checkShortcutLinks returns an error if one of the links points to a denied host.
+func (s *APIV2Service) checkShortcutLinks(links []string) error {
+	for _, link := range links {
+		if s.HostDenylist.ContainsURL(link) {
+			return status.Errorf(codes.PermissionDenied, "shortcut link %q points to a denied host", link)
+		}
+	}
+	return nil
+}
+
+// checkShortcutName returns an error if the name is blocked, or requires a review that the user cannot give.
+// Admins can use the names that require a review.
+func (s *APIV2Service) checkShortcutName(user *store.User, name string) error {
+	switch s.Blocklist.Check(name) {
+	case blocklist.Blocked:
+		return status.Errorf(codes.PermissionDenied, "shortcut name %q is not allowed", name)
+	case blocklist.ReviewRequired:
+		if user.Role != store.RoleAdmin {
+			return status.Errorf(codes.PermissionDenied, "shortcut name %q requires the approval of an admin", name)
+		}
+	}
+	return nil
+}
+
+// generateShortcutName returns a generated name that is free and allowed by the blocklist.
+func (s *APIV2Service) generateShortcutName(ctx context.Context) (string, error) {
+	name, err := slug.GenerateShortcutName(ctx, s.SlugGenerator, s.Blocklist, s.Store)
+	if errors.Is(err, slug.ErrNoFreeName) {
+		return "", status.Errorf(codes.AlreadyExists, "no free name generated for the shortcut")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get shortcut, err: %v", err)
+	}
+	return name, nil
+}
+
+// checkShortcutCreationRate counts a shortcut created by the user, and refuses it once the user
+// created the limit of shortcuts of the current window. Admins are never limited.
+func (s *APIV2Service) checkShortcutCreationRate(user *store.User) error {
+	if s.Profile.CreationRateLimit <= 0 || user.Role == store.RoleAdmin {
+		return nil
+	}
+	now := time.Now()
+	window, allowed := s.CreationLimiter.Take(user.ID, s.Profile.CreationRateLimit, now)
+	if allowed {
+		return nil
+	}
+	st, err := status.New(codes.ResourceExhausted, "too many shortcuts created, try again later").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(window.RetryAfter(now)) * time.Second),
+	})
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "too many shortcuts created, try again later")
+	}
+	return st.Err()
+}
+
+// validateShortcut returns the errors of the invalid fields of the shortcut among the given paths.
+func validateShortcut(shortcut *apiv2pb.Shortcut, paths []string, profile *profile.Profile) map[string]string {
+	fields := map[string]string{}
+	maxLinkLength := profile.GetMaxLinkLength()
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if strings.TrimSpace(shortcut.Name) == "" {
+				fields["name"] = "name is required"
+			}
+		case "link":
+			if strings.TrimSpace(shortcut.Link) == "" {
+				fields["link"] = "link is required"
+			} else if len(shortcut.Link) > maxLinkLength {
+				fields["link"] = fmt.Sprintf("link must not be longer than %d bytes", maxLinkLength)
+			}
+		case "visibility":
+			if !isValidVisibility(shortcut.Visibility) {
+				fields["visibility"] = fmt.Sprintf("invalid visibility: %s", shortcut.Visibility)
+			}
+		case "tags":
+			if message := validateShortcutTags(shortcut.Tags, profile); message != "" {
+				fields["tags"] = message
+			}
+		case "og_metadata":
+			if message := validateOpenGraphMetadata(shortcut.OgMetadata, profile); message != "" {
+				fields["og_metadata"] = message
+			}
+		case "targets":
+			for _, link := range shortcut.Targets.GetLinks() {
+				if strings.TrimSpace(link) == "" {
+					fields["targets"] = "target links must not be empty"
+				} else if len(link) > maxLinkLength {
+					fields["targets"] = fmt.Sprintf("target links must not be longer than %d bytes", maxLinkLength)
+				}
+			}
+			if _, ok := apiv2pb.TargetMode_name[int32(shortcut.Targets.GetMode())]; !ok {
+				fields["targets"] = fmt.Sprintf("invalid target mode: %s", shortcut.Targets.GetMode())
+			}
+			if message := language.ValidateLinks(shortcut.Targets.GetLocaleLinks(), maxLinkLength); message != "" {
+				fields["targets"] = message
+			}
+		case "redirect_delay_ms":
+			if shortcut.RedirectDelayMs < 0 {
+				fields["redirect_delay_ms"] = "redirect delay must not be negative"
+			}
+		case "referer_policy":
+			policy := shortcut.RefererPolicy
+			for _, host := range append(slices.Clone(policy.GetAllowedHosts()), policy.GetDeniedHosts()...) {
+				if strings.TrimSpace(host) == "" || strings.ContainsAny(host, "/:") {
+					fields["referer_policy"] = fmt.Sprintf("invalid referer host: %q", host)
+				}
+			}
+			if blockedLink := policy.GetBlockedLink(); blockedLink != "" {
+				if len(blockedLink) > maxLinkLength {
+					fields["referer_policy"] = fmt.Sprintf("blocked link must not be longer than %d bytes", maxLinkLength)
+				} else if u, err := url.Parse(blockedLink); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					fields["referer_policy"] = "blocked link must be an http or https URL"
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// validateShortcutTags returns the error of the tags when there are too many tags or when a tag is
+// too long, and an empty string otherwise.
+func validateShortcutTags(tags []string, profile *profile.Profile) string {
+	if maxTags := profile.GetMaxTagsPerShortcut(); len(tags) > maxTags {
+		return fmt.Sprintf("a shortcut must not have more than %d tags", maxTags)
+	}
+	for _, tag := range tags {
+		if maxTagLength := profile.GetMaxTagLength(); len(tag) > maxTagLength {
+			return fmt.Sprintf("tags must not be longer than %d bytes", maxTagLength)
+		}
+	}
+	return ""
+}
+
+// validateOpenGraphMetadata returns the error of the open graph metadata when one of its values is too long.
+func validateOpenGraphMetadata(metadata *apiv2pb.OpenGraphMetadata, profile *profile.Profile) string {
+	if maxLength := profile.GetMaxOgTitleLength(); len(metadata.GetTitle()) > maxLength {
+		return fmt.Sprintf("open graph title must not be longer than %d bytes", maxLength)
+	}
+	if maxLength := profile.GetMaxOgDescriptionLength(); len(metadata.GetDescription()) > maxLength {
+		return fmt.Sprintf("open graph description must not be longer than %d bytes", maxLength)
+	}
+	if maxLength := profile.GetMaxOgImageLength(); len(metadata.GetImage()) > maxLength {
+		return fmt.Sprintf("open graph image must not be longer than %d bytes", maxLength)
+	}
+	return ""
+}
+
+// convertExpireTimeToTs returns the stored expiry of the shortcut, zero for never.
+func convertExpireTimeToTs(expireTime *timestamppb.Timestamp) int64 {
+	if expireTime == nil {
+		return 0
+	}
+	return expireTime.AsTime().Unix()
+}
+
+// convertExpiresTsToExpireTime returns the expire time of the shortcut, nil for never.
+func convertExpiresTsToExpireTime(expiresTs int64) *timestamppb.Timestamp {
+	if expiresTs == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(expiresTs, 0))
+}
+
+// convertLastViewedTsToLastViewTime returns the last view time, nil when the shortcut was never viewed.
+func convertLastViewedTsToLastViewTime(lastViewedTs int64) *timestamppb.Timestamp {
+	if lastViewedTs == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(lastViewedTs, 0))
+}
+
+// convertShortcutTargetsToStorepb returns the stored targets, and no targets for nil.
+func convertShortcutTargetsToStorepb(targets *apiv2pb.ShortcutTargets) *storepb.ShortcutTargets {
+	return &storepb.ShortcutTargets{
+		Links:       targets.GetLinks(),
+		Mode:        storepb.TargetMode(targets.GetMode()),
+		LocaleLinks: targets.GetLocaleLinks(),
+	}
+}
+
+// convertRefererPolicyToStorepb returns the stored referer policy, and no policy for nil.
+func convertRefererPolicyToStorepb(policy *apiv2pb.RefererPolicy) *storepb.RefererPolicy {
+	return &storepb.RefererPolicy{
+		AllowedHosts: policy.GetAllowedHosts(),
+		DeniedHosts:  policy.GetDeniedHosts(),
+		AllowEmpty:   policy.GetAllowEmpty(),
+		BlockedLink:  policy.GetBlockedLink(),
+	}
+}
+
 func (s *APIV2Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*apiv2pb.Shortcut, error) {
 	composedShortcut := &apiv2pb.Shortcut{
 		Id:          shortcut.Id,
@@ -331,6 +769,27 @@ func (s *APIV2Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 			Description: shortcut.OgMetadata.Description,
 			Image:       shortcut.OgMetadata.Image,
 		},
+		Enabled:               shortcut.Enabled,
+		ApprovalStatus:        apiv2pb.ApprovalStatus(shortcut.ApprovalStatus),
+		LinkDecoratorDisabled: shortcut.LinkDecoratorDisabled,
+		NoCache:               shortcut.NoCache,
+		Interstitial:          shortcut.Interstitial,
+		DisableAnalytics:      shortcut.DisableAnalytics,
+		RedirectDelayMs:       shortcut.RedirectDelayMs,
+		Goal:                  shortcut.Goal,
+		LastViewTime:          convertLastViewedTsToLastViewTime(shortcut.LastViewedTs),
+		ExpireTime:            convertExpiresTsToExpireTime(shortcut.ExpiresTs),
+		Targets: &apiv2pb.ShortcutTargets{
+			Links:       shortcut.Targets.GetLinks(),
+			Mode:        apiv2pb.TargetMode(shortcut.Targets.GetMode()),
+			LocaleLinks: shortcut.Targets.GetLocaleLinks(),
+		},
+		RefererPolicy: &apiv2pb.RefererPolicy{
+			AllowedHosts: shortcut.RefererPolicy.GetAllowedHosts(),
+			DeniedHosts:  shortcut.RefererPolicy.GetDeniedHosts(),
+			AllowEmpty:   shortcut.RefererPolicy.GetAllowEmpty(),
+			BlockedLink:  shortcut.RefererPolicy.GetBlockedLink(),
+		},
 	}
 
 	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,8 +18,30 @@ import (
 	"github.com/yourselfhosted/slash/store"
 )
 
-func (s *APIV2Service) ListUsers(ctx context.Context, _ *apiv2pb.ListUsersRequest) (*apiv2pb.ListUsersResponse, error) {
-	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+func (s *APIV2Service) ListUsers(ctx context.Context, request *apiv2pb.ListUsersRequest) (*apiv2pb.ListUsersResponse, error) {
+	find := &store.FindUser{
+		OrderBy:   store.UserOrderBy(request.OrderBy),
+		OrderDesc: request.OrderDesc,
+	}
+	if request.Search != "" {
+		find.Search = &request.Search
+	}
+	if request.CreatedTsAfter != 0 {
+		find.CreatedTsAfter = &request.CreatedTsAfter
+	}
+	if request.CreatedTsBefore != 0 {
+		find.CreatedTsBefore = &request.CreatedTsBefore
+	}
+	if request.UpdatedTsAfter != 0 {
+		find.UpdatedTsAfter = &request.UpdatedTsAfter
+	}
+	if request.UpdatedTsBefore != 0 {
+		find.UpdatedTsBefore = &request.UpdatedTsBefore
+	}
+	if !store.IsValidUserOrderBy(find.OrderBy) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by: %s", request.OrderBy)
+	}
+	users, err := s.Store.ListUsers(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
@@ -54,7 +75,7 @@ func (s *APIV2Service) GetUser(ctx context.Context, request *apiv2pb.GetUserRequ
 }
 
 func (s *APIV2Service) CreateUser(ctx context.Context, request *apiv2pb.CreateUserRequest) (*apiv2pb.CreateUserResponse, error) {
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
+	passwordHash, err := store.HashPassword(request.User.Password)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
 	}
@@ -73,7 +94,7 @@ func (s *APIV2Service) CreateUser(ctx context.Context, request *apiv2pb.CreateUs
 		Email:        request.User.Email,
 		Nickname:     request.User.Nickname,
 		Role:         store.RoleUser,
-		PasswordHash: string(passwordHash),
+		PasswordHash: passwordHash,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
@@ -105,6 +126,9 @@ func (s *APIV2Service) UpdateUser(ctx context.Context, request *apiv2pb.UpdateUs
 	}
 	user, err := s.Store.UpdateUser(ctx, userUpdate)
 	if err != nil {
+		if errors.Is(err, store.ErrLastAdmin) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot remove the last admin user")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
 	return &apiv2pb.UpdateUserResponse{
@@ -122,6 +146,9 @@ func (s *APIV2Service) DeleteUser(ctx context.Context, request *apiv2pb.DeleteUs
 		ID: request.Id,
 	})
 	if err != nil {
+		if errors.Is(err, store.ErrLastAdmin) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot delete the last admin user")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
 	response := &apiv2pb.DeleteUserResponse{}
@@ -245,25 +272,15 @@ func (s *APIV2Service) DeleteUserAccessToken(ctx context.Context, request *apiv2
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, userID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
-	}
-	updatedUserAccessTokens := []*storepb.AccessTokensUserSetting_AccessToken{}
-	for _, userAccessToken := range userAccessTokens {
-		if userAccessToken.AccessToken == request.AccessToken {
-			continue
+	if err := s.Store.UpdateUserAccessTokens(ctx, userID, func(userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+		updatedUserAccessTokens := []*storepb.AccessTokensUserSetting_AccessToken{}
+		for _, userAccessToken := range userAccessTokens {
+			if userAccessToken.AccessToken == request.AccessToken {
+				continue
+			}
+			updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
 		}
-		updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
-	}
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: userID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: updatedUserAccessTokens,
-			},
-		},
+		return updatedUserAccessTokens
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
@@ -272,23 +289,13 @@ func (s *APIV2Service) DeleteUserAccessToken(ctx context.Context, request *apiv2
 }
 
 func (s *APIV2Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
-	}
-	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
+	userAccessToken := &storepb.AccessTokensUserSetting_AccessToken{
 		AccessToken: accessToken,
 		Description: description,
+		CreatedTs:   time.Now().Unix(),
 	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: userAccessTokens,
-			},
-		},
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+		return append(userAccessTokens, userAccessToken)
 	}); err != nil {
 		return errors.Wrap(err, "failed to upsert user setting")
 	}
@@ -2,7 +2,6 @@ package v2
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -11,6 +10,10 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/internal/hostlist"
+	"github.com/yourselfhosted/slash/internal/ratelimit"
+	"github.com/yourselfhosted/slash/internal/slug"
 	apiv2pb "github.com/yourselfhosted/slash/proto/gen/api/v2"
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/server/service/license"
@@ -30,25 +33,36 @@ type APIV2Service struct {
 	Profile        *profile.Profile
 	Store          *store.Store
 	LicenseService *license.LicenseService
+	Blocklist      *blocklist.Blocklist
+	// HostDenylist is the hosts that the links of shortcuts cannot point to.
+	HostDenylist    *hostlist.List
+	CreationLimiter *ratelimit.Limiter
+	// SlugGenerator generates the names of the shortcuts created without one, nil requires names.
+	SlugGenerator slug.Generator
 
-	grpcServer     *grpc.Server
-	grpcServerPort int
+	grpcServer        *grpc.Server
+	grpcServerAddress string
 }
 
-func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV2Service {
+func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, blocklist *blocklist.Blocklist, hostDenylist *hostlist.List, creationLimiter *ratelimit.Limiter, slugGenerator slug.Generator, grpcServerAddress string) *APIV2Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			authProvider.AuthenticationInterceptor,
+			validationErrorInterceptor,
 		),
 	)
 	apiV2Service := &APIV2Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:            secret,
+		Profile:           profile,
+		Store:             store,
+		LicenseService:    licenseService,
+		Blocklist:         blocklist,
+		HostDenylist:      hostDenylist,
+		CreationLimiter:   creationLimiter,
+		SlugGenerator:     slugGenerator,
+		grpcServer:        grpcServer,
+		grpcServerAddress: grpcServerAddress,
 	}
 
 	apiv2pb.RegisterSubscriptionServiceServer(grpcServer, apiV2Service)
@@ -73,7 +87,7 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 	// This is where the gRPC-Gateway proxies the requests.
 	conn, err := grpc.DialContext(
 		ctx,
-		fmt.Sprintf(":%d", s.grpcServerPort),
+		s.grpcServerAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
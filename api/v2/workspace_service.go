@@ -2,15 +2,22 @@ package v2
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/yourselfhosted/slash/internal/timezone"
 	apiv2pb "github.com/yourselfhosted/slash/proto/gen/api/v2"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
+// redirectStatusCodes is the statuses the shortcuts may redirect with.
+var redirectStatusCodes = []int32{301, 302, 303, 307, 308}
+
 func (s *APIV2Service) GetWorkspaceProfile(ctx context.Context, _ *apiv2pb.GetWorkspaceProfileRequest) (*apiv2pb.GetWorkspaceProfileResponse, error) {
 	profile := &apiv2pb.WorkspaceProfile{
 		Mode:    s.Profile.Mode,
@@ -66,6 +73,69 @@ func (s *APIV2Service) GetWorkspaceSetting(ctx context.Context, _ *apiv2pb.GetWo
 			workspaceSetting.CustomStyle = v.GetCustomStyle()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_SCRIPT {
 			workspaceSetting.CustomScript = v.GetCustomScript()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE {
+			workspaceSetting.RedirectCacheMaxAge = v.GetRedirectCacheMaxAge()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW {
+			workspaceSetting.UniqueVisitorWindow = apiv2pb.UniqueVisitorWindow(v.GetUniqueVisitorWindow())
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA {
+			defaultOgMetadata := v.GetDefaultOgMetadata()
+			workspaceSetting.DefaultOgMetadata = &apiv2pb.OpenGraphMetadata{
+				Title:       defaultOgMetadata.GetTitle(),
+				Description: defaultOgMetadata.GetDescription(),
+				Image:       defaultOgMetadata.GetImage(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE {
+			workspaceSetting.DisabledShortcutMessage = v.GetDisabledShortcutMessage()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL {
+			workspaceSetting.RequirePublicShortcutApproval = v.GetRequirePublicShortcutApproval()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS {
+			workspaceSetting.HashVisitorIps = v.GetHashVisitorIps()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR {
+			workspaceSetting.LinkDecorator = &apiv2pb.LinkDecorator{
+				Params: v.GetLinkDecorator().GetParams(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS {
+			workspaceSetting.RequireAuthForAllRedirects = v.GetRequireAuthForAllRedirects()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL {
+			interstitial := v.GetInterstitial()
+			workspaceSetting.Interstitial = &apiv2pb.InterstitialWorkspaceSetting{
+				Enabled:      interstitial.GetEnabled(),
+				DelaySeconds: interstitial.GetDelaySeconds(),
+				Message:      interstitial.GetMessage(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN {
+			workspaceSetting.CustomDomain = v.GetCustomDomain()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN {
+			workspaceSetting.InternalChain = &apiv2pb.InternalChainWorkspaceSetting{
+				Enabled: v.GetInternalChain().GetEnabled(),
+				MaxHops: v.GetInternalChain().GetMaxHops(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS {
+			workspaceSetting.RedirectStatus = &apiv2pb.RedirectStatusWorkspaceSetting{
+				Public:    v.GetRedirectStatus().GetPublic(),
+				Workspace: v.GetRedirectStatus().GetWorkspace(),
+				Private:   v.GetRedirectStatus().GetPrivate(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT {
+			workspaceSetting.LoginRedirect = &apiv2pb.LoginRedirectWorkspaceSetting{
+				Enabled: v.GetLoginRedirect().GetEnabled(),
+				Url:     v.GetLoginRedirect().GetUrl(),
+			}
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES {
+			prefixRoutes := &apiv2pb.PrefixRoutesWorkspaceSetting{}
+			for _, route := range v.GetPrefixRoutes().GetRoutes() {
+				prefixRoutes.Routes = append(prefixRoutes.Routes, &apiv2pb.PrefixRoute{
+					Prefix: route.Prefix,
+					Base:   route.Base,
+				})
+			}
+			workspaceSetting.PrefixRoutes = prefixRoutes
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE {
+			workspaceSetting.Timezone = v.GetTimezone()
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE {
+			workspaceSetting.InvalidLinkMode = apiv2pb.InvalidLinkMode(v.GetInvalidLinkMode())
+		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS {
+			workspaceSetting.AnonymizeVisitors = v.GetAnonymizeVisitors()
 		} else if isAdmin {
 			// For some settings, only admin can get the value.
 			if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY {
@@ -120,6 +190,243 @@ func (s *APIV2Service) UpdateWorkspaceSetting(ctx context.Context, request *apiv
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "redirect_cache_max_age" {
+			if request.Setting.RedirectCacheMaxAge < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "redirect cache max age must not be negative")
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE,
+				Value: &storepb.WorkspaceSetting_RedirectCacheMaxAge{
+					RedirectCacheMaxAge: request.Setting.RedirectCacheMaxAge,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "unique_visitor_window" {
+			if _, ok := storepb.UniqueVisitorWindow_name[int32(request.Setting.UniqueVisitorWindow)]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid unique visitor window: %v", request.Setting.UniqueVisitorWindow)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW,
+				Value: &storepb.WorkspaceSetting_UniqueVisitorWindow{
+					UniqueVisitorWindow: storepb.UniqueVisitorWindow(request.Setting.UniqueVisitorWindow),
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "default_og_metadata" {
+			defaultOgMetadata := request.Setting.DefaultOgMetadata
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA,
+				Value: &storepb.WorkspaceSetting_DefaultOgMetadata{
+					DefaultOgMetadata: &storepb.OpenGraphMetadata{
+						Title:       defaultOgMetadata.GetTitle(),
+						Description: defaultOgMetadata.GetDescription(),
+						Image:       defaultOgMetadata.GetImage(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "disabled_shortcut_message" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE,
+				Value: &storepb.WorkspaceSetting_DisabledShortcutMessage{
+					DisabledShortcutMessage: request.Setting.DisabledShortcutMessage,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "require_public_shortcut_approval" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL,
+				Value: &storepb.WorkspaceSetting_RequirePublicShortcutApproval{
+					RequirePublicShortcutApproval: request.Setting.RequirePublicShortcutApproval,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "hash_visitor_ips" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+				Value: &storepb.WorkspaceSetting_HashVisitorIps{
+					HashVisitorIps: request.Setting.HashVisitorIps,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "link_decorator" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR,
+				Value: &storepb.WorkspaceSetting_LinkDecorator{
+					LinkDecorator: &storepb.LinkDecorator{
+						Params: request.Setting.LinkDecorator.GetParams(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "require_auth_for_all_redirects" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS,
+				Value: &storepb.WorkspaceSetting_RequireAuthForAllRedirects{
+					RequireAuthForAllRedirects: request.Setting.RequireAuthForAllRedirects,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "interstitial" {
+			interstitial := request.Setting.Interstitial
+			if interstitial.GetDelaySeconds() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "interstitial delay must not be negative")
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL,
+				Value: &storepb.WorkspaceSetting_Interstitial{
+					Interstitial: &storepb.InterstitialWorkspaceSetting{
+						Enabled:      interstitial.GetEnabled(),
+						DelaySeconds: interstitial.GetDelaySeconds(),
+						Message:      interstitial.GetMessage(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "custom_domain" {
+			customDomain := strings.TrimSuffix(strings.TrimSpace(request.Setting.CustomDomain), "/")
+			if customDomain != "" {
+				if u, err := url.Parse(customDomain); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "custom domain must be an http or https URL: %s", request.Setting.CustomDomain)
+				}
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN,
+				Value: &storepb.WorkspaceSetting_CustomDomain{
+					CustomDomain: customDomain,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "internal_chain" {
+			internalChain := request.Setting.InternalChain
+			if internalChain.GetMaxHops() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "internal chain max hops must not be negative")
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN,
+				Value: &storepb.WorkspaceSetting_InternalChain{
+					InternalChain: &storepb.InternalChainWorkspaceSetting{
+						Enabled: internalChain.GetEnabled(),
+						MaxHops: internalChain.GetMaxHops(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "redirect_status" {
+			redirectStatus := request.Setting.RedirectStatus
+			for _, code := range []int32{redirectStatus.GetPublic(), redirectStatus.GetWorkspace(), redirectStatus.GetPrivate()} {
+				if code != 0 && !slices.Contains(redirectStatusCodes, code) {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid redirect status: %d", code)
+				}
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS,
+				Value: &storepb.WorkspaceSetting_RedirectStatus{
+					RedirectStatus: &storepb.RedirectStatusWorkspaceSetting{
+						Public:    redirectStatus.GetPublic(),
+						Workspace: redirectStatus.GetWorkspace(),
+						Private:   redirectStatus.GetPrivate(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "login_redirect" {
+			loginRedirect := request.Setting.LoginRedirect
+			if loginURL := loginRedirect.GetUrl(); loginURL != "" && !isValidLoginURL(loginURL) {
+				return nil, status.Errorf(codes.InvalidArgument, "login url must be an http or https URL or a path: %s", loginURL)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT,
+				Value: &storepb.WorkspaceSetting_LoginRedirect{
+					LoginRedirect: &storepb.LoginRedirectWorkspaceSetting{
+						Enabled: loginRedirect.GetEnabled(),
+						Url:     loginRedirect.GetUrl(),
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "prefix_routes" {
+			prefixRoutes := &storepb.PrefixRoutesWorkspaceSetting{}
+			prefixes := map[string]bool{}
+			for _, route := range request.Setting.PrefixRoutes.GetRoutes() {
+				prefix := strings.TrimSpace(route.Prefix)
+				if prefix == "" || strings.Contains(prefix, "/") {
+					return nil, status.Errorf(codes.InvalidArgument, "prefix must be a non-empty name segment: %q", route.Prefix)
+				}
+				if prefixes[s.Store.NormalizeName(prefix)] {
+					return nil, status.Errorf(codes.InvalidArgument, "duplicate prefix: %s", prefix)
+				}
+				prefixes[s.Store.NormalizeName(prefix)] = true
+				if u, err := url.Parse(route.Base); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "prefix route base must be an http or https URL: %s", route.Base)
+				}
+				// The rest of the routed names is appended to the path of the base, never to its host.
+				if u, _ := url.Parse(route.Base); !strings.HasSuffix(u.Path, "/") {
+					return nil, status.Errorf(codes.InvalidArgument, "prefix route base must have a path ending with a slash: %s", route.Base)
+				}
+				if s.HostDenylist.ContainsURL(route.Base) {
+					return nil, status.Errorf(codes.PermissionDenied, "prefix route base points to a denied host: %s", route.Base)
+				}
+				prefixRoutes.Routes = append(prefixRoutes.Routes, &storepb.PrefixRoute{
+					Prefix: prefix,
+					Base:   route.Base,
+				})
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES,
+				Value: &storepb.WorkspaceSetting_PrefixRoutes{
+					PrefixRoutes: prefixRoutes,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "timezone" {
+			tz := strings.TrimSpace(request.Setting.Timezone)
+			if _, err := timezone.Load(tz); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "timezone must be an IANA timezone such as Europe/Paris: %s", request.Setting.Timezone)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE,
+				Value: &storepb.WorkspaceSetting_Timezone{
+					Timezone: tz,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "invalid_link_mode" {
+			if _, ok := storepb.InvalidLinkMode_name[int32(request.Setting.InvalidLinkMode)]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown invalid link mode: %v", request.Setting.InvalidLinkMode)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE,
+				Value: &storepb.WorkspaceSetting_InvalidLinkMode{
+					InvalidLinkMode: storepb.InvalidLinkMode(request.Setting.InvalidLinkMode),
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "anonymize_visitors" {
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS,
+				Value: &storepb.WorkspaceSetting_AnonymizeVisitors{
+					AnonymizeVisitors: request.Setting.AnonymizeVisitors,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -133,3 +440,15 @@ func (s *APIV2Service) UpdateWorkspaceSetting(ctx context.Context, request *apiv
 		Setting: getWorkspaceSettingResponse.Setting,
 	}, nil
 }
+
+// isValidLoginURL returns whether the login URL is an absolute http or https URL, or a path of the instance.
+func isValidLoginURL(loginURL string) bool {
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" && u.Host == "" {
+		return strings.HasPrefix(u.Path, "/")
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/store"
+)
+
+var (
+	adminCmd = &cobra.Command{
+		Use:   "admin",
+		Short: "Administration commands.",
+	}
+
+	adminRehashCmd = &cobra.Command{
+		Use:   "rehash",
+		Short: "Report the password hashes pending a rehash with the current parameters.",
+		Run: func(_cmd *cobra.Command, _args []string) {
+			ctx := context.Background()
+			storeInstance, err := openStore(ctx)
+			if err != nil {
+				log.Error("failed to open store", zap.Error(err))
+				return
+			}
+			defer storeInstance.Close(ctx)
+
+			report, err := storeInstance.GetPasswordHashReport(ctx)
+			if err != nil {
+				log.Error("failed to get password hash report", zap.Error(err))
+				return
+			}
+			printPasswordHashReport(os.Stdout, report)
+		},
+	}
+)
+
+func init() {
+	adminCmd.AddCommand(adminRehashCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+func printPasswordHashReport(w io.Writer, report *store.PasswordHashReport) {
+	fmt.Fprintf(w, "%d password hashes are current, %d are outdated and %d are malformed.\n", report.Current, report.Outdated, report.Malformed)
+	// Rehashing needs the plaintext password, which is only known while the user signs in.
+	if report.Outdated > 0 {
+		fmt.Fprintln(w, "Outdated hashes are rehashed when their users next sign in.")
+	}
+	if report.Malformed > 0 {
+		fmt.Fprintln(w, "Users with malformed hashes cannot sign in until their password is reset.")
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+)
+
+var (
+	pruneOlderThan string
+	pruneYes       bool
+
+	dbCmd = &cobra.Command{
+		Use:   "db",
+		Short: "Database maintenance commands.",
+	}
+
+	dbSweepCmd = &cobra.Command{
+		Use:   "sweep",
+		Short: "Delete orphaned activities, user settings and shortcuts.",
+		Run: func(_cmd *cobra.Command, _args []string) {
+			ctx := context.Background()
+			storeInstance, err := openStore(ctx)
+			if err != nil {
+				log.Error("failed to open store", zap.Error(err))
+				return
+			}
+			defer storeInstance.Close(ctx)
+
+			result, err := storeInstance.Sweep(ctx)
+			if err != nil {
+				log.Error("failed to sweep database", zap.Error(err))
+				return
+			}
+			fmt.Printf("Deleted %d orphaned shortcuts, %d user settings and %d activities.\n", result.Shortcuts, result.UserSettings, result.Activities)
+		},
+	}
+
+	dbPruneArchivedCmd = &cobra.Command{
+		Use:   "prune-archived",
+		Short: "Permanently delete the shortcuts archived for longer than an age, with their activities.",
+		Run: func(_cmd *cobra.Command, _args []string) {
+			olderThan, err := parseAge(pruneOlderThan)
+			if err != nil {
+				log.Error("invalid --older-than", zap.Error(err))
+				return
+			}
+			ctx := context.Background()
+			storeInstance, err := openStore(ctx)
+			if err != nil {
+				log.Error("failed to open store", zap.Error(err))
+				return
+			}
+			defer storeInstance.Close(ctx)
+
+			if err := pruneArchived(ctx, storeInstance, os.Stdin, os.Stdout, time.Now().Add(-olderThan), pruneYes); err != nil {
+				log.Error("failed to prune archived shortcuts", zap.Error(err))
+			}
+		},
+	}
+)
+
+func init() {
+	dbPruneArchivedCmd.Flags().StringVar(&pruneOlderThan, "older-than", "", `age of the archived shortcuts to delete, such as "30d" or "12h"`)
+	dbPruneArchivedCmd.Flags().BoolVarP(&pruneYes, "yes", "y", false, "delete without asking for confirmation")
+	if err := dbPruneArchivedCmd.MarkFlagRequired("older-than"); err != nil {
+		panic(err)
+	}
+	dbCmd.AddCommand(dbSweepCmd)
+	dbCmd.AddCommand(dbPruneArchivedCmd)
+	rootCmd.AddCommand(dbCmd)
+}
+
+// openStore opens the database of the server profile and returns a store over it.
+func openStore(ctx context.Context) (*store.Store, error) {
+	db := db.NewDB(serverProfile)
+	if err := db.Open(ctx); err != nil {
+		return nil, err
+	}
+	return store.New(db.DBInstance, serverProfile), nil
+}
+
+// openFallbackStore opens the fallback database of the server profile read-only, so that the database
+// of the older instance is left as it is, and returns a store over it.
+func openFallbackStore(ctx context.Context) (*store.Store, error) {
+	fallbackProfile := *serverProfile
+	fallbackProfile.DSN = serverProfile.FallbackDSN
+	db := db.NewDB(&fallbackProfile)
+	if err := db.OpenReadOnly(ctx); err != nil {
+		return nil, err
+	}
+	return store.New(db.DBInstance, &fallbackProfile), nil
+}
+
+// pruneArchived deletes the shortcuts archived before the time once the deletion is confirmed on in,
+// or right away with yes.
+func pruneArchived(ctx context.Context, storeInstance *store.Store, in io.Reader, out io.Writer, archivedBefore time.Time, yes bool) error {
+	cutoff := archivedBefore.Unix()
+	list, err := storeInstance.ListShortcuts(ctx, &store.FindShortcut{
+		ArchivedBefore: &cutoff,
+	})
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		fmt.Fprintf(out, "No shortcuts archived before %s.\n", archivedBefore.Format(time.RFC3339))
+		return nil
+	}
+	if !yes {
+		fmt.Fprintf(out, "Permanently delete %d shortcuts archived before %s? [y/N] ", len(list), archivedBefore.Format(time.RFC3339))
+		answer, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			fmt.Fprintln(out, "Aborted.")
+			return nil
+		}
+	}
+
+	result, err := storeInstance.PruneArchivedShortcuts(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Deleted %d archived shortcuts, %d activities and %d collaborators.\n", result.Shortcuts, result.Activities, result.ShortcutCollaborators)
+	return nil
+}
+
+// parseAge parses an age of a number of days such as "30d", or of a duration such as "12h".
+func parseAge(value string) (time.Duration, error) {
+	var age time.Duration
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, errors.Errorf("invalid number of days %q", value)
+		}
+		age = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if age, err = time.ParseDuration(value); err != nil {
+			return 0, err
+		}
+	}
+	if age <= 0 {
+		return 0, errors.Errorf("the age %q must be positive", value)
+	}
+	return age, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestPruneArchived(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	storeInstance := store.New(database.DBInstance, profile)
+	defer storeInstance.Close(ctx)
+	user, err := storeInstance.CreateUser(ctx, &store.User{
+		Email:    "slash@yourselfhosted.com",
+		Nickname: "slash",
+		Role:     store.RoleAdmin,
+	})
+	require.NoError(t, err)
+	now := time.Now()
+	// The archive times are seeded directly, as archiving sets the current time.
+	for name, archivedAge := range map[string]time.Duration{
+		"active":      0,
+		"archived-1d": 24 * time.Hour,
+		"archived-29": 29 * 24 * time.Hour,
+		"archived-31": 31 * 24 * time.Hour,
+		"archived-1y": 365 * 24 * time.Hour,
+	} {
+		shortcut, err := storeInstance.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link",
+			Visibility: storepb.Visibility_PUBLIC,
+		})
+		require.NoError(t, err)
+		if archivedAge > 0 {
+			_, err = database.DBInstance.ExecContext(ctx, `UPDATE shortcut SET row_status = 'ARCHIVED', archived_ts = ? WHERE id = ?`, now.Add(-archivedAge).Unix(), shortcut.Id)
+			require.NoError(t, err)
+		}
+	}
+	listNames := func() []string {
+		list, err := storeInstance.ListShortcuts(ctx, &store.FindShortcut{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range list {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+
+	// Nothing is deleted unless confirmed.
+	out := &bytes.Buffer{}
+	require.NoError(t, pruneArchived(ctx, storeInstance, strings.NewReader("n\n"), out, now.Add(-30*24*time.Hour), false))
+	require.Contains(t, out.String(), "Permanently delete 2 shortcuts archived before")
+	require.Contains(t, out.String(), "Aborted.")
+	require.Len(t, listNames(), 5)
+
+	out.Reset()
+	require.NoError(t, pruneArchived(ctx, storeInstance, strings.NewReader("y\n"), out, now.Add(-30*24*time.Hour), false))
+	require.Contains(t, out.String(), "Deleted 2 archived shortcuts")
+	require.ElementsMatch(t, []string{"active", "archived-1d", "archived-29"}, listNames())
+
+	// The confirmation is skipped with yes.
+	out.Reset()
+	require.NoError(t, pruneArchived(ctx, storeInstance, strings.NewReader(""), out, now.Add(-time.Hour), true))
+	require.Contains(t, out.String(), "Deleted 2 archived shortcuts")
+	require.Equal(t, []string{"active"}, listNames())
+}
+
+func TestParseAge(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"30d": 30 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+		"90m": 90 * time.Minute,
+	} {
+		age, err := parseAge(value)
+		require.NoError(t, err)
+		require.Equal(t, expected, age)
+	}
+	for _, value := range []string{"", "d", "-1d", "0d", "0s", "month"} {
+		_, err := parseAge(value)
+		require.Error(t, err, value)
+	}
+}
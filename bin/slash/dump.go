@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/store"
+)
+
+var (
+	dumpFormat string
+
+	exportCmd = &cobra.Command{
+		Use:   "export <file>",
+		Short: "Export the users, shortcuts, collections and settings to a dump file.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(_cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			storeInstance, err := openStore(ctx)
+			if err != nil {
+				log.Error("failed to open store", zap.Error(err))
+				return
+			}
+			defer storeInstance.Close(ctx)
+
+			dump, err := exportDump(ctx, storeInstance, args[0])
+			if err != nil {
+				log.Error("failed to export", zap.Error(err))
+				return
+			}
+			fmt.Printf("Exported %d users, %d shortcuts and %d collections to %s.\n", len(dump.Users), len(dump.Shortcuts), len(dump.Collections), args[0])
+		},
+	}
+
+	importCmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a dump file exported by slash, preferably into a new instance.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(_cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			storeInstance, err := openStore(ctx)
+			if err != nil {
+				log.Error("failed to open store", zap.Error(err))
+				return
+			}
+			defer storeInstance.Close(ctx)
+
+			result, err := importDump(ctx, storeInstance, args[0])
+			if err != nil {
+				log.Error("failed to import", zap.Error(err))
+				return
+			}
+			fmt.Printf("Imported %d users, %d shortcuts and %d collections, remapped the IDs of %d users and %d shortcuts.\n", result.Users, result.Shortcuts, result.Collections, result.RemappedUsers, result.RemappedShortcuts)
+		},
+	}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{exportCmd, importCmd} {
+		cmd.Flags().StringVar(&dumpFormat, "format", store.DumpFormat, `format of the dump file, only "slash" is supported`)
+		rootCmd.AddCommand(cmd)
+	}
+}
+
+func checkDumpFormat() error {
+	if dumpFormat != store.DumpFormat {
+		return errors.Errorf("unsupported dump format %q", dumpFormat)
+	}
+	return nil
+}
+
+// exportDump writes the dump of the store to the file.
+func exportDump(ctx context.Context, s *store.Store, path string) (*store.Dump, error) {
+	if err := checkDumpFormat(); err != nil {
+		return nil, err
+	}
+	dump, err := s.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", path)
+	}
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(dump); err != nil {
+		return nil, errors.Wrapf(err, "failed to write %s", path)
+	}
+	return dump, file.Close()
+}
+
+// importDump imports the dump file into the store.
+func importDump(ctx context.Context, s *store.Store, path string) (*store.ImportResult, error) {
+	if err := checkDumpFormat(); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	dump := &store.Dump{}
+	if err := json.Unmarshal(buf, dump); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+	return s.Import(ctx, dump)
+}
@@ -3,17 +3,22 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	_ "modernc.org/sqlite"
 
 	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/internal/requestid"
 	"github.com/yourselfhosted/slash/server"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/server/profile"
@@ -26,15 +31,81 @@ const (
 )
 
 var (
-	serverProfile *profile.Profile
-	mode          string
-	port          int
-	data          string
+	serverProfile            *profile.Profile
+	mode                     string
+	port                     int
+	grpcPort                 int
+	socket                   string
+	data                     string
+	quiet                    bool
+	sweepInterval            time.Duration
+	debugHeaders             bool
+	blockedNames             string
+	reviewNames              string
+	previewParam             string
+	preserveCase             bool
+	trustedIPs               []string
+	countHead                bool
+	userLimit                int
+	adminLimit               int
+	welcome                  bool
+	maxLinkLength            int
+	lockRetries              int
+	createLimit              int
+	createWindow             time.Duration
+	previewFavicon           bool
+	dsn                      string
+	targetHealthInterval     time.Duration
+	healthCheckPrivate       bool
+	slugGenerator            string
+	slowQueryThreshold       time.Duration
+	maxTagsPerShortcut       int
+	maxTagLength             int
+	sitemap                  bool
+	stripTrailingSlash       bool
+	readHeaderTimeout        time.Duration
+	readTimeout              time.Duration
+	writeTimeout             time.Duration
+	idleTimeout              time.Duration
+	tlsCertFile              string
+	tlsKeyFile               string
+	fallbackDSN              string
+	maxOgTitleLength         int
+	maxOgDescriptionLength   int
+	maxOgImageLength         int
+	seed                     string
+	normalizeNames           bool
+	foldNameCase             bool
+	deadLinkReportSchedule   string
+	deadLinkReportWebhooks   []string
+	deniedHosts              string
+	redirectLog              string
+	redirectLogMaxSize       int64
+	redirectLogMaxAge        time.Duration
+	parseUserAgents          bool
+	discardRawUserAgents     bool
+	analyticsPauseBufferSize int
+	frontendFallback         string
+	frontendOrigin           string
+	clientIPHeader           string
+	trustedProxies           []string
+	pruneArchivedAfter       time.Duration
 
 	rootCmd = &cobra.Command{
 		Use:   "slash",
 		Short: `An open source, self-hosted bookmarks and link sharing platform.`,
-		Run: func(_cmd *cobra.Command, _args []string) {
+		Run: func(cmd *cobra.Command, _args []string) {
+			if err := checkListenFlags(cmd); err != nil {
+				log.Error("invalid listen address", zap.Error(err))
+				return
+			}
+
+			if serverProfile.Mode == "prod" {
+				for _, warning := range serverProfile.StorageWarnings() {
+					slog.Warn(warning, "dsn", profile.RedactDSN(serverProfile.DSN))
+				}
+			}
+
 			ctx, cancel := context.WithCancel(context.Background())
 			db := db.NewDB(serverProfile)
 			if err := db.Open(ctx); err != nil {
@@ -44,6 +115,15 @@ var (
 			}
 
 			storeInstance := store.New(db.DBInstance, serverProfile)
+			if serverProfile.FallbackDSN != "" {
+				fallbackStore, err := openFallbackStore(ctx)
+				if err != nil {
+					cancel()
+					log.Error("failed to open fallback database", zap.Error(err))
+					return
+				}
+				storeInstance.SetFallback(fallbackStore)
+			}
 			s, err := server.NewServer(ctx, serverProfile, storeInstance)
 			if err != nil {
 				cancel()
@@ -65,8 +145,24 @@ var (
 				s.Shutdown(ctx)
 				cancel()
 			}()
+			// Reload the lists read from files on SIGHUP.
+			reload := make(chan os.Signal, 1)
+			signal.Notify(reload, syscall.SIGHUP)
+			go func() {
+				for range reload {
+					if err := s.Reload(); err != nil {
+						log.Error("failed to reload", zap.Error(err))
+						continue
+					}
+					slog.Info("reloaded the lists of the server")
+				}
+			}()
 
-			printGreetings()
+			if isQuiet(cmd) {
+				slog.Info("server started", "version", serverProfile.Version, "port", serverProfile.Port, "socket", serverProfile.Socket)
+			} else {
+				printGreetings()
+			}
 
 			if err := s.Start(ctx); err != nil {
 				if err != http.ErrServerClosed {
@@ -91,7 +187,63 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVarP(&mode, "mode", "m", "demo", `mode of server, can be "prod" or "dev" or "demo"`)
 	rootCmd.PersistentFlags().IntVarP(&port, "port", "p", 8082, "port of server")
+	rootCmd.PersistentFlags().IntVar(&grpcPort, "grpc-port", 0, "port of the gRPC server, defaults to the port after the server port")
+	rootCmd.PersistentFlags().StringVar(&socket, "socket", "", "path of the Unix domain socket to listen on instead of the port")
 	rootCmd.PersistentFlags().StringVarP(&data, "data", "d", "", "data directory")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "do not print the greeting banner, defaults to true in prod mode")
+	rootCmd.PersistentFlags().BoolVar(&quiet, "no-banner", false, "alias of --quiet")
+	rootCmd.PersistentFlags().DurationVar(&sweepInterval, "sweep-interval", 0, "interval to delete orphaned rows in the background, 0 disables it")
+	rootCmd.PersistentFlags().StringVar(&blockedNames, "blocked-names", "", "path of the list of names that shortcuts cannot use")
+	rootCmd.PersistentFlags().StringVar(&reviewNames, "review-names", "", "path of the list of names that only admins can give to shortcuts")
+	rootCmd.PersistentFlags().BoolVar(&debugHeaders, "debug-headers", false, "expose the matched shortcut in redirect response headers, always on in dev mode")
+	rootCmd.PersistentFlags().StringVar(&previewParam, "preview-param", profile.DefaultPreviewParam, "query param letting owners and admins preview a shortcut instead of following it")
+	rootCmd.PersistentFlags().BoolVar(&preserveCase, "preserve-tag-case", false, "keep the case of shortcut tags instead of lowercasing them")
+	rootCmd.PersistentFlags().StringSliceVar(&trustedIPs, "trusted-ips", nil, "comma-separated IPs and CIDR ranges of health checks and monitors, which are not rate limited")
+	rootCmd.PersistentFlags().BoolVar(&countHead, "count-head-views", false, "count the HEAD requests of shortcuts as views")
+	rootCmd.PersistentFlags().IntVar(&userLimit, "user-rate-limit", 600, "number of API requests per minute users can make, 0 for unlimited")
+	rootCmd.PersistentFlags().IntVar(&adminLimit, "admin-rate-limit", 1200, "number of API requests per minute admins can make, 0 for unlimited")
+	rootCmd.PersistentFlags().IntVar(&createLimit, "creation-rate-limit", 0, "number of shortcuts users can create per creation rate window, 0 for unlimited")
+	rootCmd.PersistentFlags().DurationVar(&createWindow, "creation-rate-window", time.Hour, "window in which the shortcuts created by users are counted")
+	rootCmd.PersistentFlags().IntVar(&maxLinkLength, "max-link-length", profile.DefaultMaxLinkLength, "maximum length in bytes of shortcut links")
+	rootCmd.PersistentFlags().IntVar(&lockRetries, "lock-retries", 3, "number of times a write failing because the database is locked is retried")
+	rootCmd.PersistentFlags().BoolVar(&welcome, "welcome", false, "seed a new prod instance with the welcome shortcuts")
+	rootCmd.PersistentFlags().BoolVar(&previewFavicon, "preview-favicon", false, "fetch the favicons of shortcut links to show them in the link previews")
+	rootCmd.PersistentFlags().StringVar(&dsn, "dsn", "", "path of the database file, overriding the one derived from the data directory")
+	rootCmd.PersistentFlags().DurationVar(&targetHealthInterval, "target-health-interval", 0, "interval to health check the targets of shortcuts in the background, 0 disables it")
+	rootCmd.PersistentFlags().BoolVar(&healthCheckPrivate, "health-check-private-targets", false, "health check the targets of shortcuts on private and loopback addresses, which are otherwise never unhealthy")
+	rootCmd.PersistentFlags().StringVar(&slugGenerator, "slug-generator", "", "generator of the names of shortcuts created without one: base62, words or increment, empty requires names")
+	rootCmd.PersistentFlags().DurationVar(&slowQueryThreshold, "slow-query-threshold", 0, "duration above which store queries are logged as slow, 0 disables it")
+	rootCmd.PersistentFlags().IntVar(&maxTagsPerShortcut, "max-tags-per-shortcut", profile.DefaultMaxTagsPerShortcut, "maximum number of tags of a shortcut")
+	rootCmd.PersistentFlags().IntVar(&maxTagLength, "max-tag-length", profile.DefaultMaxTagLength, "maximum length in bytes of shortcut tags")
+	rootCmd.PersistentFlags().BoolVar(&sitemap, "sitemap", false, "serve a sitemap of the public shortcuts at /sitemap.xml, with the custom domain of the workspace")
+	rootCmd.PersistentFlags().BoolVar(&stripTrailingSlash, "strip-trailing-slash", false, "strip the trailing slashes of shortcut names before matching them, so that /s/name/ redirects as /s/name")
+	rootCmd.PersistentFlags().DurationVar(&readHeaderTimeout, "read-header-timeout", profile.DefaultReadHeaderTimeout, "maximum duration to read the headers of a request, 0 disables it")
+	rootCmd.PersistentFlags().DurationVar(&readTimeout, "read-timeout", profile.DefaultReadTimeout, "maximum duration to read a whole request, 0 disables it")
+	rootCmd.PersistentFlags().DurationVar(&writeTimeout, "write-timeout", profile.DefaultWriteTimeout, "maximum duration to write a response once its request is read, 0 disables it")
+	rootCmd.PersistentFlags().DurationVar(&idleTimeout, "idle-timeout", profile.DefaultIdleTimeout, "maximum duration a keep-alive connection waits for the next request, 0 disables it")
+	rootCmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert-file", "", "path of the TLS certificate to serve HTTPS and HTTP/2 with, along with --tls-key-file")
+	rootCmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key-file", "", "path of the private key of the TLS certificate")
+	rootCmd.PersistentFlags().StringVar(&fallbackDSN, "fallback-dsn", "", "data source of an older database, opened read-only and already migrated to this version, that missing shortcuts are read from and copied forward")
+	rootCmd.PersistentFlags().IntVar(&maxOgTitleLength, "max-og-title-length", profile.DefaultMaxOgTitleLength, "maximum length in bytes of the open graph titles of shortcuts")
+	rootCmd.PersistentFlags().IntVar(&maxOgDescriptionLength, "max-og-description-length", profile.DefaultMaxOgDescriptionLength, "maximum length in bytes of the open graph descriptions of shortcuts")
+	rootCmd.PersistentFlags().IntVar(&maxOgImageLength, "max-og-image-length", profile.DefaultMaxOgImageLength, "maximum length in bytes of the open graph image URLs of shortcuts")
+	rootCmd.PersistentFlags().StringVar(&seed, "seed", "", "path of a SQL file to seed a new prod database with")
+	rootCmd.PersistentFlags().BoolVar(&normalizeNames, "normalize-names", false, "normalize shortcut names to NFC and reject names confusable with existing ones")
+	rootCmd.PersistentFlags().BoolVar(&foldNameCase, "fold-name-case", false, "case-fold shortcut names on save and lookup")
+	rootCmd.PersistentFlags().StringVar(&deadLinkReportSchedule, "dead-link-report-schedule", "", "cron schedule, in UTC, of the report of the shortcuts whose targets failed the last health check, empty disables it")
+	rootCmd.PersistentFlags().StringSliceVar(&deadLinkReportWebhooks, "dead-link-report-webhooks", nil, "comma-separated URLs of the webhooks the dead link report is posted to")
+	rootCmd.PersistentFlags().StringVar(&deniedHosts, "denied-hosts", "", "path of the list of hosts shortcut links cannot point to, one per line, with *.example.com denying a domain and its subdomains; reloaded on SIGHUP")
+	rootCmd.PersistentFlags().StringVar(&redirectLog, "redirect-log", "", "path of the append-only file every redirect is logged to as a JSON line, empty disables it")
+	rootCmd.PersistentFlags().Int64Var(&redirectLogMaxSize, "redirect-log-max-size", 100, "size in megabytes at which the redirect log is rotated, 0 disables it")
+	rootCmd.PersistentFlags().DurationVar(&redirectLogMaxAge, "redirect-log-max-age", 24*time.Hour, "age at which the redirect log is rotated, 0 disables it")
+	rootCmd.PersistentFlags().BoolVar(&parseUserAgents, "parse-user-agents", false, "parse the user agents of views into their browser, OS and device type when they are recorded")
+	rootCmd.PersistentFlags().BoolVar(&discardRawUserAgents, "discard-raw-user-agents", false, "do not record the raw user agents of views, only their parsed fields with --parse-user-agents")
+	rootCmd.PersistentFlags().IntVar(&analyticsPauseBufferSize, "analytics-pause-buffer-size", profile.DefaultAnalyticsPauseBufferSize, "maximum number of views buffered in memory while analytics are paused, the views past it are dropped")
+	rootCmd.PersistentFlags().StringVar(&frontendFallback, "frontend-fallback", "", "the response to the paths of the web app: empty serves the bundled frontend, not-found responds 404 for API-only deployments, redirect or proxy sends them to the frontend-origin")
+	rootCmd.PersistentFlags().StringVar(&frontendOrigin, "frontend-origin", "", "the origin of the frontend hosted elsewhere, such as https://links.example.com, which the redirect and proxy frontend fallbacks send the paths of the web app to")
+	rootCmd.PersistentFlags().StringVar(&clientIPHeader, "client-ip-header", "", "header carrying the client IP set by the trusted proxies, such as X-Forwarded-For, X-Real-IP or CF-Connecting-IP, empty uses X-Forwarded-For and X-Real-IP from any peer")
+	rootCmd.PersistentFlags().StringSliceVar(&trustedProxies, "trusted-proxies", nil, "comma-separated IPs and CIDR ranges of the proxies the client-ip-header is read from")
+	rootCmd.PersistentFlags().DurationVar(&pruneArchivedAfter, "prune-archived-after", 0, "age after which the background sweep of sweep-interval permanently deletes archived shortcuts, 0 disables it")
 
 	err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode"))
 	if err != nil {
@@ -101,14 +253,235 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = viper.BindPFlag("grpc-port", rootCmd.PersistentFlags().Lookup("grpc-port"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("socket", rootCmd.PersistentFlags().Lookup("socket"))
+	if err != nil {
+		panic(err)
+	}
 	err = viper.BindPFlag("data", rootCmd.PersistentFlags().Lookup("data"))
 	if err != nil {
 		panic(err)
 	}
+	err = viper.BindPFlag("sweep-interval", rootCmd.PersistentFlags().Lookup("sweep-interval"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("blocked-names", rootCmd.PersistentFlags().Lookup("blocked-names"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("review-names", rootCmd.PersistentFlags().Lookup("review-names"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("debug-headers", rootCmd.PersistentFlags().Lookup("debug-headers"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("preview-param", rootCmd.PersistentFlags().Lookup("preview-param"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("preserve-tag-case", rootCmd.PersistentFlags().Lookup("preserve-tag-case"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("trusted-ips", rootCmd.PersistentFlags().Lookup("trusted-ips"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("count-head-views", rootCmd.PersistentFlags().Lookup("count-head-views"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("user-rate-limit", rootCmd.PersistentFlags().Lookup("user-rate-limit"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("admin-rate-limit", rootCmd.PersistentFlags().Lookup("admin-rate-limit"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("creation-rate-limit", rootCmd.PersistentFlags().Lookup("creation-rate-limit"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("creation-rate-window", rootCmd.PersistentFlags().Lookup("creation-rate-window"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-link-length", rootCmd.PersistentFlags().Lookup("max-link-length"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("lock-retries", rootCmd.PersistentFlags().Lookup("lock-retries"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("welcome", rootCmd.PersistentFlags().Lookup("welcome"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("preview-favicon", rootCmd.PersistentFlags().Lookup("preview-favicon"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("dsn", rootCmd.PersistentFlags().Lookup("dsn"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("target-health-interval", rootCmd.PersistentFlags().Lookup("target-health-interval"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("health-check-private-targets", rootCmd.PersistentFlags().Lookup("health-check-private-targets"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("slug-generator", rootCmd.PersistentFlags().Lookup("slug-generator"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("slow-query-threshold", rootCmd.PersistentFlags().Lookup("slow-query-threshold"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-tags-per-shortcut", rootCmd.PersistentFlags().Lookup("max-tags-per-shortcut"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-tag-length", rootCmd.PersistentFlags().Lookup("max-tag-length"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("sitemap", rootCmd.PersistentFlags().Lookup("sitemap"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("strip-trailing-slash", rootCmd.PersistentFlags().Lookup("strip-trailing-slash"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("read-header-timeout", rootCmd.PersistentFlags().Lookup("read-header-timeout"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("read-timeout", rootCmd.PersistentFlags().Lookup("read-timeout"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("write-timeout", rootCmd.PersistentFlags().Lookup("write-timeout"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("idle-timeout", rootCmd.PersistentFlags().Lookup("idle-timeout"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("tls-cert-file", rootCmd.PersistentFlags().Lookup("tls-cert-file"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("tls-key-file", rootCmd.PersistentFlags().Lookup("tls-key-file"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("fallback-dsn", rootCmd.PersistentFlags().Lookup("fallback-dsn"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-og-title-length", rootCmd.PersistentFlags().Lookup("max-og-title-length"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-og-description-length", rootCmd.PersistentFlags().Lookup("max-og-description-length"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("max-og-image-length", rootCmd.PersistentFlags().Lookup("max-og-image-length"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("seed", rootCmd.PersistentFlags().Lookup("seed"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("normalize-names", rootCmd.PersistentFlags().Lookup("normalize-names"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("fold-name-case", rootCmd.PersistentFlags().Lookup("fold-name-case"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("dead-link-report-schedule", rootCmd.PersistentFlags().Lookup("dead-link-report-schedule"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("dead-link-report-webhooks", rootCmd.PersistentFlags().Lookup("dead-link-report-webhooks"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("denied-hosts", rootCmd.PersistentFlags().Lookup("denied-hosts"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("redirect-log", rootCmd.PersistentFlags().Lookup("redirect-log"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("redirect-log-max-size", rootCmd.PersistentFlags().Lookup("redirect-log-max-size"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("redirect-log-max-age", rootCmd.PersistentFlags().Lookup("redirect-log-max-age"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("parse-user-agents", rootCmd.PersistentFlags().Lookup("parse-user-agents"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("discard-raw-user-agents", rootCmd.PersistentFlags().Lookup("discard-raw-user-agents"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("analytics-pause-buffer-size", rootCmd.PersistentFlags().Lookup("analytics-pause-buffer-size"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("frontend-fallback", rootCmd.PersistentFlags().Lookup("frontend-fallback"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("frontend-origin", rootCmd.PersistentFlags().Lookup("frontend-origin"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("client-ip-header", rootCmd.PersistentFlags().Lookup("client-ip-header"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("trusted-proxies", rootCmd.PersistentFlags().Lookup("trusted-proxies"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("prune-archived-after", rootCmd.PersistentFlags().Lookup("prune-archived-after"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindEnv("quiet")
+	if err != nil {
+		panic(err)
+	}
 
 	viper.SetDefault("mode", "demo")
 	viper.SetDefault("port", 8082)
 	viper.SetEnvPrefix("slash")
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 }
 
 func initConfig() {
@@ -120,18 +493,49 @@ func initConfig() {
 		return
 	}
 
-	println("---")
-	println("Server profile")
-	println("dsn:", serverProfile.DSN)
-	println("port:", serverProfile.Port)
-	println("mode:", serverProfile.Mode)
-	println("version:", serverProfile.Version)
-	println("---")
+	logLevel := slog.LevelInfo
+	if serverProfile.IsDev() {
+		logLevel = slog.LevelDebug
+	}
+	// Include the request ID in every log line emitted while serving a request.
+	slog.SetDefault(slog.New(requestid.NewHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))))
+	slog.Debug("server profile", "profile", serverProfile)
+}
+
+// isQuiet returns whether the greeting banner should be skipped.
+// It defaults to true in prod mode unless --quiet, --no-banner or SLASH_QUIET is given.
+func isQuiet(cmd *cobra.Command) bool {
+	flags := cmd.Flags()
+	if flags.Changed("quiet") || flags.Changed("no-banner") {
+		return quiet
+	}
+	if viper.IsSet("quiet") {
+		return viper.GetBool("quiet")
+	}
+	return serverProfile.Mode == "prod"
+}
+
+// checkListenFlags returns an error if both a socket and a port are given, as only the socket is listened on.
+func checkListenFlags(cmd *cobra.Command) error {
+	if serverProfile.Socket == "" {
+		return nil
+	}
+	if cmd.Flags().Changed("port") || os.Getenv("SLASH_PORT") != "" {
+		return errors.New("--socket and --port cannot be both set")
+	}
+	if cmd.Flags().Changed("grpc-port") || os.Getenv("SLASH_GRPC_PORT") != "" {
+		return errors.New("--socket and --grpc-port cannot be both set")
+	}
+	return nil
 }
 
 func printGreetings() {
 	println(greetingBanner)
-	fmt.Printf("Version %s has been started on port %d\n", serverProfile.Version, serverProfile.Port)
+	if serverProfile.Socket != "" {
+		fmt.Printf("Version %s has been started on socket %s\n", serverProfile.Version, serverProfile.Socket)
+	} else {
+		fmt.Printf("Version %s has been started on port %d\n", serverProfile.Version, serverProfile.Port)
+	}
 	println("---")
 	println("See more in:")
 	fmt.Printf("👉GitHub: %s\n", "https://github.com/yourselfhosted/slash")
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/store/db"
+)
+
+var (
+	migrateStatusJSON bool
+
+	migrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Database migration commands.",
+	}
+
+	migrateStatusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "Print the migration history and the schema version of the database.",
+		Run: func(_cmd *cobra.Command, _args []string) {
+			ctx := context.Background()
+			status, err := getMigrationStatus(ctx)
+			if err != nil {
+				log.Error("failed to get migration status", zap.Error(err))
+				return
+			}
+			if err := printMigrationStatus(os.Stdout, status, migrateStatusJSON); err != nil {
+				log.Error("failed to print migration status", zap.Error(err))
+			}
+		},
+	}
+
+	migrateVerifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the layout of the migrations embedded in the binary.",
+		Run: func(_cmd *cobra.Command, _args []string) {
+			if err := db.VerifyEmbeddedMigrations(); err != nil {
+				log.Error("invalid embedded migrations", zap.Error(err))
+				os.Exit(1)
+			}
+			fmt.Println("The embedded migrations are valid.")
+		},
+	}
+)
+
+func init() {
+	migrateStatusCmd.Flags().BoolVar(&migrateStatusJSON, "json", false, "print the migration status as JSON")
+	migrateCmd.AddCommand(migrateStatusCmd)
+	migrateCmd.AddCommand(migrateVerifyCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// getMigrationStatus reads the migration status of the profile database without migrating it.
+func getMigrationStatus(ctx context.Context) (*db.MigrationStatus, error) {
+	if _, err := os.Stat(serverProfile.DSN); err != nil {
+		return nil, errors.Wrap(err, "failed to find database file")
+	}
+	database := db.NewDB(serverProfile)
+	if err := database.Connect(); err != nil {
+		return nil, err
+	}
+	defer database.DBInstance.Close()
+	return database.GetMigrationStatus(ctx)
+}
+
+type migrationHistoryJSON struct {
+	Version   string `json:"version"`
+	CreatedTs int64  `json:"createdTs"`
+}
+
+type migrationStatusJSON struct {
+	History             []migrationHistoryJSON `json:"history"`
+	SchemaVersion       string                 `json:"schemaVersion"`
+	TargetSchemaVersion string                 `json:"targetSchemaVersion"`
+	Pending             bool                   `json:"pending"`
+}
+
+func printMigrationStatus(w io.Writer, status *db.MigrationStatus, asJSON bool) error {
+	if asJSON {
+		output := migrationStatusJSON{
+			History:             []migrationHistoryJSON{},
+			SchemaVersion:       status.SchemaVersion,
+			TargetSchemaVersion: status.TargetSchemaVersion,
+			Pending:             status.IsPending(),
+		}
+		for _, migrationHistory := range status.History {
+			output.History = append(output.History, migrationHistoryJSON{
+				Version:   migrationHistory.Version,
+				CreatedTs: migrationHistory.CreatedTs,
+			})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tAPPLIED AT")
+	for _, migrationHistory := range status.History {
+		fmt.Fprintf(tw, "%s\t%s\n", migrationHistory.Version, time.Unix(migrationHistory.CreatedTs, 0).UTC().Format(time.RFC3339))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	schemaVersion := status.SchemaVersion
+	if schemaVersion == "" {
+		schemaVersion = "none"
+	}
+	fmt.Fprintf(w, "Current schema version: %s\n", schemaVersion)
+	fmt.Fprintf(w, "Target schema version: %s\n", status.TargetSchemaVersion)
+	if status.IsPending() {
+		fmt.Fprintln(w, "Migrations are pending.")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/yourselfhosted/slash/server/version"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestMigrateStatus(t *testing.T) {
+	ctx := context.Background()
+	serverProfile = test.GetTestingProfile(t)
+	database := db.NewDB(serverProfile)
+	require.NoError(t, database.Open(ctx))
+	for _, v := range []string{"0.4.0", "0.3.0"} {
+		_, err := database.UpsertMigrationHistory(ctx, &db.MigrationHistoryUpsert{
+			Version: v,
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, database.DBInstance.Close())
+
+	status, err := getMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(status.History))
+	require.Equal(t, "0.3.0", status.History[0].Version)
+	require.Equal(t, "0.4.0", status.History[1].Version)
+	require.Equal(t, "0.4.0", status.SchemaVersion)
+	targetSchemaVersion := version.GetSchemaVersion(version.GetCurrentVersion(serverProfile.Mode))
+	require.Equal(t, targetSchemaVersion, status.TargetSchemaVersion)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, printMigrationStatus(buf, status, false))
+	lines := strings.Split(buf.String(), "\n")
+	require.True(t, strings.HasPrefix(lines[0], "VERSION"))
+	require.True(t, strings.HasPrefix(lines[1], "0.3.0"))
+	require.True(t, strings.HasPrefix(lines[2], "0.4.0"))
+	require.Contains(t, buf.String(), "Current schema version: 0.4.0")
+	require.Contains(t, buf.String(), "Target schema version: "+targetSchemaVersion)
+
+	buf.Reset()
+	require.NoError(t, printMigrationStatus(buf, status, true))
+	output := &migrationStatusJSON{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), output))
+	require.Equal(t, 2, len(output.History))
+	require.Equal(t, "0.3.0", output.History[0].Version)
+	require.Equal(t, "0.4.0", output.SchemaVersion)
+	require.Equal(t, status.IsPending(), output.Pending)
+}
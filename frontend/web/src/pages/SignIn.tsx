@@ -48,6 +48,12 @@ const SignIn: React.FC = () => {
       if (user) {
         userStore.setCurrentUserId(user.id);
         await userStore.fetchCurrentUser();
+        // Visitors redirected here from a short link are sent back to it.
+        const returnTo = new URLSearchParams(window.location.search).get("return_to");
+        if (returnTo && new URL(returnTo, window.location.origin).origin === window.location.origin) {
+          window.location.href = returnTo;
+          return;
+        }
         navigateTo("/");
       } else {
         toast.error("Signin failed");
@@ -0,0 +1,20 @@
+package analytics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+)
+
+// HashIP returns the keyed hash of the IP, so that views from the same IP can still be
+// counted as the same visitor without recording the IP itself.
+// Values that are not IPs, e.g. IPs that are already hashed, are returned as is.
+func HashIP(key, ip string) string {
+	if net.ParseIP(ip) == nil {
+		return ip
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(ip))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,17 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashIP(t *testing.T) {
+	hashed := HashIP("key", "1.1.1.1")
+	require.NotEqual(t, "1.1.1.1", hashed)
+	require.Equal(t, hashed, HashIP("key", "1.1.1.1"))
+	require.NotEqual(t, hashed, HashIP("other", "1.1.1.1"))
+	require.NotEqual(t, hashed, HashIP("key", "2.2.2.2"))
+	// Hashed IPs are not hashed again.
+	require.Equal(t, hashed, HashIP("key", hashed))
+}
@@ -0,0 +1,56 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// SessionTimeout is the idle time after which the next view from the same IP starts a new session.
+const SessionTimeout = 30 * time.Minute
+
+// View is a single view of a shortcut.
+type View struct {
+	IP string
+	// CreatedTs is the unix timestamp in seconds of the view.
+	CreatedTs int64
+}
+
+// CountUniqueVisitors counts the views so that the views from the same IP within the window count once.
+func CountUniqueVisitors(views []View, window storepb.UniqueVisitorWindow) int {
+	switch window {
+	case storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_ALL_TIME:
+		visitors := map[string]bool{}
+		for _, view := range views {
+			visitors[view.IP] = true
+		}
+		return len(visitors)
+	case storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION:
+		sorted := make([]View, len(views))
+		copy(sorted, views)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].CreatedTs < sorted[j].CreatedTs
+		})
+		count := 0
+		lastViewTs := map[string]int64{}
+		for _, view := range sorted {
+			if ts, ok := lastViewTs[view.IP]; !ok || view.CreatedTs-ts > int64(SessionTimeout.Seconds()) {
+				count++
+			}
+			lastViewTs[view.IP] = view.CreatedTs
+		}
+		return count
+	default:
+		type visitorDay struct {
+			ip  string
+			day string
+		}
+		visitors := map[visitorDay]bool{}
+		for _, view := range views {
+			day := time.Unix(view.CreatedTs, 0).UTC().Format(time.DateOnly)
+			visitors[visitorDay{ip: view.IP, day: day}] = true
+		}
+		return len(visitors)
+	}
+}
@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+func TestCountUniqueVisitors(t *testing.T) {
+	dayStart := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC).Unix()
+	views := []View{
+		// The same IP hit twice close to midnight, then again the next day.
+		{IP: "1.1.1.1", CreatedTs: dayStart - 60},
+		{IP: "1.1.1.1", CreatedTs: dayStart + 60},
+		{IP: "1.1.1.1", CreatedTs: dayStart + 10*60},
+		// The same IP hit again after the session timeout on the same day.
+		{IP: "1.1.1.1", CreatedTs: dayStart + 10*60 + int64(SessionTimeout.Seconds()) + 1},
+		// Another IP hit once.
+		{IP: "2.2.2.2", CreatedTs: dayStart + 60},
+	}
+
+	tests := []struct {
+		window storepb.UniqueVisitorWindow
+		want   int
+	}{
+		{
+			window: storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_UNSPECIFIED,
+			want:   3,
+		},
+		{
+			window: storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_DAY,
+			want:   3,
+		},
+		{
+			window: storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION,
+			want:   3,
+		},
+		{
+			window: storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_ALL_TIME,
+			want:   2,
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, CountUniqueVisitors(views, test.window), test.window.String())
+	}
+	require.Equal(t, 0, CountUniqueVisitors(nil, storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_DAY))
+}
+
+func TestCountUniqueVisitorsSession(t *testing.T) {
+	timeout := int64(SessionTimeout.Seconds())
+	// Unordered views within one session count once.
+	views := []View{
+		{IP: "1.1.1.1", CreatedTs: 1000 + timeout},
+		{IP: "1.1.1.1", CreatedTs: 1000},
+		{IP: "1.1.1.1", CreatedTs: 1000 + 2*timeout},
+	}
+	require.Equal(t, 1, CountUniqueVisitors(views, storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION))
+	views = append(views, View{IP: "1.1.1.1", CreatedTs: 1000 + 3*timeout + 1})
+	require.Equal(t, 2, CountUniqueVisitors(views, storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION))
+}
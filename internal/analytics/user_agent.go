@@ -0,0 +1,48 @@
+package analytics
+
+import (
+	"github.com/mssola/useragent"
+)
+
+// The device types of the user agents.
+const (
+	DeviceTypeDesktop = "desktop"
+	DeviceTypeMobile  = "mobile"
+	DeviceTypeBot     = "bot"
+)
+
+// UserAgent is the browser, operating system and device type parsed from a user agent string.
+type UserAgent struct {
+	Browser    string
+	OS         string
+	DeviceType string
+}
+
+// ParseUserAgent parses the user agent string, an empty string has no browser, OS nor device type.
+func ParseUserAgent(s string) UserAgent {
+	if s == "" {
+		return UserAgent{}
+	}
+	ua := useragent.New(s)
+	browser, _ := ua.Browser()
+	deviceType := DeviceTypeDesktop
+	if ua.Bot() {
+		deviceType = DeviceTypeBot
+	} else if ua.Mobile() {
+		deviceType = DeviceTypeMobile
+	}
+	return UserAgent{
+		Browser:    browser,
+		OS:         ua.OSInfo().Name,
+		DeviceType: deviceType,
+	}
+}
+
+// RecordedUserAgent returns the user agent recorded along with a view, parsing the user agent string
+// of the views recorded before their browser, OS and device type were.
+func RecordedUserAgent(s, browser, os, deviceType string) UserAgent {
+	if deviceType != "" {
+		return UserAgent{Browser: browser, OS: os, DeviceType: deviceType}
+	}
+	return ParseUserAgent(s)
+}
@@ -0,0 +1,50 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      UserAgent
+	}{
+		{
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			want:      UserAgent{Browser: "Chrome", OS: "Windows", DeviceType: DeviceTypeDesktop},
+		},
+		{
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:121.0) Gecko/20100101 Firefox/121.0",
+			want:      UserAgent{Browser: "Firefox", OS: "Mac OS X", DeviceType: DeviceTypeDesktop},
+		},
+		{
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			want:      UserAgent{Browser: "Safari", OS: "iPhone OS", DeviceType: DeviceTypeMobile},
+		},
+		{
+			userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.144 Mobile Safari/537.36",
+			want:      UserAgent{Browser: "Chrome", OS: "Android", DeviceType: DeviceTypeMobile},
+		},
+		{
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			want:      UserAgent{Browser: "Googlebot", OS: "", DeviceType: DeviceTypeBot},
+		},
+		{
+			userAgent: "",
+			want:      UserAgent{},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, ParseUserAgent(test.userAgent), test.userAgent)
+	}
+}
+
+func TestRecordedUserAgent(t *testing.T) {
+	firefox := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:121.0) Gecko/20100101 Firefox/121.0"
+	// The recorded fields are returned as recorded.
+	require.Equal(t, UserAgent{Browser: "Chrome", OS: "Linux", DeviceType: DeviceTypeDesktop}, RecordedUserAgent(firefox, "Chrome", "Linux", DeviceTypeDesktop))
+	// The user agent string of the views recorded without them is parsed.
+	require.Equal(t, UserAgent{Browser: "Firefox", OS: "Mac OS X", DeviceType: DeviceTypeDesktop}, RecordedUserAgent(firefox, "", "", ""))
+}
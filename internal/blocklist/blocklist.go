@@ -0,0 +1,156 @@
+package blocklist
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Verdict is the result of checking a name against the blocklist.
+type Verdict int
+
+const (
+	// Allowed names can be used freely.
+	Allowed Verdict = iota
+	// Blocked names cannot be used.
+	Blocked
+	// ReviewRequired names can only be used with the approval of an admin.
+	ReviewRequired
+)
+
+// matcher matches names against exact terms and regex patterns.
+type matcher struct {
+	terms    map[string]bool
+	patterns []*regexp.Regexp
+}
+
+// Blocklist checks shortcut names against a list of blocked names and a list of names to review.
+// A nil Blocklist allows every name.
+type Blocklist struct {
+	blocked *matcher
+	review  *matcher
+}
+
+// New returns a blocklist from the lines of the blocked and review lists.
+// Every line is an exact term, or a regex pattern when it is wrapped in slashes, e.g. "/^paypa[l1]/".
+// The patterns are matched against the names without case and diacritics, so they are written without
+// diacritics.
+// Empty lines and lines starting with "#" are ignored.
+func New(blockedLines, reviewLines []string) (*Blocklist, error) {
+	blocked, err := newMatcher(blockedLines)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid blocked list")
+	}
+	review, err := newMatcher(reviewLines)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid review list")
+	}
+	return &Blocklist{
+		blocked: blocked,
+		review:  review,
+	}, nil
+}
+
+// Load returns a blocklist from the blocked and review list files, an empty path is an empty list.
+func Load(blockedPath, reviewPath string) (*Blocklist, error) {
+	blockedLines, err := readLines(blockedPath)
+	if err != nil {
+		return nil, err
+	}
+	reviewLines, err := readLines(reviewPath)
+	if err != nil {
+		return nil, err
+	}
+	return New(blockedLines, reviewLines)
+}
+
+// Check returns whether the name is allowed, blocked or requires a review.
+// Matching ignores case and diacritics.
+func (b *Blocklist) Check(name string) Verdict {
+	if b == nil {
+		return Allowed
+	}
+	normalized := Normalize(name)
+	if b.blocked.match(normalized) {
+		return Blocked
+	}
+	if b.review.match(normalized) {
+		return ReviewRequired
+	}
+	return Allowed
+}
+
+// Normalize lowercases the name and removes its diacritics, e.g. "Pàypal" becomes "paypal".
+func Normalize(name string) string {
+	decomposed := norm.NFD.String(strings.ToLower(name))
+	var builder strings.Builder
+	for _, r := range decomposed {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return norm.NFC.String(builder.String())
+}
+
+func newMatcher(lines []string) (*matcher, error) {
+	m := &matcher{
+		terms: map[string]bool{},
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if len(line) > 2 && strings.HasPrefix(line, "/") && strings.HasSuffix(line, "/") {
+			// The pattern is matched against the normalized names as it is, since lowercasing it would
+			// turn classes such as \D or \W into their opposites, and case is ignored anyway.
+			pattern, err := regexp.Compile("(?i)" + line[1:len(line)-1])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid pattern %s", line)
+			}
+			m.patterns = append(m.patterns, pattern)
+			continue
+		}
+		m.terms[Normalize(line)] = true
+	}
+	return m, nil
+}
+
+func (m *matcher) match(normalized string) bool {
+	if m.terms[normalized] {
+		return true
+	}
+	for _, pattern := range m.patterns {
+		if pattern.MatchString(normalized) {
+			return true
+		}
+	}
+	return false
+}
+
+func readLines(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer file.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	return lines, nil
+}
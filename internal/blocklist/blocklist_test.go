@@ -0,0 +1,66 @@
+package blocklist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocklistCheck(t *testing.T) {
+	b, err := New([]string{
+		"# Brands",
+		"paypal",
+		"/^g[o0]{2}gle/",
+		`/^\D+-\d{4}$/`,
+		"",
+	}, []string{
+		"bank",
+		"/login$/",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		want Verdict
+	}{
+		{name: "paypal", want: Blocked},
+		{name: "PayPal", want: Blocked},
+		{name: "pàypàl", want: Blocked},
+		{name: "paypal-support", want: Allowed},
+		{name: "g00gle-drive", want: Blocked},
+		{name: "GÖÖGLE", want: Blocked},
+		{name: "my-google", want: Allowed},
+		// The classes of the patterns keep their meaning, regardless of case.
+		{name: "Scam-2024", want: Blocked},
+		{name: "team-abcd", want: Allowed},
+		{name: "Bänk", want: ReviewRequired},
+		{name: "team-login", want: ReviewRequired},
+		{name: "docs", want: Allowed},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, b.Check(test.name), test.name)
+	}
+
+	var empty *Blocklist
+	require.Equal(t, Allowed, empty.Check("paypal"))
+}
+
+func TestBlocklistLoad(t *testing.T) {
+	dir := t.TempDir()
+	blockedPath := filepath.Join(dir, "blocked.txt")
+	require.NoError(t, os.WriteFile(blockedPath, []byte("paypal\n/^bank/\n"), 0644))
+
+	b, err := Load(blockedPath, "")
+	require.NoError(t, err)
+	require.Equal(t, Blocked, b.Check("bank-of-slash"))
+	require.Equal(t, Allowed, b.Check("docs"))
+
+	_, err = Load(filepath.Join(dir, "missing.txt"), "")
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(blockedPath, []byte("/[/\n"), 0644))
+	_, err = Load(blockedPath, "")
+	require.Error(t, err)
+}
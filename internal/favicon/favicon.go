@@ -0,0 +1,169 @@
+package favicon
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxIconSize is the max size of a favicon, bigger ones are not used.
+	maxIconSize = 64 * 1024
+	// maxCacheSize is the max number of origins in the cache.
+	maxCacheSize = 1000
+)
+
+// Icon is a favicon.
+type Icon struct {
+	ContentType string
+	Data        []byte
+}
+
+// DataURL returns the favicon as a data URL, which pages can embed without linking to the site.
+func (i *Icon) DataURL() string {
+	return fmt.Sprintf("data:%s;base64,%s", i.ContentType, base64.StdEncoding.EncodeToString(i.Data))
+}
+
+// Source fetches the favicon of an origin, e.g. "https://example.com".
+type Source func(ctx context.Context, origin string) (*Icon, error)
+
+// HTTPSource returns the source fetching the /favicon.ico of origins with the client.
+func HTTPSource(client *http.Client) Source {
+	return func(ctx context.Context, origin string) (*Icon, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/favicon.ico", nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxIconSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > maxIconSize {
+			return nil, errors.Errorf("favicon is bigger than %d bytes", maxIconSize)
+		}
+		// Sites often answer missing favicons with a page, which is no image.
+		contentType := http.DetectContentType(data)
+		if !strings.HasPrefix(contentType, "image/") {
+			contentType = resp.Header.Get("Content-Type")
+		}
+		if !strings.HasPrefix(contentType, "image/") {
+			return nil, errors.Errorf("unexpected content type %q", contentType)
+		}
+		return &Icon{
+			ContentType: contentType,
+			Data:        data,
+		}, nil
+	}
+}
+
+type entry struct {
+	// icon is nil when the origin has no favicon.
+	icon      *Icon
+	expiresAt time.Time
+}
+
+// Cache keeps the favicons of origins, so that each is fetched at most once per TTL. The favicons are
+// fetched in the background, so that the requests showing them never wait for the origins.
+type Cache struct {
+	source Source
+	ttl    time.Duration
+
+	mutex    sync.Mutex
+	entries  map[string]*entry
+	fetching map[string]bool
+	wg       sync.WaitGroup
+}
+
+// NewCache returns a cache of the favicons fetched from the source.
+func NewCache(source Source, ttl time.Duration) *Cache {
+	return &Cache{
+		source:   source,
+		ttl:      ttl,
+		entries:  map[string]*entry{},
+		fetching: map[string]bool{},
+	}
+}
+
+// Get returns the favicon of the origin of the link, or nil when its favicon isn't fetched yet or can't be.
+// The favicons not cached within the TTL are fetched in the background, the expired one being returned
+// meanwhile. Failed fetches are cached as well, so that an origin without favicon isn't fetched again within the TTL.
+func (c *Cache) Get(ctx context.Context, link string, now time.Time) *Icon {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil
+	}
+	origin := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.entries[origin]
+	if ok && now.Before(e.expiresAt) {
+		return e.icon
+	}
+	if !c.fetching[origin] && len(c.fetching) < maxCacheSize {
+		c.fetching[origin] = true
+		c.wg.Add(1)
+		// The fetch outlives the request, which must not cancel it.
+		go c.fetch(context.WithoutCancel(ctx), origin, now)
+	}
+	if ok {
+		return e.icon
+	}
+	return nil
+}
+
+func (c *Cache) fetch(ctx context.Context, origin string, now time.Time) {
+	defer c.wg.Done()
+	icon, err := c.source(ctx, origin)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.fetching, origin)
+	if err != nil {
+		// A cancelled or timed out fetch says nothing of the favicon of the origin, which is fetched again.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			slog.DebugContext(ctx, "favicon fetch interrupted", "origin", origin, "error", err)
+			return
+		}
+		slog.DebugContext(ctx, "failed to fetch favicon", "origin", origin, "error", err)
+		icon = nil
+	}
+	c.store(origin, &entry{icon: icon, expiresAt: now.Add(c.ttl)}, now)
+}
+
+// wait waits for the fetches in the background to be done.
+func (c *Cache) wait() {
+	c.wg.Wait()
+}
+
+// store caches the entry of the origin, with the mutex held.
+func (c *Cache) store(origin string, e *entry, now time.Time) {
+	if _, ok := c.entries[origin]; !ok && len(c.entries) >= maxCacheSize {
+		for k, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCacheSize {
+			return
+		}
+	}
+	c.entries[origin] = e
+}
@@ -0,0 +1,90 @@
+package favicon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	fetched := []string{}
+	cache := NewCache(func(ctx context.Context, origin string) (*Icon, error) {
+		fetched = append(fetched, origin)
+		switch origin {
+		case "https://missing.com":
+			return nil, errors.New("not found")
+		case "https://slow.com":
+			return nil, errors.Wrap(context.DeadlineExceeded, "timeout")
+		}
+		// The fetch isn't cancelled with the request.
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return &Icon{ContentType: "image/png", Data: []byte("png")}, nil
+	}, time.Hour)
+	now := time.Now()
+
+	// The favicon is fetched in the background, the request getting none meanwhile.
+	requestCtx, cancel := context.WithCancel(ctx)
+	require.Nil(t, cache.Get(requestCtx, "https://example.com/a?b=c", now))
+	cancel()
+	cache.wait()
+	icon := cache.Get(ctx, "https://example.com/a?b=c", now)
+	require.Equal(t, &Icon{ContentType: "image/png", Data: []byte("png")}, icon)
+	require.Equal(t, "data:image/png;base64,cG5n", icon.DataURL())
+	// The favicon is cached by origin.
+	require.Equal(t, icon, cache.Get(ctx, "https://example.com/other", now.Add(time.Minute)))
+	require.Equal(t, []string{"https://example.com"}, fetched)
+
+	// Failed fetches fall back to no favicon, and are cached too.
+	require.Nil(t, cache.Get(ctx, "https://missing.com", now))
+	cache.wait()
+	require.Nil(t, cache.Get(ctx, "https://missing.com", now))
+	cache.wait()
+	require.Equal(t, []string{"https://example.com", "https://missing.com"}, fetched)
+
+	// Timed out fetches are not cached, so that the favicon is fetched again.
+	require.Nil(t, cache.Get(ctx, "https://slow.com", now))
+	cache.wait()
+	require.Nil(t, cache.Get(ctx, "https://slow.com", now))
+	cache.wait()
+	require.Equal(t, []string{"https://example.com", "https://missing.com", "https://slow.com", "https://slow.com"}, fetched)
+
+	// The favicon is fetched again after the TTL, the expired one being returned meanwhile.
+	require.Equal(t, icon, cache.Get(ctx, "https://example.com", now.Add(time.Hour)))
+	cache.wait()
+	require.Equal(t, 5, len(fetched))
+
+	// Links without an http origin have no favicon.
+	require.Nil(t, cache.Get(ctx, "mailto:user@example.com", now))
+	require.Nil(t, cache.Get(ctx, "some text", now))
+	require.Equal(t, 5, len(fetched))
+}
+
+func TestHTTPSource(t *testing.T) {
+	ctx := context.Background()
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	iconServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(png)
+	}))
+	defer iconServer.Close()
+	icon, err := HTTPSource(iconServer.Client())(ctx, iconServer.URL)
+	require.NoError(t, err)
+	require.Equal(t, "image/png", icon.ContentType)
+	require.Equal(t, png, icon.Data)
+
+	// Pages answered instead of the favicon are no favicons.
+	pageServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html></html>"))
+	}))
+	defer pageServer.Close()
+	_, err = HTTPSource(pageServer.Client())(ctx, pageServer.URL)
+	require.ErrorContains(t, err, "unexpected content type")
+}
@@ -0,0 +1,113 @@
+package filelog
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// rotatedTimeFormat is the suffix of the rotated files, in UTC so that they sort by time.
+const rotatedTimeFormat = "20060102T150405.000000000"
+
+// Writer appends lines to a file, which is rotated once it reaches its maximum size or age.
+// The file is only ever appended to, and the rotated files are renamed next to it and kept.
+type Writer struct {
+	path    string
+	maxSize int64
+	maxAge  time.Duration
+	now     func() time.Time
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// New returns the writer of the file at path. A zero maxSize or maxAge disables the rotation on
+// size or age. The file is opened on the first write.
+func New(path string, maxSize int64, maxAge time.Duration) *Writer {
+	return &Writer{
+		path:    path,
+		maxSize: maxSize,
+		maxAge:  maxAge,
+		now:     time.Now,
+	}
+}
+
+// WriteLine appends the line to the file, followed by a newline. The file is rotated first when
+// the line would take it past its maximum size, or when it is older than its maximum age.
+func (w *Writer) WriteLine(line []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if w.size > 0 && w.shouldRotate(int64(len(line)+1)) {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(append(line, '\n'))
+	w.size += int64(n)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write to %s", w.path)
+	}
+	return nil
+}
+
+// Close closes the file, the next write opens it again.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+func (w *Writer) shouldRotate(n int64) bool {
+	if w.maxSize > 0 && w.size+n > w.maxSize {
+		return true
+	}
+	return w.maxAge > 0 && w.now().Sub(w.openedAt) >= w.maxAge
+}
+
+// open opens the file for appending, the age of the file is counted from then.
+func (w *Writer) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create the directory of %s", w.path)
+	}
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", w.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to stat %s", w.path)
+	}
+	w.file, w.size, w.openedAt = file, info.Size(), w.now()
+	return nil
+}
+
+// rotate renames the file after the time of the rotation and opens a new one in its place.
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close %s", w.path)
+	}
+	w.file = nil
+	rotatedPath := w.path + "." + w.now().UTC().Format(rotatedTimeFormat)
+	if err := os.Rename(w.path, rotatedPath); err != nil {
+		return errors.Wrapf(err, "failed to rotate %s", w.path)
+	}
+	return w.open()
+}
@@ -0,0 +1,78 @@
+package filelog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterRotateOnSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "redirects.log")
+	w := New(path, 13, 0)
+	defer w.Close()
+
+	require.NoError(t, w.WriteLine([]byte("first")))
+	require.NoError(t, w.WriteLine([]byte("second")))
+	requireFileContent(t, path, "first\nsecond\n")
+	require.Empty(t, rotatedFiles(t, path))
+
+	// The next line would take the file past its maximum size.
+	require.NoError(t, w.WriteLine([]byte("third")))
+	requireFileContent(t, path, "third\n")
+	rotated := rotatedFiles(t, path)
+	require.Len(t, rotated, 1)
+	requireFileContent(t, rotated[0], "first\nsecond\n")
+
+	// A line longer than the maximum size is still written, alone in its file.
+	require.NoError(t, w.WriteLine([]byte("a line longer than the maximum")))
+	require.NoError(t, w.WriteLine([]byte("fourth")))
+	require.Len(t, rotatedFiles(t, path), 3)
+	requireFileContent(t, path, "fourth\n")
+}
+
+func TestWriterRotateOnAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redirects.log")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := New(path, 0, time.Hour)
+	w.now = func() time.Time { return now }
+	defer w.Close()
+
+	require.NoError(t, w.WriteLine([]byte("first")))
+	now = now.Add(59 * time.Minute)
+	require.NoError(t, w.WriteLine([]byte("second")))
+	require.Empty(t, rotatedFiles(t, path))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, w.WriteLine([]byte("third")))
+	requireFileContent(t, path, "third\n")
+	requireFileContent(t, path+".20240101T010000.000000000", "first\nsecond\n")
+}
+
+func TestWriterAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "redirects.log")
+	w := New(path, 0, 0)
+	require.NoError(t, w.WriteLine([]byte("first")))
+	require.NoError(t, w.Close())
+
+	// Reopening the file keeps its lines.
+	w = New(path, 0, 0)
+	require.NoError(t, w.WriteLine([]byte("second")))
+	require.NoError(t, w.Close())
+	requireFileContent(t, path, "first\nsecond\n")
+}
+
+func requireFileContent(t *testing.T, path, content string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(data))
+}
+
+func rotatedFiles(t *testing.T, path string) []string {
+	files, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	return files
+}
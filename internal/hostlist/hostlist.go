@@ -0,0 +1,142 @@
+package hostlist
+
+import (
+	"bufio"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// hosts is a parsed list of hosts.
+type hosts struct {
+	exact    map[string]bool
+	suffixes []string
+}
+
+// List is a list of hosts, loaded from a file that can be reloaded while the list is in use.
+// A nil List contains no host.
+type List struct {
+	path  string
+	hosts atomic.Pointer[hosts]
+}
+
+// New returns the list of the hosts of the lines. Every line is an exact host, or a domain and all
+// of its subdomains when it starts with "*.", e.g. "*.example.com". Empty lines and lines starting
+// with "#" are ignored.
+func New(lines []string) (*List, error) {
+	parsed, err := parse(lines)
+	if err != nil {
+		return nil, err
+	}
+	l := &List{}
+	l.hosts.Store(parsed)
+	return l, nil
+}
+
+// Load returns the list of the hosts of the file, an empty path is an empty list.
+func Load(path string) (*List, error) {
+	l := &List{
+		path: path,
+	}
+	if err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reads the file of the list again, keeping the current hosts when it is invalid.
+func (l *List) Reload() error {
+	lines, err := readLines(l.path)
+	if err != nil {
+		return err
+	}
+	parsed, err := parse(lines)
+	if err != nil {
+		return errors.Wrapf(err, "invalid host list %s", l.path)
+	}
+	l.hosts.Store(parsed)
+	return nil
+}
+
+// Contains returns whether the host is in the list, ignoring its case and trailing dot.
+func (l *List) Contains(host string) bool {
+	if l == nil {
+		return false
+	}
+	h := l.hosts.Load()
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if h == nil || host == "" {
+		return false
+	}
+	if h.exact[host] {
+		return true
+	}
+	for _, suffix := range h.suffixes {
+		if host == suffix || strings.HasSuffix(host, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsURL returns whether the host of the URL is in the list. Links without a host, such as
+// mailto links, are never in it.
+func (l *List) ContainsURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return l.Contains(u.Hostname())
+}
+
+func parse(lines []string) (*hosts, error) {
+	h := &hosts{
+		exact: map[string]bool{},
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		host := strings.TrimSuffix(strings.ToLower(line), ".")
+		if strings.ContainsAny(host, "/: ") {
+			return nil, errors.Errorf("invalid host %s", line)
+		}
+		if suffix, ok := strings.CutPrefix(host, "*."); ok {
+			if suffix == "" || strings.Contains(suffix, "*") {
+				return nil, errors.Errorf("invalid host %s", line)
+			}
+			h.suffixes = append(h.suffixes, suffix)
+			continue
+		}
+		if strings.Contains(host, "*") {
+			return nil, errors.Errorf("invalid host %s, wildcards are only allowed as the first label", line)
+		}
+		h.exact[host] = true
+	}
+	return h, nil
+}
+
+func readLines(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer file.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	return lines, nil
+}
@@ -0,0 +1,74 @@
+package hostlist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListContains(t *testing.T) {
+	l, err := New([]string{
+		"# Competitors",
+		"evil.com",
+		"*.phishing.net",
+		"",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "evil.com", want: true},
+		{host: "EVIL.com.", want: true},
+		{host: "www.evil.com", want: false},
+		{host: "notevil.com", want: false},
+		{host: "phishing.net", want: true},
+		{host: "login.phishing.net", want: true},
+		{host: "a.b.phishing.net", want: true},
+		{host: "notphishing.net", want: false},
+		{host: "", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, l.Contains(test.host), test.host)
+	}
+	require.True(t, l.ContainsURL("https://login.phishing.net:8443/path?q=1"))
+	require.False(t, l.ContainsURL("mailto:evil.com"))
+
+	var empty *List
+	require.False(t, empty.Contains("evil.com"))
+
+	for _, line := range []string{"https://evil.com", "evil.com/path", "*.", "a.*.com"} {
+		_, err := New([]string{line})
+		require.Error(t, err, line)
+	}
+}
+
+func TestListReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "denied.txt")
+	require.NoError(t, os.WriteFile(path, []byte("evil.com\n"), 0644))
+
+	l, err := Load(path)
+	require.NoError(t, err)
+	require.True(t, l.Contains("evil.com"))
+	require.False(t, l.Contains("bad.org"))
+
+	require.NoError(t, os.WriteFile(path, []byte("*.bad.org\n"), 0644))
+	require.NoError(t, l.Reload())
+	require.False(t, l.Contains("evil.com"))
+	require.True(t, l.Contains("www.bad.org"))
+
+	// An invalid file keeps the hosts loaded before.
+	require.NoError(t, os.WriteFile(path, []byte("https://evil.com\n"), 0644))
+	require.Error(t, l.Reload())
+	require.True(t, l.Contains("www.bad.org"))
+
+	_, err = Load(filepath.Join(dir, "missing.txt"))
+	require.Error(t, err)
+	empty, err := Load("")
+	require.NoError(t, err)
+	require.False(t, empty.Contains("evil.com"))
+}
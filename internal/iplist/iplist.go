@@ -0,0 +1,58 @@
+package iplist
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// List is a list of IPs and CIDR ranges.
+// A nil List contains no IP.
+type List struct {
+	prefixes []netip.Prefix
+}
+
+// Parse returns the list of the IPs and CIDR ranges, e.g. "10.0.0.1" or "10.0.0.0/8".
+func Parse(entries []string) (*List, error) {
+	list := &List{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid CIDR %s", entry)
+			}
+			list.prefixes = append(list.prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid IP %s", entry)
+		}
+		addr = addr.Unmap()
+		list.prefixes = append(list.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return list, nil
+}
+
+// Contains returns whether the IP is in the list.
+func (l *List) Contains(ip string) bool {
+	if l == nil {
+		return false
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range l.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package iplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestList(t *testing.T) {
+	list, err := Parse([]string{"127.0.0.1", " 10.0.0.0/8 ", "", "2001:db8::/32"})
+	require.NoError(t, err)
+	require.True(t, list.Contains("127.0.0.1"))
+	require.True(t, list.Contains("::ffff:127.0.0.1"))
+	require.False(t, list.Contains("127.0.0.2"))
+	require.True(t, list.Contains("10.1.2.3"))
+	require.False(t, list.Contains("11.0.0.1"))
+	require.True(t, list.Contains("2001:db8::1"))
+	require.False(t, list.Contains("2001:db9::1"))
+	require.False(t, list.Contains("invalid"))
+
+	_, err = Parse([]string{"10.0.0.0/33"})
+	require.Error(t, err)
+	_, err = Parse([]string{"localhost"})
+	require.Error(t, err)
+
+	var empty *List
+	require.False(t, empty.Contains("127.0.0.1"))
+}
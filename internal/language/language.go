@@ -0,0 +1,108 @@
+package language
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tagPattern matches the language tags, such as "fr" or "pt-BR".
+var tagPattern = regexp.MustCompile(`^[A-Za-z]{1,8}(-[A-Za-z0-9]{1,8})*$`)
+
+// IsValidTag returns whether the value is a language tag, such as "fr" or "pt-BR".
+func IsValidTag(value string) bool {
+	return tagPattern.MatchString(value)
+}
+
+// ParseAcceptLanguage returns the language tags of the Accept-Language header, lowercased and most
+// preferred first. The tags of equal quality keep their order, and the wildcard, the tags of zero
+// quality and the invalid entries are left out.
+func ParseAcceptLanguage(header string) []string {
+	type entry struct {
+		tag     string
+		quality float64
+	}
+	entries := []entry{}
+	for _, value := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(value, ";")
+		tag = strings.TrimSpace(tag)
+		if !IsValidTag(tag) {
+			continue
+		}
+		quality := 1.0
+		if params = strings.TrimSpace(params); params != "" {
+			name, q, ok := strings.Cut(params, "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			var err error
+			if quality, err = strconv.ParseFloat(strings.TrimSpace(q), 64); err != nil || quality < 0 || quality > 1 {
+				continue
+			}
+		}
+		if quality > 0 {
+			entries = append(entries, entry{tag: strings.ToLower(tag), quality: quality})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].quality > entries[j].quality
+	})
+	tags := []string{}
+	for _, entry := range entries {
+		tags = append(tags, entry.tag)
+	}
+	return tags
+}
+
+// Match returns the tag of the given ones preferred by the Accept-Language header, empty when there
+// is none. Tags match regardless of case, and a preferred tag also matches the tags it is more
+// specific than, so that "fr-CA" matches "fr" when there is no "fr-CA".
+func Match(header string, tags []string) string {
+	available := map[string]string{}
+	for _, tag := range tags {
+		available[strings.ToLower(tag)] = tag
+	}
+	if len(available) == 0 {
+		return ""
+	}
+	for _, preferred := range ParseAcceptLanguage(header) {
+		for {
+			if tag, ok := available[preferred]; ok {
+				return tag
+			}
+			i := strings.LastIndex(preferred, "-")
+			if i < 0 {
+				break
+			}
+			preferred = preferred[:i]
+		}
+	}
+	return ""
+}
+
+// ValidateLinks returns the error of the invalid links of languages, empty when they are valid. The
+// languages must be language tags, distinct regardless of case as they are matched so, and the
+// links URLs as they are redirected to.
+func ValidateLinks(links map[string]string, maxLinkLength int) string {
+	seen := map[string]bool{}
+	for tag, link := range links {
+		if !IsValidTag(tag) {
+			return fmt.Sprintf("invalid locale link language: %q", tag)
+		}
+		if seen[strings.ToLower(tag)] {
+			return fmt.Sprintf("duplicate locale link language: %q", tag)
+		}
+		seen[strings.ToLower(tag)] = true
+		if strings.TrimSpace(link) == "" {
+			return "locale links must not be empty"
+		} else if len(link) > maxLinkLength {
+			return fmt.Sprintf("locale links must not be longer than %d bytes", maxLinkLength)
+		} else if _, err := url.ParseRequestURI(link); err != nil {
+			return fmt.Sprintf("invalid locale link: %q", link)
+		}
+	}
+	return ""
+}
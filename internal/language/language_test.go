@@ -0,0 +1,73 @@
+package language
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected []string
+	}{
+		{header: "", expected: []string{}},
+		{header: "fr", expected: []string{"fr"}},
+		{header: "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", expected: []string{"fr-ch", "fr", "en", "de"}},
+		// The tags are ordered by quality, and keep their order for equal qualities.
+		{header: "en;q=0.5, de, fr;q=0.8, it", expected: []string{"de", "it", "fr", "en"}},
+		// The tags of zero quality are not acceptable.
+		{header: "en;q=0, fr", expected: []string{"fr"}},
+		// The invalid entries are skipped.
+		{header: "en;q=high, fr;q=2, de;level=1, not a tag, es;q=0.1", expected: []string{"es"}},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, ParseAcceptLanguage(test.header), test.header)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tags := []string{"fr", "pt-BR", "de-CH"}
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "fr", expected: "fr"},
+		{header: "FR", expected: "fr"},
+		// The more specific tags fall back to their language.
+		{header: "fr-CA", expected: "fr"},
+		{header: "pt-br", expected: "pt-BR"},
+		// The less specific tags do not match the more specific ones.
+		{header: "pt", expected: ""},
+		{header: "de", expected: ""},
+		// The most preferred tag with a match wins.
+		{header: "es, pt-BR;q=0.6, fr;q=0.9", expected: "fr"},
+		{header: "fr;q=0.1, pt-BR;q=0.2", expected: "pt-BR"},
+		{header: "fr;q=0, en", expected: ""},
+		{header: "", expected: ""},
+		{header: "*", expected: ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, Match(test.header, tags), test.header)
+	}
+	require.Equal(t, "", Match("fr", nil))
+}
+
+func TestValidateLinks(t *testing.T) {
+	tests := []struct {
+		links    map[string]string
+		expected string
+	}{
+		{links: nil, expected: ""},
+		{links: map[string]string{"fr": "https://example.fr", "pt-BR": "https://example.com.br"}, expected: ""},
+		{links: map[string]string{"not a tag": "https://example.com"}, expected: `invalid locale link language: "not a tag"`},
+		{links: map[string]string{"fr": " "}, expected: "locale links must not be empty"},
+		{links: map[string]string{"fr": "https://example.fr/" + strings.Repeat("a", 32)}, expected: "locale links must not be longer than 32 bytes"},
+		{links: map[string]string{"fr": "example.fr"}, expected: `invalid locale link: "example.fr"`},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, ValidateLinks(test.links, 32), test.links)
+	}
+	require.Contains(t, ValidateLinks(map[string]string{"fr": "https://example.fr", "FR": "https://example.fr"}, 32), "duplicate locale link language")
+}
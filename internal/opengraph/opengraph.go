@@ -0,0 +1,144 @@
+package opengraph
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+// maxPageSize is the max size of the page read for its metadata, the metadata being in its head.
+const maxPageSize = 1 << 20
+
+// Metadata is the open graph metadata of a page.
+type Metadata struct {
+	Title       string
+	Description string
+	Image       string
+}
+
+// Source fetches the open graph metadata of the page of a link.
+type Source func(ctx context.Context, link string) (*Metadata, error)
+
+// HTTPSource returns the source fetching the pages of links with the client. The relative image of
+// a page is resolved against the URL of the page, after the redirects.
+func HTTPSource(client *http.Client) Source {
+	return func(ctx context.Context, link string) (*Metadata, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "text/html")
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/html" {
+			return nil, errors.Errorf("unexpected content type %q", resp.Header.Get("Content-Type"))
+		}
+		metadata, err := Parse(io.LimitReader(resp.Body, maxPageSize))
+		if err != nil {
+			return nil, err
+		}
+		metadata.Image = ResolveURL(resp.Request.URL, metadata.Image)
+		return metadata, nil
+	}
+}
+
+// ResolveURL returns the URL value of a page resolved against the URL of the page, as crawlers
+// cannot resolve relative values without the page. Absolute and invalid values, and the values of
+// pages which are not http URLs, are returned untouched.
+func ResolveURL(base *url.URL, value string) string {
+	if value == "" || base == nil || (base.Scheme != "http" && base.Scheme != "https") {
+		return value
+	}
+	ref, err := url.Parse(value)
+	if err != nil || ref.IsAbs() {
+		return value
+	}
+	resolved := base.ResolveReference(ref)
+	// The credentials of the page are not leaked to the crawlers.
+	resolved.User = nil
+	return resolved.String()
+}
+
+// Parse returns the open graph metadata of the HTML page. The fields without an open graph
+// property fall back to the title and the description of the page.
+func Parse(r io.Reader) (*Metadata, error) {
+	metadata, fallback := &Metadata{}, &Metadata{}
+	tokenizer := html.NewTokenizer(r)
+	inTitle := false
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); err != io.EOF {
+				return nil, errors.Wrap(err, "failed to parse page")
+			}
+			return merge(metadata, fallback), nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = true
+			case "meta":
+				property, content := metaAttrs(token)
+				switch property {
+				case "og:title":
+					metadata.Title = content
+				case "og:description":
+					metadata.Description = content
+				case "og:image":
+					metadata.Image = content
+				case "description":
+					fallback.Description = content
+				}
+			case "body":
+				// The metadata is in the head of the page.
+				return merge(metadata, fallback), nil
+			}
+		case html.TextToken:
+			if inTitle && fallback.Title == "" {
+				fallback.Title = strings.TrimSpace(string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			if token := tokenizer.Token(); token.Data == "title" {
+				inTitle = false
+			}
+		}
+	}
+}
+
+// metaAttrs returns the property, or name, and the content of the meta tag.
+func metaAttrs(token html.Token) (string, string) {
+	property, content := "", ""
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "property", "name":
+			if property == "" {
+				property = strings.ToLower(attr.Val)
+			}
+		case "content":
+			content = strings.TrimSpace(attr.Val)
+		}
+	}
+	return property, content
+}
+
+func merge(metadata, fallback *Metadata) *Metadata {
+	if metadata.Title == "" {
+		metadata.Title = fallback.Title
+	}
+	if metadata.Description == "" {
+		metadata.Description = fallback.Description
+	}
+	return metadata
+}
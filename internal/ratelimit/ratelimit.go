@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Window is the number of events counted for a key in the current window.
+type Window struct {
+	Count   int
+	ResetAt time.Time
+}
+
+// RetryAfter returns the whole number of seconds until the window ends, at least one.
+func (w Window) RetryAfter(now time.Time) int {
+	return max(int(math.Ceil(w.ResetAt.Sub(now).Seconds())), 1)
+}
+
+// Limiter counts the events of each key in fixed windows.
+type Limiter struct {
+	window time.Duration
+
+	mutex   sync.Mutex
+	windows map[int32]*Window
+}
+
+// New returns a limiter counting the events in windows of the given duration.
+func New(window time.Duration) *Limiter {
+	return &Limiter{
+		window:  window,
+		windows: map[int32]*Window{},
+	}
+}
+
+// Take counts an event of the key and returns the window, and whether the event is within the limit.
+func (l *Limiter) Take(key int32, limit int, now time.Time) (Window, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for k, window := range l.windows {
+		if !now.Before(window.ResetAt) {
+			delete(l.windows, k)
+		}
+	}
+	window, ok := l.windows[key]
+	if !ok {
+		window = &Window{ResetAt: now.Add(l.window)}
+		l.windows[key] = window
+	}
+	if window.Count >= limit {
+		return *window, false
+	}
+	window.Count++
+	return *window, true
+}
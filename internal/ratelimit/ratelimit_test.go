@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := New(time.Minute)
+	now := time.Unix(1700000000, 0)
+
+	window, allowed := limiter.Take(1, 2, now)
+	require.True(t, allowed)
+	require.Equal(t, 1, window.Count)
+	require.Equal(t, now.Add(time.Minute), window.ResetAt)
+	_, allowed = limiter.Take(1, 2, now.Add(time.Second))
+	require.True(t, allowed)
+	window, allowed = limiter.Take(1, 2, now.Add(2*time.Second))
+	require.False(t, allowed)
+	require.Equal(t, 2, window.Count)
+	require.Equal(t, 58, window.RetryAfter(now.Add(2*time.Second)))
+	require.Equal(t, 1, window.RetryAfter(now.Add(time.Minute-time.Millisecond)))
+
+	// Keys are counted separately.
+	_, allowed = limiter.Take(2, 2, now.Add(2*time.Second))
+	require.True(t, allowed)
+
+	// A new window starts once the current one ends.
+	window, allowed = limiter.Take(1, 2, now.Add(time.Minute))
+	require.True(t, allowed)
+	require.Equal(t, 1, window.Count)
+	require.Equal(t, now.Add(2*time.Minute), window.ResetAt)
+}
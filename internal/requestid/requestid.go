@@ -0,0 +1,67 @@
+package requestid
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+)
+
+// HeaderName is the header used to propagate the request ID.
+const HeaderName = "X-Request-ID"
+
+// LogKey is the key of the request ID attribute in log lines.
+const LogKey = "request_id"
+
+type contextKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request ID carried by ctx, or an empty string.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+// WrapError annotates err with the request ID carried by ctx, if any.
+func WrapError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	requestID := FromContext(ctx)
+	if requestID == "" {
+		return err
+	}
+	return errors.Wrapf(err, "request_id=%s", requestID)
+}
+
+// Handler is a slog.Handler adding the request ID of the record context to every log line.
+type Handler struct {
+	slog.Handler
+}
+
+// NewHandler wraps h to include the request ID of the record context.
+func NewHandler(h slog.Handler) *Handler {
+	return &Handler{Handler: h}
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := FromContext(ctx); requestID != "" {
+		record.AddAttrs(slog.String(LogKey, requestID))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Handler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,73 @@
+package safehttp
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// nonPublicPrefixes are the ranges not covered by the netip checks which are not public either:
+// the carrier-grade NAT range, the benchmarking range, and the NAT64 prefix translating to IPv4
+// addresses which may be private ones.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// ErrNotPublic is returned when the client refuses to connect to a non-public address.
+var ErrNotPublic = errors.New("address is not public")
+
+// NewClient returns an HTTP client for fetching user provided URLs, which refuses to connect
+// to loopback, private and other non-public addresses so that links can't reach internal services.
+// The addresses are checked when connecting, so redirects and DNS answers can't bypass the check.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(addr) {
+				return errors.Wrapf(ErrNotPublic, "address %s", host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// A proxy would connect on our behalf, without the check of the dialer.
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+	}
+}
+
+// IsPublicAddr returns whether the address is reachable on the public internet.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsUnspecified() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast()
+}
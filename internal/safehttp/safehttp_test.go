@@ -0,0 +1,30 @@
+package safehttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	for _, addr := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		require.True(t, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{"127.0.0.1", "::1", "10.0.0.1", "192.168.1.1", "172.16.0.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "fd00::1", "fe80::1", "::ffff:127.0.0.1", "198.18.0.1", "198.19.255.255", "64:ff9b::7f00:1", "64:ff9b::a00:1"} {
+		require.False(t, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(time.Second).Get(server.URL)
+	require.ErrorIs(t, err, ErrNotPublic)
+}
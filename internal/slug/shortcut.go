@@ -0,0 +1,28 @@
+package slug
+
+import (
+	"context"
+
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// maxShortcutNameAttempts is the number of generated names tried for a shortcut created without a name.
+const maxShortcutNameAttempts = 10
+
+// GenerateShortcutName returns a generated name of shortcut that is free and allowed by the blocklist,
+// or ErrNoFreeName when none of the generated names is.
+func GenerateShortcutName(ctx context.Context, generator Generator, list *blocklist.Blocklist, s *store.Store) (string, error) {
+	return GenerateFree(generator, maxShortcutNameAttempts, func(name string) (bool, error) {
+		if list.Check(name) != blocklist.Allowed {
+			return true, nil
+		}
+		existing, err := s.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return false, err
+		}
+		return existing != nil, nil
+	})
+}
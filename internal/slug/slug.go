@@ -0,0 +1,135 @@
+package slug
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KindBase62 generates random base62 names, e.g. "f3Kx9a".
+	KindBase62 = "base62"
+	// KindWords generates names of random words joined by dashes, e.g. "correct-horse".
+	KindWords = "words"
+	// KindIncrement generates increasing numbers, e.g. "42".
+	KindIncrement = "increment"
+
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// base62Length is the length of the base62 names, 62^6 being enough to rarely collide.
+	base62Length = 6
+	// wordCount is the number of words of the word names.
+	wordCount = 2
+)
+
+// ErrNoFreeName is returned when all the generated names are taken.
+var ErrNoFreeName = errors.New("no free name generated")
+
+// Generator generates the names of the shortcuts created without one. The names may be taken,
+// in which case another one is generated.
+type Generator interface {
+	Generate() string
+}
+
+// Source is the randomness of the generators.
+type Source interface {
+	Intn(n int) int
+}
+
+// lockedSource is a source safe for concurrent use, which rand.Rand is not.
+type lockedSource struct {
+	mutex sync.Mutex
+	rand  *rand.Rand
+}
+
+// NewSource returns a source safe for concurrent use, seeded with the seed.
+func NewSource(seed int64) Source {
+	return &lockedSource{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (s *lockedSource) Intn(n int) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.rand.Intn(n)
+}
+
+// New returns the generator of the kind. The random generators use the source, and the increment
+// generator starts at start.
+func New(kind string, source Source, start int64) (Generator, error) {
+	switch kind {
+	case KindBase62:
+		return &Base62{Source: source, Length: base62Length}, nil
+	case KindWords:
+		return &Words{Source: source, Count: wordCount, Words: defaultWords}, nil
+	case KindIncrement:
+		return NewIncrement(start), nil
+	default:
+		return nil, errors.Errorf("unknown slug generator %q", kind)
+	}
+}
+
+// Base62 generates random names of base62 characters.
+type Base62 struct {
+	Source Source
+	Length int
+}
+
+func (g *Base62) Generate() string {
+	var builder strings.Builder
+	for i := 0; i < g.Length; i++ {
+		builder.WriteByte(base62Alphabet[g.Source.Intn(len(base62Alphabet))])
+	}
+	return builder.String()
+}
+
+// Words generates names of random words joined by dashes.
+type Words struct {
+	Source Source
+	Count  int
+	Words  []string
+}
+
+func (g *Words) Generate() string {
+	words := make([]string, 0, g.Count)
+	for i := 0; i < g.Count; i++ {
+		words = append(words, g.Words[g.Source.Intn(len(g.Words))])
+	}
+	return strings.Join(words, "-")
+}
+
+// Increment generates increasing numbers, each number being generated once.
+type Increment struct {
+	next atomic.Int64
+}
+
+// NewIncrement returns an increment generator whose first number is start.
+func NewIncrement(start int64) *Increment {
+	g := &Increment{}
+	g.next.Store(start)
+	return g
+}
+
+func (g *Increment) Generate() string {
+	return strconv.FormatInt(g.next.Add(1)-1, 10)
+}
+
+// GenerateFree returns the first generated name that is not taken, trying at most attempts names.
+func GenerateFree(generator Generator, attempts int, taken func(name string) (bool, error)) (string, error) {
+	for i := 0; i < attempts; i++ {
+		name := generator.Generate()
+		isTaken, err := taken(name)
+		if err != nil {
+			return "", err
+		}
+		if !isTaken {
+			return name, nil
+		}
+	}
+	return "", ErrNoFreeName
+}
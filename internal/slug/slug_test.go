@@ -0,0 +1,101 @@
+package slug
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// sequenceSource returns the values of the sequence in turn, modulo n.
+type sequenceSource struct {
+	values []int
+	next   int
+}
+
+func (s *sequenceSource) Intn(n int) int {
+	value := s.values[s.next%len(s.values)]
+	s.next++
+	return value % n
+}
+
+func TestBase62(t *testing.T) {
+	generator := &Base62{Source: &sequenceSource{values: []int{0, 10, 36, 61}}, Length: 6}
+	require.Equal(t, "0Aaz0A", generator.Generate())
+
+	randomGenerator, err := New(KindBase62, NewSource(1), 0)
+	require.NoError(t, err)
+	name := randomGenerator.Generate()
+	require.Len(t, name, base62Length)
+	require.Regexp(t, "^[0-9A-Za-z]+$", name)
+}
+
+func TestWords(t *testing.T) {
+	generator := &Words{Source: &sequenceSource{values: []int{0, 1}}, Count: 2, Words: []string{"correct", "horse", "battery"}}
+	require.Equal(t, "correct-horse", generator.Generate())
+	require.Equal(t, "correct-horse", generator.Generate())
+
+	randomGenerator, err := New(KindWords, NewSource(1), 0)
+	require.NoError(t, err)
+	require.Regexp(t, "^[a-z]+-[a-z]+$", randomGenerator.Generate())
+}
+
+func TestIncrement(t *testing.T) {
+	generator, err := New(KindIncrement, nil, 41)
+	require.NoError(t, err)
+	require.Equal(t, "41", generator.Generate())
+	require.Equal(t, "42", generator.Generate())
+
+	// Each number is generated once, even concurrently.
+	names := map[string]bool{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			name := generator.Generate()
+			mutex.Lock()
+			names[name] = true
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+	require.Len(t, names, 100)
+}
+
+func TestNewUnknownKind(t *testing.T) {
+	_, err := New("uuid", NewSource(1), 0)
+	require.Error(t, err)
+}
+
+func TestGenerateFree(t *testing.T) {
+	generator := &Words{Source: &sequenceSource{values: []int{0, 1, 2}}, Count: 1, Words: []string{"a", "b", "c"}}
+	taken := map[string]bool{"a": true, "b": true}
+	var tried []string
+	name, err := GenerateFree(generator, 5, func(name string) (bool, error) {
+		tried = append(tried, name)
+		return taken[name], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "c", name)
+	require.Equal(t, []string{"a", "b", "c"}, tried)
+
+	// Once the attempts are used up, no name is returned.
+	generator = &Words{Source: &sequenceSource{values: []int{0}}, Count: 1, Words: []string{"a"}}
+	attempts := 0
+	_, err = GenerateFree(generator, 3, func(name string) (bool, error) {
+		attempts++
+		return true, nil
+	})
+	require.ErrorIs(t, err, ErrNoFreeName)
+	require.Equal(t, 3, attempts)
+
+	// The errors of the lookup are returned as is.
+	lookupErr := errors.New("lookup failed")
+	_, err = GenerateFree(generator, 3, func(name string) (bool, error) {
+		return false, lookupErr
+	})
+	require.ErrorIs(t, err, lookupErr)
+}
@@ -0,0 +1,36 @@
+package slug
+
+// defaultWords is the list of the words of the word names, all common and lowercase.
+var defaultWords = []string{
+	"able", "acid", "aged", "also", "area", "army", "away", "baby", "back", "ball",
+	"band", "bank", "base", "bath", "bear", "beat", "bell", "belt", "bird", "blue",
+	"boat", "body", "bone", "book", "born", "both", "bowl", "bulk", "burn", "bush",
+	"busy", "cake", "calm", "camp", "card", "care", "cart", "case", "cash", "cast",
+	"cell", "chef", "chip", "city", "clay", "club", "coal", "coat", "code", "cold",
+	"cook", "cool", "cope", "copy", "core", "corn", "cost", "crew", "crop", "dark",
+	"dawn", "deal", "dear", "deep", "deer", "desk", "dial", "dish", "door", "dove",
+	"down", "draw", "drop", "drum", "duck", "dust", "duty", "each", "earn", "east",
+	"easy", "edge", "epic", "even", "ever", "face", "fact", "fair", "farm", "fast",
+	"fern", "file", "fill", "film", "fine", "fire", "firm", "fish", "flag", "flat",
+	"flow", "fold", "folk", "food", "foot", "form", "fort", "free", "frog", "fuel",
+	"full", "gain", "game", "gate", "gear", "gift", "glad", "glow", "goal", "gold",
+	"golf", "good", "grab", "gray", "grid", "grow", "gulf", "hair", "half", "hall",
+	"hand", "hard", "harp", "hawk", "head", "heat", "herb", "hero", "high", "hill",
+	"hint", "hive", "hold", "home", "hope", "horn", "horse", "host", "huge", "hunt",
+	"idea", "inch", "iron", "item", "jazz", "join", "jump", "keen", "keep", "kind",
+	"king", "kite", "knot", "lake", "lamp", "land", "lane", "last", "lava", "lawn",
+	"leaf", "lean", "left", "lens", "life", "lift", "lime", "line", "link", "lion",
+	"list", "live", "loaf", "lock", "loft", "long", "loop", "loud", "luck", "mail",
+	"main", "mark", "mask", "mild", "mile", "milk", "mill", "mind", "mint", "mist",
+	"moon", "moss", "moth", "much", "nail", "near", "neat", "nest", "news", "next",
+	"nice", "node", "nose", "note", "oak", "open", "oval", "pace", "page", "palm",
+	"park", "part", "path", "peak", "pear", "pine", "pink", "pipe", "plan", "play",
+	"plum", "poem", "pond", "pool", "port", "quiz", "race", "rain", "rare", "reed",
+	"rest", "rice", "rich", "ring", "road", "rock", "roof", "room", "root", "rope",
+	"rose", "ruby", "safe", "sail", "salt", "sand", "seal", "seed", "ship", "shoe",
+	"silk", "sing", "size", "snow", "soft", "soil", "song", "star", "stem", "step",
+	"sure", "swan", "tail", "tall", "task", "team", "tent", "tide", "tile", "time",
+	"tiny", "tone", "tool", "town", "tree", "trip", "tune", "vast", "vine", "wall",
+	"warm", "wave", "well", "west", "wide", "wild", "wind", "wing", "wise", "wolf",
+	"wood", "wool", "yard", "yarn", "year", "zero", "zone", "staple", "battery", "correct",
+}
@@ -0,0 +1,82 @@
+package timezone
+
+import (
+	"time"
+	// The timezone database is embedded, so that the timezones load on hosts without one.
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNonexistentTime is returned for a wall clock time that is skipped in the timezone, such as
+// the times in the hour the clocks are put forward for daylight saving time.
+var ErrNonexistentTime = errors.New("time does not exist in the timezone")
+
+// wallClockLayouts are the layouts of the times without an offset, interpreted in a timezone.
+var wallClockLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Load returns the location of the IANA timezone name, UTC when the name is empty. The "Local"
+// timezone of the host is rejected, as it changes with the host running the server.
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "UTC" {
+		return time.UTC, nil
+	}
+	if name == "Local" {
+		return nil, errors.Errorf("unknown timezone %q", name)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unknown timezone %q", name)
+	}
+	return location, nil
+}
+
+// ParseTime parses an RFC 3339 time, or a wall clock time without an offset in the location,
+// such as "2024-03-31T09:00". Of the wall clock times happening twice, as the clocks are put back
+// for daylight saving time, the earlier one is returned. The skipped ones return ErrNonexistentTime.
+func ParseTime(value string, location *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range wallClockLayouts {
+		wallClock, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		t, ok := resolveWallClock(wallClock, location)
+		if !ok {
+			return time.Time{}, errors.Wrapf(ErrNonexistentTime, "%s in %s", value, location)
+		}
+		return t, nil
+	}
+	return time.Time{}, errors.Errorf("invalid time %q, must be an RFC 3339 time or a time such as 2006-01-02T15:04", value)
+}
+
+// resolveWallClock returns the earliest time at which the clocks of the location show the wall
+// clock time, which is parsed in UTC. A wall clock time is shown at the offset of the location
+// before or after a transition near it, when the time at that offset has that offset.
+func resolveWallClock(wallClock time.Time, location *time.Location) (time.Time, bool) {
+	guess := time.Date(wallClock.Year(), wallClock.Month(), wallClock.Day(), wallClock.Hour(), wallClock.Minute(), wallClock.Second(), 0, location)
+	var resolved time.Time
+	found := false
+	for _, around := range []time.Time{guess.Add(-24 * time.Hour), guess, guess.Add(24 * time.Hour)} {
+		_, offset := around.Zone()
+		t := wallClock.Add(-time.Duration(offset) * time.Second)
+		if _, actualOffset := t.In(location).Zone(); actualOffset != offset {
+			continue
+		}
+		if !found || t.Before(resolved) {
+			resolved, found = t, true
+		}
+	}
+	return resolved.In(location), found
+}
+
+// Format formats the unix timestamp as an RFC 3339 time in the location, with its offset at that time.
+func Format(ts int64, location *time.Location) string {
+	return time.Unix(ts, 0).In(location).Format(time.RFC3339)
+}
@@ -0,0 +1,68 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	for _, name := range []string{"", "UTC"} {
+		location, err := Load(name)
+		require.NoError(t, err)
+		require.Equal(t, time.UTC, location)
+	}
+	location, err := Load("Europe/Paris")
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Paris", location.String())
+	for _, name := range []string{"Local", "Mars/Olympus_Mons", "+02:00"} {
+		_, err := Load(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestParseTimeAcrossDST(t *testing.T) {
+	// The clocks of New York go from 02:00 EST to 03:00 EDT on March 10, 2024, and back from
+	// 02:00 EDT to 01:00 EST on November 3, 2024.
+	newYork, err := Load("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "2024-03-09T09:00", want: "2024-03-09T14:00:00Z"},
+		{value: "2024-03-10T01:59:59", want: "2024-03-10T06:59:59Z"},
+		{value: "2024-03-10T03:00", want: "2024-03-10T07:00:00Z"},
+		{value: "2024-03-11T09:00", want: "2024-03-11T13:00:00Z"},
+		// The repeated hour resolves to its first occurrence, in EDT.
+		{value: "2024-11-03T01:30", want: "2024-11-03T05:30:00Z"},
+		{value: "2024-11-03T02:00", want: "2024-11-03T07:00:00Z"},
+		{value: "2024-11-04", want: "2024-11-04T05:00:00Z"},
+		// The times with an offset are not interpreted in the timezone.
+		{value: "2024-03-10T02:30:00+01:00", want: "2024-03-10T01:30:00Z"},
+	}
+	for _, test := range tests {
+		got, err := ParseTime(test.value, newYork)
+		require.NoError(t, err, test.value)
+		require.Equal(t, test.want, got.UTC().Format(time.RFC3339), test.value)
+	}
+
+	// The skipped hour does not exist.
+	for _, value := range []string{"2024-03-10T02:00", "2024-03-10T02:30:00"} {
+		_, err := ParseTime(value, newYork)
+		require.ErrorIs(t, err, ErrNonexistentTime, value)
+	}
+	_, err = ParseTime("next tuesday", newYork)
+	require.Error(t, err)
+}
+
+func TestFormat(t *testing.T) {
+	paris, err := Load("Europe/Paris")
+	require.NoError(t, err)
+	// The offset of Paris goes from +01:00 to +02:00 on March 31, 2024.
+	require.Equal(t, "2024-03-30T10:00:00+01:00", Format(time.Date(2024, 3, 30, 9, 0, 0, 0, time.UTC).Unix(), paris))
+	require.Equal(t, "2024-03-31T11:00:00+02:00", Format(time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC).Unix(), paris))
+	require.Equal(t, "2024-03-31T09:00:00Z", Format(time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC).Unix(), time.UTC))
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -23,3 +24,14 @@ func HasPrefixes(src string, prefixes ...string) bool {
 	}
 	return false
 }
+
+// StripURLUserinfo removes the user:password credentials from a URL.
+// It returns the link unchanged and false if the link has no credentials.
+func StripURLUserinfo(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil || u.User == nil {
+		return link, false
+	}
+	u.User = nil
+	return u.String(), true
+}
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	ShortcutService_ListShortcuts_FullMethodName        = "/slash.api.v2.ShortcutService/ListShortcuts"
 	ShortcutService_GetShortcut_FullMethodName          = "/slash.api.v2.ShortcutService/GetShortcut"
+	ShortcutService_ResolveShortcut_FullMethodName      = "/slash.api.v2.ShortcutService/ResolveShortcut"
 	ShortcutService_CreateShortcut_FullMethodName       = "/slash.api.v2.ShortcutService/CreateShortcut"
 	ShortcutService_UpdateShortcut_FullMethodName       = "/slash.api.v2.ShortcutService/UpdateShortcut"
 	ShortcutService_DeleteShortcut_FullMethodName       = "/slash.api.v2.ShortcutService/DeleteShortcut"
@@ -35,6 +36,9 @@ type ShortcutServiceClient interface {
 	ListShortcuts(ctx context.Context, in *ListShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*GetShortcutResponse, error)
+	// ResolveShortcut returns the shortcut a visit of the name redirects to.
+	// Missing and disabled shortcuts are not found. Resolving is not counted as a view.
+	ResolveShortcut(ctx context.Context, in *ResolveShortcutRequest, opts ...grpc.CallOption) (*ResolveShortcutResponse, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*CreateShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
@@ -71,6 +75,15 @@ func (c *shortcutServiceClient) GetShortcut(ctx context.Context, in *GetShortcut
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ResolveShortcut(ctx context.Context, in *ResolveShortcutRequest, opts ...grpc.CallOption) (*ResolveShortcutResponse, error) {
+	out := new(ResolveShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ResolveShortcut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*CreateShortcutResponse, error) {
 	out := new(CreateShortcutResponse)
 	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcut_FullMethodName, in, out, opts...)
@@ -115,6 +128,9 @@ type ShortcutServiceServer interface {
 	ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(context.Context, *GetShortcutRequest) (*GetShortcutResponse, error)
+	// ResolveShortcut returns the shortcut a visit of the name redirects to.
+	// Missing and disabled shortcuts are not found. Resolving is not counted as a view.
+	ResolveShortcut(context.Context, *ResolveShortcutRequest) (*ResolveShortcutResponse, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*CreateShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
@@ -136,6 +152,9 @@ func (UnimplementedShortcutServiceServer) ListShortcuts(context.Context, *ListSh
 func (UnimplementedShortcutServiceServer) GetShortcut(context.Context, *GetShortcutRequest) (*GetShortcutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) ResolveShortcut(context.Context, *ResolveShortcutRequest) (*ResolveShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShortcut not implemented")
+}
 func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *CreateShortcutRequest) (*CreateShortcutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcut not implemented")
 }
@@ -197,6 +216,24 @@ func _ShortcutService_GetShortcut_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ResolveShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ResolveShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ResolveShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ResolveShortcut(ctx, req.(*ResolveShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_CreateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShortcutRequest)
 	if err := dec(in); err != nil {
@@ -284,6 +321,10 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShortcut",
 			Handler:    _ShortcutService_GetShortcut_Handler,
 		},
+		{
+			MethodName: "ResolveShortcut",
+			Handler:    _ShortcutService_ResolveShortcut_Handler,
+		},
 		{
 			MethodName: "CreateShortcut",
 			Handler:    _ShortcutService_CreateShortcut_Handler,
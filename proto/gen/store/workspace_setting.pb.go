@@ -36,27 +36,101 @@ const (
 	WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_SCRIPT WorkspaceSettingKey = 5
 	// The auto backup setting.
 	WorkspaceSettingKey_WORKSPACE_SETTING_AUTO_BACKUP WorkspaceSettingKey = 6
+	// The max age in seconds that browsers and CDNs may cache redirects of public shortcuts.
+	// Cached redirects are not counted as views.
+	WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE WorkspaceSettingKey = 7
+	// The window in which views from the same IP count as one unique visitor.
+	WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW WorkspaceSettingKey = 8
+	// The default open graph metadata used for the empty fields of a shortcut's metadata.
+	// The "{name}", "{title}" and "{link}" placeholders are replaced with the shortcut's values.
+	WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA WorkspaceSettingKey = 9
+	// The message responded with a 404 to visits of disabled shortcuts.
+	// When empty, disabled shortcuts are handled as not found.
+	WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE WorkspaceSettingKey = 10
+	// Whether the public shortcuts of users wait for the approval of an admin before going live.
+	WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL WorkspaceSettingKey = 11
+	// Whether the IPs of visitors are hashed before they are recorded in activities and exports.
+	WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS WorkspaceSettingKey = 12
+	// The link decorator applied to the links of all shortcuts on redirect.
+	WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR WorkspaceSettingKey = 13
+	// Whether following any shortcut requires signing in, so that public shortcuts are only public to signed in users.
+	WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS WorkspaceSettingKey = 14
+	// The interstitial page shown to visitors before they are redirected to a link.
+	WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL WorkspaceSettingKey = 15
+	// The base URL of the instance, such as "https://go.example.com", used in the absolute URLs of shortcuts.
+	// When empty, the URLs use the host of the request.
+	WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN WorkspaceSettingKey = 16
+	// The handling of the shortcuts whose link is another shortcut of the instance.
+	WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN WorkspaceSettingKey = 17
+	// The default redirect status of the shortcuts of each visibility.
+	WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS WorkspaceSettingKey = 18
+	// The redirect of the visitors who must sign in to follow a shortcut.
+	WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT WorkspaceSettingKey = 19
+	// The routes of the shortcut names starting with a prefix to the base URL of another system.
+	WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES WorkspaceSettingKey = 20
+	// The IANA timezone, such as "Europe/Paris", in which the scheduling times without an offset are
+	// interpreted and the scheduling times of responses are formatted. When empty, UTC is used.
+	WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE WorkspaceSettingKey = 21
+	// The response to visits of the shortcuts whose link is not a valid URL, such as a text snippet.
+	WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE WorkspaceSettingKey = 22
+	// Whether the IPs and user agents of visitors are left out of activities and the redirect log.
+	// The views are still counted, while the unique visitors of anonymous views count as one.
+	WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS WorkspaceSettingKey = 23
 )
 
 // Enum value maps for WorkspaceSettingKey.
 var (
 	WorkspaceSettingKey_name = map[int32]string{
-		0: "WORKSPACE_SETTING_KEY_UNSPECIFIED",
-		1: "WORKSPACE_SETTING_LICENSE_KEY",
-		2: "WORKSPACE_SETTING_SECRET_SESSION",
-		3: "WORKSAPCE_SETTING_ENABLE_SIGNUP",
-		4: "WORKSPACE_SETTING_CUSTOM_STYLE",
-		5: "WORKSPACE_SETTING_CUSTOM_SCRIPT",
-		6: "WORKSPACE_SETTING_AUTO_BACKUP",
+		0:  "WORKSPACE_SETTING_KEY_UNSPECIFIED",
+		1:  "WORKSPACE_SETTING_LICENSE_KEY",
+		2:  "WORKSPACE_SETTING_SECRET_SESSION",
+		3:  "WORKSAPCE_SETTING_ENABLE_SIGNUP",
+		4:  "WORKSPACE_SETTING_CUSTOM_STYLE",
+		5:  "WORKSPACE_SETTING_CUSTOM_SCRIPT",
+		6:  "WORKSPACE_SETTING_AUTO_BACKUP",
+		7:  "WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE",
+		8:  "WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW",
+		9:  "WORKSPACE_SETTING_DEFAULT_OG_METADATA",
+		10: "WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE",
+		11: "WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL",
+		12: "WORKSPACE_SETTING_HASH_VISITOR_IPS",
+		13: "WORKSPACE_SETTING_LINK_DECORATOR",
+		14: "WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS",
+		15: "WORKSPACE_SETTING_INTERSTITIAL",
+		16: "WORKSPACE_SETTING_CUSTOM_DOMAIN",
+		17: "WORKSPACE_SETTING_INTERNAL_CHAIN",
+		18: "WORKSPACE_SETTING_REDIRECT_STATUS",
+		19: "WORKSPACE_SETTING_LOGIN_REDIRECT",
+		20: "WORKSPACE_SETTING_PREFIX_ROUTES",
+		21: "WORKSPACE_SETTING_TIMEZONE",
+		22: "WORKSPACE_SETTING_INVALID_LINK_MODE",
+		23: "WORKSPACE_SETTING_ANONYMIZE_VISITORS",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
-		"WORKSPACE_SETTING_KEY_UNSPECIFIED": 0,
-		"WORKSPACE_SETTING_LICENSE_KEY":     1,
-		"WORKSPACE_SETTING_SECRET_SESSION":  2,
-		"WORKSAPCE_SETTING_ENABLE_SIGNUP":   3,
-		"WORKSPACE_SETTING_CUSTOM_STYLE":    4,
-		"WORKSPACE_SETTING_CUSTOM_SCRIPT":   5,
-		"WORKSPACE_SETTING_AUTO_BACKUP":     6,
+		"WORKSPACE_SETTING_KEY_UNSPECIFIED":                  0,
+		"WORKSPACE_SETTING_LICENSE_KEY":                      1,
+		"WORKSPACE_SETTING_SECRET_SESSION":                   2,
+		"WORKSAPCE_SETTING_ENABLE_SIGNUP":                    3,
+		"WORKSPACE_SETTING_CUSTOM_STYLE":                     4,
+		"WORKSPACE_SETTING_CUSTOM_SCRIPT":                    5,
+		"WORKSPACE_SETTING_AUTO_BACKUP":                      6,
+		"WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE":           7,
+		"WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW":            8,
+		"WORKSPACE_SETTING_DEFAULT_OG_METADATA":              9,
+		"WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE":        10,
+		"WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL": 11,
+		"WORKSPACE_SETTING_HASH_VISITOR_IPS":                 12,
+		"WORKSPACE_SETTING_LINK_DECORATOR":                   13,
+		"WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS":   14,
+		"WORKSPACE_SETTING_INTERSTITIAL":                     15,
+		"WORKSPACE_SETTING_CUSTOM_DOMAIN":                    16,
+		"WORKSPACE_SETTING_INTERNAL_CHAIN":                   17,
+		"WORKSPACE_SETTING_REDIRECT_STATUS":                  18,
+		"WORKSPACE_SETTING_LOGIN_REDIRECT":                   19,
+		"WORKSPACE_SETTING_PREFIX_ROUTES":                    20,
+		"WORKSPACE_SETTING_TIMEZONE":                         21,
+		"WORKSPACE_SETTING_INVALID_LINK_MODE":                22,
+		"WORKSPACE_SETTING_ANONYMIZE_VISITORS":               23,
 	}
 )
 
@@ -87,6 +161,115 @@ func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
 }
 
+type UniqueVisitorWindow int32
+
+const (
+	// Unspecified means the day window.
+	UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_UNSPECIFIED UniqueVisitorWindow = 0
+	// Views from the same IP within a calendar day (UTC) count once.
+	UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_DAY UniqueVisitorWindow = 1
+	// Views from the same IP count once per session, a session ends after 30 minutes without views.
+	UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION UniqueVisitorWindow = 2
+	// Views from the same IP count once.
+	UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_ALL_TIME UniqueVisitorWindow = 3
+)
+
+// Enum value maps for UniqueVisitorWindow.
+var (
+	UniqueVisitorWindow_name = map[int32]string{
+		0: "UNIQUE_VISITOR_WINDOW_UNSPECIFIED",
+		1: "UNIQUE_VISITOR_WINDOW_DAY",
+		2: "UNIQUE_VISITOR_WINDOW_SESSION",
+		3: "UNIQUE_VISITOR_WINDOW_ALL_TIME",
+	}
+	UniqueVisitorWindow_value = map[string]int32{
+		"UNIQUE_VISITOR_WINDOW_UNSPECIFIED": 0,
+		"UNIQUE_VISITOR_WINDOW_DAY":         1,
+		"UNIQUE_VISITOR_WINDOW_SESSION":     2,
+		"UNIQUE_VISITOR_WINDOW_ALL_TIME":    3,
+	}
+)
+
+func (x UniqueVisitorWindow) Enum() *UniqueVisitorWindow {
+	p := new(UniqueVisitorWindow)
+	*p = x
+	return p
+}
+
+func (x UniqueVisitorWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UniqueVisitorWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (UniqueVisitorWindow) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[1]
+}
+
+func (x UniqueVisitorWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UniqueVisitorWindow.Descriptor instead.
+func (UniqueVisitorWindow) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+}
+
+type InvalidLinkMode int32
+
+const (
+	// Unspecified means the preview mode.
+	InvalidLinkMode_INVALID_LINK_MODE_UNSPECIFIED InvalidLinkMode = 0
+	// The shortcuts with open graph metadata respond with a preview page of their metadata, telling visitors
+	// that the shortcut has no destination, while the shortcuts without metadata respond with their link as text.
+	InvalidLinkMode_INVALID_LINK_MODE_PREVIEW InvalidLinkMode = 1
+	// The shortcuts respond with their link as a text snippet, whether they have open graph metadata or not.
+	InvalidLinkMode_INVALID_LINK_MODE_TEXT InvalidLinkMode = 2
+)
+
+// Enum value maps for InvalidLinkMode.
+var (
+	InvalidLinkMode_name = map[int32]string{
+		0: "INVALID_LINK_MODE_UNSPECIFIED",
+		1: "INVALID_LINK_MODE_PREVIEW",
+		2: "INVALID_LINK_MODE_TEXT",
+	}
+	InvalidLinkMode_value = map[string]int32{
+		"INVALID_LINK_MODE_UNSPECIFIED": 0,
+		"INVALID_LINK_MODE_PREVIEW":     1,
+		"INVALID_LINK_MODE_TEXT":        2,
+	}
+)
+
+func (x InvalidLinkMode) Enum() *InvalidLinkMode {
+	p := new(InvalidLinkMode)
+	*p = x
+	return p
+}
+
+func (x InvalidLinkMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvalidLinkMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (InvalidLinkMode) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x InvalidLinkMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvalidLinkMode.Descriptor instead.
+func (InvalidLinkMode) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
+}
+
 type WorkspaceSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -101,6 +284,23 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_CustomStyle
 	//	*WorkspaceSetting_CustomScript
 	//	*WorkspaceSetting_AutoBackup
+	//	*WorkspaceSetting_RedirectCacheMaxAge
+	//	*WorkspaceSetting_UniqueVisitorWindow
+	//	*WorkspaceSetting_DefaultOgMetadata
+	//	*WorkspaceSetting_DisabledShortcutMessage
+	//	*WorkspaceSetting_RequirePublicShortcutApproval
+	//	*WorkspaceSetting_HashVisitorIps
+	//	*WorkspaceSetting_LinkDecorator
+	//	*WorkspaceSetting_RequireAuthForAllRedirects
+	//	*WorkspaceSetting_Interstitial
+	//	*WorkspaceSetting_CustomDomain
+	//	*WorkspaceSetting_InternalChain
+	//	*WorkspaceSetting_RedirectStatus
+	//	*WorkspaceSetting_LoginRedirect
+	//	*WorkspaceSetting_PrefixRoutes
+	//	*WorkspaceSetting_Timezone
+	//	*WorkspaceSetting_InvalidLinkMode
+	//	*WorkspaceSetting_AnonymizeVisitors
 	Value isWorkspaceSetting_Value `protobuf_oneof:"value"`
 }
 
@@ -192,6 +392,125 @@ func (x *WorkspaceSetting) GetAutoBackup() *AutoBackupWorkspaceSetting {
 	return nil
 }
 
+func (x *WorkspaceSetting) GetRedirectCacheMaxAge() int32 {
+	if x, ok := x.GetValue().(*WorkspaceSetting_RedirectCacheMaxAge); ok {
+		return x.RedirectCacheMaxAge
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetUniqueVisitorWindow() UniqueVisitorWindow {
+	if x, ok := x.GetValue().(*WorkspaceSetting_UniqueVisitorWindow); ok {
+		return x.UniqueVisitorWindow
+	}
+	return UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting) GetDefaultOgMetadata() *OpenGraphMetadata {
+	if x, ok := x.GetValue().(*WorkspaceSetting_DefaultOgMetadata); ok {
+		return x.DefaultOgMetadata
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetDisabledShortcutMessage() string {
+	if x, ok := x.GetValue().(*WorkspaceSetting_DisabledShortcutMessage); ok {
+		return x.DisabledShortcutMessage
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetRequirePublicShortcutApproval() bool {
+	if x, ok := x.GetValue().(*WorkspaceSetting_RequirePublicShortcutApproval); ok {
+		return x.RequirePublicShortcutApproval
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetHashVisitorIps() bool {
+	if x, ok := x.GetValue().(*WorkspaceSetting_HashVisitorIps); ok {
+		return x.HashVisitorIps
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetLinkDecorator() *LinkDecorator {
+	if x, ok := x.GetValue().(*WorkspaceSetting_LinkDecorator); ok {
+		return x.LinkDecorator
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRequireAuthForAllRedirects() bool {
+	if x, ok := x.GetValue().(*WorkspaceSetting_RequireAuthForAllRedirects); ok {
+		return x.RequireAuthForAllRedirects
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetInterstitial() *InterstitialWorkspaceSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_Interstitial); ok {
+		return x.Interstitial
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCustomDomain() string {
+	if x, ok := x.GetValue().(*WorkspaceSetting_CustomDomain); ok {
+		return x.CustomDomain
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetInternalChain() *InternalChainWorkspaceSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_InternalChain); ok {
+		return x.InternalChain
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRedirectStatus() *RedirectStatusWorkspaceSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_RedirectStatus); ok {
+		return x.RedirectStatus
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLoginRedirect() *LoginRedirectWorkspaceSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_LoginRedirect); ok {
+		return x.LoginRedirect
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetPrefixRoutes() *PrefixRoutesWorkspaceSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_PrefixRoutes); ok {
+		return x.PrefixRoutes
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetTimezone() string {
+	if x, ok := x.GetValue().(*WorkspaceSetting_Timezone); ok {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetInvalidLinkMode() InvalidLinkMode {
+	if x, ok := x.GetValue().(*WorkspaceSetting_InvalidLinkMode); ok {
+		return x.InvalidLinkMode
+	}
+	return InvalidLinkMode_INVALID_LINK_MODE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting) GetAnonymizeVisitors() bool {
+	if x, ok := x.GetValue().(*WorkspaceSetting_AnonymizeVisitors); ok {
+		return x.AnonymizeVisitors
+	}
+	return false
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -220,6 +539,74 @@ type WorkspaceSetting_AutoBackup struct {
 	AutoBackup *AutoBackupWorkspaceSetting `protobuf:"bytes,7,opt,name=auto_backup,json=autoBackup,proto3,oneof"`
 }
 
+type WorkspaceSetting_RedirectCacheMaxAge struct {
+	RedirectCacheMaxAge int32 `protobuf:"varint,8,opt,name=redirect_cache_max_age,json=redirectCacheMaxAge,proto3,oneof"`
+}
+
+type WorkspaceSetting_UniqueVisitorWindow struct {
+	UniqueVisitorWindow UniqueVisitorWindow `protobuf:"varint,9,opt,name=unique_visitor_window,json=uniqueVisitorWindow,proto3,enum=slash.store.UniqueVisitorWindow,oneof"`
+}
+
+type WorkspaceSetting_DefaultOgMetadata struct {
+	DefaultOgMetadata *OpenGraphMetadata `protobuf:"bytes,10,opt,name=default_og_metadata,json=defaultOgMetadata,proto3,oneof"`
+}
+
+type WorkspaceSetting_DisabledShortcutMessage struct {
+	DisabledShortcutMessage string `protobuf:"bytes,11,opt,name=disabled_shortcut_message,json=disabledShortcutMessage,proto3,oneof"`
+}
+
+type WorkspaceSetting_RequirePublicShortcutApproval struct {
+	RequirePublicShortcutApproval bool `protobuf:"varint,12,opt,name=require_public_shortcut_approval,json=requirePublicShortcutApproval,proto3,oneof"`
+}
+
+type WorkspaceSetting_HashVisitorIps struct {
+	HashVisitorIps bool `protobuf:"varint,13,opt,name=hash_visitor_ips,json=hashVisitorIps,proto3,oneof"`
+}
+
+type WorkspaceSetting_LinkDecorator struct {
+	LinkDecorator *LinkDecorator `protobuf:"bytes,14,opt,name=link_decorator,json=linkDecorator,proto3,oneof"`
+}
+
+type WorkspaceSetting_RequireAuthForAllRedirects struct {
+	RequireAuthForAllRedirects bool `protobuf:"varint,15,opt,name=require_auth_for_all_redirects,json=requireAuthForAllRedirects,proto3,oneof"`
+}
+
+type WorkspaceSetting_Interstitial struct {
+	Interstitial *InterstitialWorkspaceSetting `protobuf:"bytes,16,opt,name=interstitial,proto3,oneof"`
+}
+
+type WorkspaceSetting_CustomDomain struct {
+	CustomDomain string `protobuf:"bytes,17,opt,name=custom_domain,json=customDomain,proto3,oneof"`
+}
+
+type WorkspaceSetting_InternalChain struct {
+	InternalChain *InternalChainWorkspaceSetting `protobuf:"bytes,18,opt,name=internal_chain,json=internalChain,proto3,oneof"`
+}
+
+type WorkspaceSetting_RedirectStatus struct {
+	RedirectStatus *RedirectStatusWorkspaceSetting `protobuf:"bytes,19,opt,name=redirect_status,json=redirectStatus,proto3,oneof"`
+}
+
+type WorkspaceSetting_LoginRedirect struct {
+	LoginRedirect *LoginRedirectWorkspaceSetting `protobuf:"bytes,20,opt,name=login_redirect,json=loginRedirect,proto3,oneof"`
+}
+
+type WorkspaceSetting_PrefixRoutes struct {
+	PrefixRoutes *PrefixRoutesWorkspaceSetting `protobuf:"bytes,21,opt,name=prefix_routes,json=prefixRoutes,proto3,oneof"`
+}
+
+type WorkspaceSetting_Timezone struct {
+	Timezone string `protobuf:"bytes,22,opt,name=timezone,proto3,oneof"`
+}
+
+type WorkspaceSetting_InvalidLinkMode struct {
+	InvalidLinkMode InvalidLinkMode `protobuf:"varint,23,opt,name=invalid_link_mode,json=invalidLinkMode,proto3,enum=slash.store.InvalidLinkMode,oneof"`
+}
+
+type WorkspaceSetting_AnonymizeVisitors struct {
+	AnonymizeVisitors bool `protobuf:"varint,24,opt,name=anonymize_visitors,json=anonymizeVisitors,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_LicenseKey) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_SecretSession) isWorkspaceSetting_Value() {}
@@ -232,6 +619,40 @@ func (*WorkspaceSetting_CustomScript) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AutoBackup) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_RedirectCacheMaxAge) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_UniqueVisitorWindow) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_DefaultOgMetadata) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_DisabledShortcutMessage) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RequirePublicShortcutApproval) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_HashVisitorIps) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_LinkDecorator) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RequireAuthForAllRedirects) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Interstitial) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_CustomDomain) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_InternalChain) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RedirectStatus) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_LoginRedirect) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_PrefixRoutes) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_Timezone) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_InvalidLinkMode) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AnonymizeVisitors) isWorkspaceSetting_Value() {}
+
 type AutoBackupWorkspaceSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -300,70 +721,596 @@ func (x *AutoBackupWorkspaceSetting) GetMaxKeep() int32 {
 	return 0
 }
 
+type InterstitialWorkspaceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether visitors are shown the interstitial before being redirected to the link of any shortcut.
+	// When disabled, only the shortcuts enabling it show the interstitial.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The seconds before visitors are redirected, zero means 5 seconds.
+	DelaySeconds int32 `protobuf:"varint,2,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	// The message shown to visitors above the link they are taken to.
+	// When empty, a default message is shown.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *InterstitialWorkspaceSetting) Reset() {
+	*x = InterstitialWorkspaceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterstitialWorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterstitialWorkspaceSetting) ProtoMessage() {}
+
+func (x *InterstitialWorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterstitialWorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*InterstitialWorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InterstitialWorkspaceSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InterstitialWorkspaceSetting) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *InterstitialWorkspaceSetting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InternalChainWorkspaceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the redirector follows the shortcuts whose link is another shortcut of the instance,
+	// redirecting straight to the final link.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The maximum number of shortcuts followed after the requested one, zero means 5.
+	MaxHops int32 `protobuf:"varint,2,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+}
+
+func (x *InternalChainWorkspaceSetting) Reset() {
+	*x = InternalChainWorkspaceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalChainWorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalChainWorkspaceSetting) ProtoMessage() {}
+
+func (x *InternalChainWorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalChainWorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*InternalChainWorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InternalChainWorkspaceSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InternalChainWorkspaceSetting) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+type RedirectStatusWorkspaceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The redirect statuses of the shortcuts of each visibility, among 301, 302, 303, 307 and 308.
+	// Zero means 303.
+	Public    int32 `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	Workspace int32 `protobuf:"varint,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Private   int32 `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *RedirectStatusWorkspaceSetting) Reset() {
+	*x = RedirectStatusWorkspaceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectStatusWorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectStatusWorkspaceSetting) ProtoMessage() {}
+
+func (x *RedirectStatusWorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectStatusWorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*RedirectStatusWorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RedirectStatusWorkspaceSetting) GetPublic() int32 {
+	if x != nil {
+		return x.Public
+	}
+	return 0
+}
+
+func (x *RedirectStatusWorkspaceSetting) GetWorkspace() int32 {
+	if x != nil {
+		return x.Workspace
+	}
+	return 0
+}
+
+func (x *RedirectStatusWorkspaceSetting) GetPrivate() int32 {
+	if x != nil {
+		return x.Private
+	}
+	return 0
+}
+
+type LoginRedirectWorkspaceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether visitors who are not signed in are redirected to the login URL when they follow a shortcut
+	// they must sign in for, with the short link as the "return_to" query param. When disabled, they get a 401.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The URL of the login page, an absolute http or https URL or a path of the instance.
+	// When empty, it is the sign in page of the instance.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *LoginRedirectWorkspaceSetting) Reset() {
+	*x = LoginRedirectWorkspaceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRedirectWorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRedirectWorkspaceSetting) ProtoMessage() {}
+
+func (x *LoginRedirectWorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRedirectWorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*LoginRedirectWorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LoginRedirectWorkspaceSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LoginRedirectWorkspaceSetting) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type PrefixRoutesWorkspaceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The routes of the shortcut names by their first segment, which take precedence over the shortcuts.
+	Routes []*PrefixRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *PrefixRoutesWorkspaceSetting) Reset() {
+	*x = PrefixRoutesWorkspaceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixRoutesWorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixRoutesWorkspaceSetting) ProtoMessage() {}
+
+func (x *PrefixRoutesWorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixRoutesWorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*PrefixRoutesWorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PrefixRoutesWorkspaceSetting) GetRoutes() []*PrefixRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type PrefixRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first segment of the routed names, such as "k" routing "k/anything".
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The http or https URL the rest of the name is appended to, such as "https://kubernetes.io/docs/".
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+}
+
+func (x *PrefixRoute) Reset() {
+	*x = PrefixRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_workspace_setting_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixRoute) ProtoMessage() {}
+
+func (x *PrefixRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixRoute.ProtoReflect.Descriptor instead.
+func (*PrefixRoute) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PrefixRoute) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PrefixRoute) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 var file_store_workspace_setting_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xda, 0x02, 0x0a,
-	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x32, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
-	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e,
-	0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x25, 0x0a,
-	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6c, 0x61, 0x73,
-	0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7a, 0x0a, 0x1a, 0x41, 0x75, 0x74,
-	0x6f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x12, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x0b, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x75, 0x70, 0x12, 0x23, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x4a,
+	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x12, 0x56, 0x0a, 0x15, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x48, 0x00, 0x52, 0x13, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x50, 0x0a, 0x13, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6f, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x4f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x19, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x17, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63,
+	0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x63, 0x75, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x70, 0x73,
+	0x12, 0x43, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x63, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x63, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x1e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x74, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x74, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x74, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0d,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x56, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x53, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x2f, 0x0a, 0x12, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x76,
+	0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x11, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7a, 0x0a, 0x1a, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x4b, 0x65, 0x65, 0x70, 0x22, 0x77, 0x0a, 0x1c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x74, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
 	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
 	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f, 0x6e,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
-	0x78, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61,
-	0x78, 0x4b, 0x65, 0x65, 0x70, 0x2a, 0x96, 0x02, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a,
-	0x21, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
-	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
-	0x45, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53,
-	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43,
-	0x52, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x23, 0x0a,
-	0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x41, 0x50, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x55, 0x50,
-	0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
-	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x53,
-	0x54, 0x59, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50,
-	0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54,
-	0x4f, 0x4d, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x57,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x54, 0x0a, 0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x48, 0x6f, 0x70, 0x73, 0x22, 0x70, 0x0a, 0x1e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x4b, 0x0a, 0x1d, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x50, 0x0a, 0x1c, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x2a, 0xdd, 0x07, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53,
+	0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4b,
+	0x45, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x41, 0x50, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x55, 0x50, 0x10, 0x03, 0x12,
+	0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x59, 0x4c,
+	0x45, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
+	0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
+	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b,
+	0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x06, 0x12, 0x2c, 0x0a, 0x28, 0x57,
 	0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x06, 0x42, 0x9f,
-	0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x42, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6a, 0x61, 0x63, 0x6b, 0x2f,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0b, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0xca, 0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73,
-	0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0xe2, 0x02, 0x17, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f,
+	0x4d, 0x41, 0x58, 0x5f, 0x41, 0x47, 0x45, 0x10, 0x07, 0x12, 0x2b, 0x0a, 0x27, 0x57, 0x4f, 0x52,
+	0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55,
+	0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x10, 0x08, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50,
+	0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x5f, 0x4f, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0x09, 0x12, 0x2f, 0x0a, 0x2b, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53,
+	0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f,
+	0x53, 0x48, 0x4f, 0x52, 0x54, 0x43, 0x55, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x10, 0x0a, 0x12, 0x36, 0x0a, 0x32, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
+	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x43, 0x55, 0x54, 0x5f,
+	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x0b, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x50, 0x53,
+	0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
+	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x45, 0x43,
+	0x4f, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x0d, 0x12, 0x34, 0x0a, 0x30, 0x57, 0x4f, 0x52, 0x4b,
+	0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41,
+	0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x53, 0x10, 0x0e, 0x12, 0x22,
+	0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x53, 0x54, 0x49, 0x54, 0x49, 0x41, 0x4c,
+	0x10, 0x0f, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f,
+	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44,
+	0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x10, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53,
+	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x11, 0x12, 0x25, 0x0a,
+	0x21, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x10, 0x12, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f,
+	0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x13, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x53, 0x10, 0x14, 0x12,
+	0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5a, 0x4f, 0x4e, 0x45, 0x10, 0x15, 0x12,
+	0x27, 0x0a, 0x23, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x16, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x4f, 0x52, 0x4b,
+	0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4e,
+	0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x5a, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x53,
+	0x10, 0x17, 0x2a, 0xa2, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x21, 0x55, 0x4e,
+	0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49,
+	0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x54,
+	0x4f, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x56, 0x49,
+	0x53, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41, 0x4c, 0x4c,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0x6f, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
+	0x19, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x42, 0x9f, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x15, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x6f, 0x6f, 0x6a, 0x61, 0x63, 0x6b, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0xa2,
+	0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0xca, 0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0xe2, 0x02, 0x17, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -378,21 +1325,41 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_workspace_setting_proto_goTypes = []interface{}{
-	(WorkspaceSettingKey)(0),           // 0: slash.store.WorkspaceSettingKey
-	(*WorkspaceSetting)(nil),           // 1: slash.store.WorkspaceSetting
-	(*AutoBackupWorkspaceSetting)(nil), // 2: slash.store.AutoBackupWorkspaceSetting
+	(WorkspaceSettingKey)(0),               // 0: slash.store.WorkspaceSettingKey
+	(UniqueVisitorWindow)(0),               // 1: slash.store.UniqueVisitorWindow
+	(InvalidLinkMode)(0),                   // 2: slash.store.InvalidLinkMode
+	(*WorkspaceSetting)(nil),               // 3: slash.store.WorkspaceSetting
+	(*AutoBackupWorkspaceSetting)(nil),     // 4: slash.store.AutoBackupWorkspaceSetting
+	(*InterstitialWorkspaceSetting)(nil),   // 5: slash.store.InterstitialWorkspaceSetting
+	(*InternalChainWorkspaceSetting)(nil),  // 6: slash.store.InternalChainWorkspaceSetting
+	(*RedirectStatusWorkspaceSetting)(nil), // 7: slash.store.RedirectStatusWorkspaceSetting
+	(*LoginRedirectWorkspaceSetting)(nil),  // 8: slash.store.LoginRedirectWorkspaceSetting
+	(*PrefixRoutesWorkspaceSetting)(nil),   // 9: slash.store.PrefixRoutesWorkspaceSetting
+	(*PrefixRoute)(nil),                    // 10: slash.store.PrefixRoute
+	(*OpenGraphMetadata)(nil),              // 11: slash.store.OpenGraphMetadata
+	(*LinkDecorator)(nil),                  // 12: slash.store.LinkDecorator
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
-	2, // 1: slash.store.WorkspaceSetting.auto_backup:type_name -> slash.store.AutoBackupWorkspaceSetting
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
+	4,  // 1: slash.store.WorkspaceSetting.auto_backup:type_name -> slash.store.AutoBackupWorkspaceSetting
+	1,  // 2: slash.store.WorkspaceSetting.unique_visitor_window:type_name -> slash.store.UniqueVisitorWindow
+	11, // 3: slash.store.WorkspaceSetting.default_og_metadata:type_name -> slash.store.OpenGraphMetadata
+	12, // 4: slash.store.WorkspaceSetting.link_decorator:type_name -> slash.store.LinkDecorator
+	5,  // 5: slash.store.WorkspaceSetting.interstitial:type_name -> slash.store.InterstitialWorkspaceSetting
+	6,  // 6: slash.store.WorkspaceSetting.internal_chain:type_name -> slash.store.InternalChainWorkspaceSetting
+	7,  // 7: slash.store.WorkspaceSetting.redirect_status:type_name -> slash.store.RedirectStatusWorkspaceSetting
+	8,  // 8: slash.store.WorkspaceSetting.login_redirect:type_name -> slash.store.LoginRedirectWorkspaceSetting
+	9,  // 9: slash.store.WorkspaceSetting.prefix_routes:type_name -> slash.store.PrefixRoutesWorkspaceSetting
+	2,  // 10: slash.store.WorkspaceSetting.invalid_link_mode:type_name -> slash.store.InvalidLinkMode
+	10, // 11: slash.store.PrefixRoutesWorkspaceSetting.routes:type_name -> slash.store.PrefixRoute
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -400,6 +1367,8 @@ func file_store_workspace_setting_proto_init() {
 	if File_store_workspace_setting_proto != nil {
 		return
 	}
+	file_store_common_proto_init()
+	file_store_shortcut_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_store_workspace_setting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkspaceSetting); i {
@@ -425,6 +1394,78 @@ func file_store_workspace_setting_proto_init() {
 				return nil
 			}
 		}
+		file_store_workspace_setting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterstitialWorkspaceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_workspace_setting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalChainWorkspaceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_workspace_setting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectStatusWorkspaceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_workspace_setting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRedirectWorkspaceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_workspace_setting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefixRoutesWorkspaceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_workspace_setting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefixRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_store_workspace_setting_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*WorkspaceSetting_LicenseKey)(nil),
@@ -433,14 +1474,31 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_CustomStyle)(nil),
 		(*WorkspaceSetting_CustomScript)(nil),
 		(*WorkspaceSetting_AutoBackup)(nil),
+		(*WorkspaceSetting_RedirectCacheMaxAge)(nil),
+		(*WorkspaceSetting_UniqueVisitorWindow)(nil),
+		(*WorkspaceSetting_DefaultOgMetadata)(nil),
+		(*WorkspaceSetting_DisabledShortcutMessage)(nil),
+		(*WorkspaceSetting_RequirePublicShortcutApproval)(nil),
+		(*WorkspaceSetting_HashVisitorIps)(nil),
+		(*WorkspaceSetting_LinkDecorator)(nil),
+		(*WorkspaceSetting_RequireAuthForAllRedirects)(nil),
+		(*WorkspaceSetting_Interstitial)(nil),
+		(*WorkspaceSetting_CustomDomain)(nil),
+		(*WorkspaceSetting_InternalChain)(nil),
+		(*WorkspaceSetting_RedirectStatus)(nil),
+		(*WorkspaceSetting_LoginRedirect)(nil),
+		(*WorkspaceSetting_PrefixRoutes)(nil),
+		(*WorkspaceSetting_Timezone)(nil),
+		(*WorkspaceSetting_InvalidLinkMode)(nil),
+		(*WorkspaceSetting_AnonymizeVisitors)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_workspace_setting_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/iplist"
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+// newIPExtractor returns the extractor of the client IPs of the profile's client IP header, nil
+// when there is none, which keeps echo's extraction. The header is only read from the trusted
+// proxies, the client IPs of the other requests being their peer address.
+func newIPExtractor(profile *profile.Profile) (echo.IPExtractor, error) {
+	if profile.ClientIPHeader == "" {
+		return nil, nil
+	}
+	if len(profile.TrustedProxies) == 0 {
+		return nil, errors.Errorf("the client IP header %s requires trusted proxies", profile.ClientIPHeader)
+	}
+	trustedProxies, err := iplist.Parse(profile.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse trusted proxies")
+	}
+	header := http.CanonicalHeaderKey(profile.ClientIPHeader)
+	return func(req *http.Request) string {
+		peer := peerIP(req)
+		if !trustedProxies.Contains(peer) {
+			return peer
+		}
+		if ip := clientIPFromHeader(req.Header.Values(header), trustedProxies); ip != "" {
+			return ip
+		}
+		return peer
+	}, nil
+}
+
+// clientIPFromHeader returns the client IP of the values of the header, empty when there is no
+// valid IP. The values listing several IPs, as X-Forwarded-For does, are read from the right, the
+// proxies appending the IP of their peer: the client IP is the first one not of a trusted proxy.
+func clientIPFromHeader(values []string, trustedProxies *iplist.List) string {
+	ips := []string{}
+	for _, value := range values {
+		for _, ip := range strings.Split(value, ",") {
+			addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+			if err != nil {
+				// A list with an invalid IP has been tampered with.
+				return ""
+			}
+			ips = append(ips, addr.Unmap().String())
+		}
+	}
+	for i := len(ips) - 1; i >= 0; i-- {
+		if i == 0 || !trustedProxies.Contains(ips[i]) {
+			return ips[i]
+		}
+	}
+	return ""
+}
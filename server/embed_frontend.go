@@ -4,11 +4,24 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
+	"net/url"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
 
 	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+// The fallbacks of the paths of the web app when the frontend is not bundled, as in API-only deployments.
+const (
+	// FrontendFallbackNotFound responds 404 to the paths of the web app.
+	FrontendFallbackNotFound = "not-found"
+	// FrontendFallbackRedirect redirects the paths of the web app to the same paths at the frontend origin.
+	FrontendFallbackRedirect = "redirect"
+	// FrontendFallbackProxy proxies the paths of the web app to the frontend origin.
+	FrontendFallbackProxy = "proxy"
 )
 
 //go:embed dist
@@ -25,7 +38,56 @@ func getFileSystem(path string) http.FileSystem {
 
 func defaultRequestSkipper(c echo.Context) bool {
 	path := c.Path()
-	return util.HasPrefixes(path, "/api/", "/s/*")
+	return util.HasPrefixes(path, "/api/", "/s/*", "/sitemap.xml")
+}
+
+// registerFrontend serves the paths of the web app with the bundled frontend, or with the frontend
+// fallback of the profile. The fallback only handles the paths not matching a route, so that the
+// API, the redirector and the other routes of the server are unchanged.
+func registerFrontend(e *echo.Echo, profile *profile.Profile) error {
+	if profile.FrontendFallback == "" {
+		embedFrontend(e)
+		return nil
+	}
+
+	var handler echo.HandlerFunc
+	switch profile.FrontendFallback {
+	case FrontendFallbackNotFound:
+		handler = echo.NotFoundHandler
+	case FrontendFallbackRedirect, FrontendFallbackProxy:
+		origin, err := url.Parse(profile.FrontendOrigin)
+		if err != nil || (origin.Scheme != "http" && origin.Scheme != "https") || origin.Host == "" {
+			return errors.Errorf("invalid frontend origin %q, must be an http or https URL", profile.FrontendOrigin)
+		}
+		if profile.FrontendFallback == FrontendFallbackRedirect {
+			handler = func(c echo.Context) error {
+				return c.Redirect(http.StatusFound, origin.JoinPath(c.Request().URL.Path).String()+querySuffix(c.Request().URL))
+			}
+		} else {
+			// The proxy responds itself, it never calls the next handler.
+			handler = middleware.ProxyWithConfig(middleware.ProxyConfig{
+				Balancer: middleware.NewRoundRobinBalancer([]*middleware.ProxyTarget{{URL: origin}}),
+			})(echo.NotFoundHandler)
+		}
+	default:
+		return errors.Errorf("unknown frontend fallback %q, must be %s, %s or %s", profile.FrontendFallback, FrontendFallbackNotFound, FrontendFallbackRedirect, FrontendFallbackProxy)
+	}
+	e.RouteNotFound("/*", func(c echo.Context) error {
+		// The unknown paths of the API are not the web app's.
+		if util.HasPrefixes(c.Request().URL.Path, "/api/", "/s/") {
+			return echo.ErrNotFound
+		}
+		return handler(c)
+	})
+	return nil
+}
+
+// querySuffix returns the query of the URL with its leading "?", empty when there is none.
+func querySuffix(u *url.URL) string {
+	if u.RawQuery == "" {
+		return ""
+	}
+	return "?" + u.RawQuery
 }
 
 func embedFrontend(e *echo.Echo) {
@@ -2,10 +2,12 @@ package profile
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -19,18 +21,245 @@ type Profile struct {
 	Mode string `json:"mode"`
 	// Port is the binding port for server
 	Port int `json:"-"`
+	// GRPCPort is the binding port for the gRPC server, defaults to the port after Port
+	GRPCPort int `json:"-" mapstructure:"grpc-port"`
+	// Socket is the path of the Unix domain socket to listen on instead of the port
+	Socket string `json:"-"`
 	// Data is the data directory
 	Data string `json:"-"`
-	// DSN points to where slash stores its own data
-	DSN string `json:"-"`
+	// DSN points to where slash stores its own data, it is derived from the data directory unless set
+	DSN string `json:"-" mapstructure:"dsn"`
 	// Version is the current version of server
 	Version string `json:"version"`
+	// SweepInterval is the interval to sweep orphaned rows, zero disables it
+	SweepInterval time.Duration `json:"-" mapstructure:"sweep-interval"`
+	// BlockedNames is the path of the list of names that shortcuts cannot use
+	BlockedNames string `json:"-" mapstructure:"blocked-names"`
+	// ReviewNames is the path of the list of names that only admins can give to shortcuts
+	ReviewNames string `json:"-" mapstructure:"review-names"`
+	// DebugHeaders exposes the matched shortcut in redirect response headers, it is always on in dev mode
+	DebugHeaders bool `json:"-" mapstructure:"debug-headers"`
+	// PreviewParam is the query param letting owners and admins preview a shortcut instead of following it
+	PreviewParam string `json:"-" mapstructure:"preview-param"`
+	// PreserveTagCase keeps the case of shortcut tags instead of lowercasing them
+	PreserveTagCase bool `json:"-" mapstructure:"preserve-tag-case"`
+	// TrustedIPs are the IPs and CIDR ranges of health checks and monitors, which are not rate limited
+	TrustedIPs []string `json:"-" mapstructure:"trusted-ips"`
+	// CountHeadViews counts the HEAD requests of shortcuts as views, which link checkers send
+	CountHeadViews bool `json:"-" mapstructure:"count-head-views"`
+	// UserRateLimit is the number of API requests per minute users can make, zero means unlimited
+	UserRateLimit int `json:"-" mapstructure:"user-rate-limit"`
+	// AdminRateLimit is the number of API requests per minute admins can make, zero means unlimited
+	AdminRateLimit int `json:"-" mapstructure:"admin-rate-limit"`
+	// CreationRateLimit is the number of shortcuts users can create per CreationRateWindow, zero means unlimited
+	CreationRateLimit int `json:"-" mapstructure:"creation-rate-limit"`
+	// CreationRateWindow is the window in which the shortcuts created by users are counted
+	CreationRateWindow time.Duration `json:"-" mapstructure:"creation-rate-window"`
+	// MaxLinkLength is the maximum length in bytes of shortcut links, defaults to DefaultMaxLinkLength
+	MaxLinkLength int `json:"-" mapstructure:"max-link-length"`
+	// LockRetries is the number of times the store retries a write failing because the database is locked
+	LockRetries int `json:"-" mapstructure:"lock-retries"`
+	// Welcome seeds a new prod instance with the welcome shortcuts, owned by the first user to sign up
+	Welcome bool `json:"-" mapstructure:"welcome"`
+	// PreviewFavicon fetches the favicons of shortcut links to show them in the link previews
+	PreviewFavicon bool `json:"-" mapstructure:"preview-favicon"`
+	// TargetHealthInterval is the interval to health check the targets of shortcuts, zero disables it and all targets are healthy
+	TargetHealthInterval time.Duration `json:"-" mapstructure:"target-health-interval"`
+	// HealthCheckPrivateTargets health checks the targets on private and loopback addresses too, which are otherwise refused and never unhealthy
+	HealthCheckPrivateTargets bool `json:"-" mapstructure:"health-check-private-targets"`
+	// SlugGenerator is the kind of generator of the names of the shortcuts created without one, empty requires names
+	SlugGenerator string `json:"-" mapstructure:"slug-generator"`
+	// SlowQueryThreshold is the duration above which the store queries are logged, zero disables it
+	SlowQueryThreshold time.Duration `json:"-" mapstructure:"slow-query-threshold"`
+	// MaxTagsPerShortcut is the maximum number of tags of a shortcut, defaults to DefaultMaxTagsPerShortcut
+	MaxTagsPerShortcut int `json:"-" mapstructure:"max-tags-per-shortcut"`
+	// MaxTagLength is the maximum length in bytes of shortcut tags, defaults to DefaultMaxTagLength
+	MaxTagLength int `json:"-" mapstructure:"max-tag-length"`
+	// Sitemap serves a sitemap of the public shortcuts at /sitemap.xml, which requires the custom domain setting
+	Sitemap bool `json:"-" mapstructure:"sitemap"`
+	// StripTrailingSlash strips the trailing slashes of shortcut names on redirect, so that /s/name/ and /s/name are the same shortcut
+	StripTrailingSlash bool `json:"-" mapstructure:"strip-trailing-slash"`
+	// ReadHeaderTimeout is the maximum duration to read the headers of a request, zero disables it
+	ReadHeaderTimeout time.Duration `json:"-" mapstructure:"read-header-timeout"`
+	// ReadTimeout is the maximum duration to read a whole request, zero disables it
+	ReadTimeout time.Duration `json:"-" mapstructure:"read-timeout"`
+	// WriteTimeout is the maximum duration to write a response once its request is read, zero disables it
+	WriteTimeout time.Duration `json:"-" mapstructure:"write-timeout"`
+	// IdleTimeout is the maximum duration a keep-alive connection waits for the next request, zero disables it
+	IdleTimeout time.Duration `json:"-" mapstructure:"idle-timeout"`
+	// TLSCertFile is the path of the TLS certificate the server serves HTTPS with, enabling HTTP/2
+	TLSCertFile string `json:"-" mapstructure:"tls-cert-file"`
+	// TLSKeyFile is the path of the private key of the TLS certificate
+	TLSKeyFile string `json:"-" mapstructure:"tls-key-file"`
+	// FallbackDSN is the data source of the older database missing shortcuts are read through to and copied from
+	FallbackDSN string `json:"-" mapstructure:"fallback-dsn"`
+	// MaxOgTitleLength is the maximum length in bytes of the open graph titles of shortcuts, defaults to DefaultMaxOgTitleLength
+	MaxOgTitleLength int `json:"-" mapstructure:"max-og-title-length"`
+	// MaxOgDescriptionLength is the maximum length in bytes of the open graph descriptions of shortcuts, defaults to DefaultMaxOgDescriptionLength
+	MaxOgDescriptionLength int `json:"-" mapstructure:"max-og-description-length"`
+	// MaxOgImageLength is the maximum length in bytes of the open graph image URLs of shortcuts, defaults to DefaultMaxOgImageLength
+	MaxOgImageLength int `json:"-" mapstructure:"max-og-image-length"`
+	// Seed is the path of a SQL file executed on a new prod database after applying the latest schema, skipped for existing ones
+	Seed string `json:"-" mapstructure:"seed"`
+	// NormalizeNames normalizes the shortcut names to the NFC unicode form on save and lookup, and rejects names confusable with existing ones
+	NormalizeNames bool `json:"-" mapstructure:"normalize-names"`
+	// FoldNameCase case-folds the shortcut names on save and lookup, so that names differing in case are the same shortcut
+	FoldNameCase bool `json:"-" mapstructure:"fold-name-case"`
+	// DeadLinkReportSchedule is the cron schedule, in UTC, of the dead link report sent to the DeadLinkReportWebhooks, empty disables it
+	DeadLinkReportSchedule string `json:"-" mapstructure:"dead-link-report-schedule"`
+	// DeadLinkReportWebhooks are the URLs of the webhooks the dead link report is posted to as JSON
+	DeadLinkReportWebhooks []string `json:"-" mapstructure:"dead-link-report-webhooks"`
+	// DeniedHosts is the path of the list of hosts that shortcut links cannot point to, reloaded on SIGHUP
+	DeniedHosts string `json:"-" mapstructure:"denied-hosts"`
+	// RedirectLog is the path of the append-only file every redirect is logged to, empty disables it
+	RedirectLog string `json:"-" mapstructure:"redirect-log"`
+	// RedirectLogMaxSize is the size in megabytes at which the redirect log is rotated, zero disables the rotation on size
+	RedirectLogMaxSize int64 `json:"-" mapstructure:"redirect-log-max-size"`
+	// RedirectLogMaxAge is the age at which the redirect log is rotated, zero disables the rotation on age
+	RedirectLogMaxAge time.Duration `json:"-" mapstructure:"redirect-log-max-age"`
+	// ParseUserAgents is whether the user agents of views are parsed into their browser, OS and device type when they are recorded
+	ParseUserAgents bool `json:"-" mapstructure:"parse-user-agents"`
+	// DiscardRawUserAgents is whether the raw user agents of views are not recorded, leaving only their parsed fields
+	DiscardRawUserAgents bool `json:"-" mapstructure:"discard-raw-user-agents"`
+	// AnalyticsPauseBufferSize is the maximum number of views buffered while analytics are paused, defaults to DefaultAnalyticsPauseBufferSize
+	AnalyticsPauseBufferSize int `json:"-" mapstructure:"analytics-pause-buffer-size"`
+	// FrontendFallback is the response to the paths of the web app, empty serves the bundled frontend, see server.FrontendFallbackNotFound
+	FrontendFallback string `json:"-" mapstructure:"frontend-fallback"`
+	// FrontendOrigin is the origin of the frontend hosted elsewhere that the redirect and proxy frontend fallbacks send the paths of the web app to
+	FrontendOrigin string `json:"-" mapstructure:"frontend-origin"`
+	// ClientIPHeader is the header carrying the client IP, only read from the TrustedProxies, empty reads X-Forwarded-For and X-Real-IP from any peer
+	ClientIPHeader string `json:"-" mapstructure:"client-ip-header"`
+	// TrustedProxies are the IPs and CIDR ranges of the proxies the ClientIPHeader is read from
+	TrustedProxies []string `json:"-" mapstructure:"trusted-proxies"`
+	// PruneArchivedAfter is the age after which the sweeper permanently deletes the archived shortcuts, zero disables it
+	PruneArchivedAfter time.Duration `json:"-" mapstructure:"prune-archived-after"`
 }
 
+// DefaultPreviewParam is the default query param previewing a shortcut.
+const DefaultPreviewParam = "_slash_preview"
+
+// DefaultAnalyticsPauseBufferSize is the default maximum number of views buffered while analytics are paused.
+const DefaultAnalyticsPauseBufferSize = 10000
+
+// DefaultMaxLinkLength is the default maximum length of shortcut links, which leaves room
+// for long signed query strings.
+const DefaultMaxLinkLength = 8 * 1024
+
+// DefaultMaxTagsPerShortcut is the default maximum number of tags of a shortcut.
+const DefaultMaxTagsPerShortcut = 50
+
+// DefaultMaxTagLength is the default maximum length of shortcut tags.
+const DefaultMaxTagLength = 64
+
+// The default maximum lengths of the open graph metadata of shortcuts, which keep the previews small.
+const (
+	DefaultMaxOgTitleLength       = 256
+	DefaultMaxOgDescriptionLength = 1024
+	DefaultMaxOgImageLength       = 2048
+)
+
+// The default timeouts of the HTTP server, which keep slow clients from holding connections open.
+const (
+	DefaultReadHeaderTimeout = 10 * time.Second
+	DefaultReadTimeout       = 30 * time.Second
+	DefaultWriteTimeout      = 60 * time.Second
+	DefaultIdleTimeout       = 120 * time.Second
+)
+
 func (p *Profile) IsDev() bool {
 	return p.Mode != "prod"
 }
 
+// IsDebugHeadersEnabled returns whether redirect responses carry the debug headers, which public
+// demo instances do not unless the flag is set.
+func (p *Profile) IsDebugHeadersEnabled() bool {
+	return p.Mode == "dev" || p.DebugHeaders
+}
+
+// GetPreviewParam returns the query param previewing a shortcut.
+func (p *Profile) GetPreviewParam() string {
+	if p.PreviewParam == "" {
+		return DefaultPreviewParam
+	}
+	return p.PreviewParam
+}
+
+// GetAnalyticsPauseBufferSize returns the maximum number of views buffered while analytics are paused.
+func (p *Profile) GetAnalyticsPauseBufferSize() int {
+	if p.AnalyticsPauseBufferSize <= 0 {
+		return DefaultAnalyticsPauseBufferSize
+	}
+	return p.AnalyticsPauseBufferSize
+}
+
+// GetMaxLinkLength returns the maximum length in bytes of shortcut links.
+func (p *Profile) GetMaxLinkLength() int {
+	if p.MaxLinkLength <= 0 {
+		return DefaultMaxLinkLength
+	}
+	return p.MaxLinkLength
+}
+
+// GetMaxOgTitleLength returns the maximum length in bytes of the open graph titles of shortcuts.
+func (p *Profile) GetMaxOgTitleLength() int {
+	if p.MaxOgTitleLength <= 0 {
+		return DefaultMaxOgTitleLength
+	}
+	return p.MaxOgTitleLength
+}
+
+// GetMaxOgDescriptionLength returns the maximum length in bytes of the open graph descriptions of shortcuts.
+func (p *Profile) GetMaxOgDescriptionLength() int {
+	if p.MaxOgDescriptionLength <= 0 {
+		return DefaultMaxOgDescriptionLength
+	}
+	return p.MaxOgDescriptionLength
+}
+
+// GetMaxOgImageLength returns the maximum length in bytes of the open graph image URLs of shortcuts.
+func (p *Profile) GetMaxOgImageLength() int {
+	if p.MaxOgImageLength <= 0 {
+		return DefaultMaxOgImageLength
+	}
+	return p.MaxOgImageLength
+}
+
+// GetMaxTagsPerShortcut returns the maximum number of tags of a shortcut.
+func (p *Profile) GetMaxTagsPerShortcut() int {
+	if p.MaxTagsPerShortcut <= 0 {
+		return DefaultMaxTagsPerShortcut
+	}
+	return p.MaxTagsPerShortcut
+}
+
+// GetMaxTagLength returns the maximum length in bytes of shortcut tags.
+func (p *Profile) GetMaxTagLength() int {
+	if p.MaxTagLength <= 0 {
+		return DefaultMaxTagLength
+	}
+	return p.MaxTagLength
+}
+
+// LogValue implements slog.LogValuer so that logging a profile never leaks the DSN.
+func (p *Profile) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("mode", p.Mode),
+		slog.Int("port", p.Port),
+		slog.String("socket", p.Socket),
+		slog.String("dsn", RedactDSN(p.DSN)),
+		slog.String("version", p.Version),
+	)
+}
+
+// RedactDSN hides the location of a DSN, keeping only the database file name
+// so that it can still be told apart in logs.
+func RedactDSN(dsn string) string {
+	if dsn == "" {
+		return ""
+	}
+	return filepath.Join("REDACTED", filepath.Base(dsn))
+}
+
 func checkDSN(dataDir string) (string, error) {
 	// Convert to absolute path if relative path is supplied.
 	if !filepath.IsAbs(dataDir) {
@@ -52,6 +281,24 @@ func checkDSN(dataDir string) (string, error) {
 	return dataDir, nil
 }
 
+// checkSQLiteDSN returns the absolute path of the database file of a SQLite DSN.
+// The connection params are set when the database is opened, so the DSN is only the path of the file.
+func checkSQLiteDSN(dsn string) (string, error) {
+	if dsn == ":memory:" || strings.HasPrefix(dsn, "file:") || strings.Contains(dsn, "?") {
+		return "", errors.Errorf("invalid dsn %s, it must be the path of the database file", RedactDSN(dsn))
+	}
+	dsn, err := filepath.Abs(dsn)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(filepath.Dir(dsn)); err != nil {
+		return "", errors.Wrapf(err, "unable to access the folder of dsn %s", RedactDSN(dsn))
+	} else if !info.IsDir() {
+		return "", errors.Errorf("the folder of dsn %s is not a directory", RedactDSN(dsn))
+	}
+	return dsn, nil
+}
+
 // GetProfile will return a profile for dev or prod.
 func GetProfile() (*Profile, error) {
 	profile := Profile{}
@@ -86,8 +333,16 @@ func GetProfile() (*Profile, error) {
 	}
 
 	profile.Data = dataDir
-	dbFile := fmt.Sprintf("slash_%s.db", profile.Mode)
-	profile.DSN = filepath.Join(dataDir, dbFile)
+	if profile.DSN == "" {
+		dbFile := fmt.Sprintf("slash_%s.db", profile.Mode)
+		profile.DSN = filepath.Join(dataDir, dbFile)
+	} else {
+		dsn, err := checkSQLiteDSN(profile.DSN)
+		if err != nil {
+			return nil, err
+		}
+		profile.DSN = dsn
+	}
 	profile.Version = version.GetCurrentVersion(profile.Mode)
 
 	return &profile, nil
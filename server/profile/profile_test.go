@@ -0,0 +1,55 @@
+package profile
+
+import (
+	"bytes"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileLogValue(t *testing.T) {
+	profile := &Profile{
+		Mode:    "prod",
+		Port:    5231,
+		Data:    "/var/opt/secret-dir",
+		DSN:     "/var/opt/secret-dir/slash_prod.db",
+		Version: "0.5.0",
+	}
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+	logger.Info("server profile", "profile", profile)
+
+	require.NotContains(t, buf.String(), "secret-dir")
+	require.Contains(t, buf.String(), `"dsn":"REDACTED/slash_prod.db"`)
+	require.Contains(t, buf.String(), `"mode":"prod"`)
+	require.Contains(t, buf.String(), `"port":5231`)
+}
+
+func TestGetProfileDSN(t *testing.T) {
+	defer viper.Reset()
+	dataDir := t.TempDir()
+	viper.Set("mode", "prod")
+	viper.Set("data", dataDir)
+
+	profile, err := GetProfile()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dataDir, "slash_prod.db"), profile.DSN)
+
+	// The DSN flag overrides the derived DSN.
+	dsnDir := t.TempDir()
+	viper.Set("dsn", filepath.Join(dsnDir, "links.db"))
+	profile, err = GetProfile()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dsnDir, "links.db"), profile.DSN)
+	require.Equal(t, dataDir, profile.Data)
+
+	for _, dsn := range []string{":memory:", "file:links.db", filepath.Join(dsnDir, "links.db?mode=ro"), filepath.Join(dsnDir, "missing", "links.db")} {
+		viper.Set("dsn", dsn)
+		_, err = GetProfile()
+		require.Error(t, err, dsn)
+	}
+}
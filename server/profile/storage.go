@@ -0,0 +1,40 @@
+package profile
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ephemeralFilesystems are the names of the filesystem types whose data is lost when
+// the machine or container restarts, by their magic number.
+var ephemeralFilesystems = map[int64]string{
+	0x01021994: "tmpfs",
+	0x858458f6: "ramfs",
+	0x794c7630: "overlay",
+}
+
+// StorageWarnings returns the reasons the database of the profile may lose its data, such as a
+// database on an ephemeral filesystem. These are warnings for prod mode, not errors.
+func (p *Profile) StorageWarnings() []string {
+	dir := filepath.Dir(p.DSN)
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil
+	}
+	// The filesystem type is only known on Linux, where containers run.
+	fsType, _ := filesystemType(dir)
+	return checkStorage(fsType, info.Mode())
+}
+
+func checkStorage(fsType int64, mode fs.FileMode) []string {
+	warnings := []string{}
+	if name, ok := ephemeralFilesystems[fsType]; ok {
+		warnings = append(warnings, fmt.Sprintf("the database folder is on a %s filesystem, whose data may be lost on restart; mount a persistent volume on it, or set --data or --dsn to one", name))
+	}
+	if mode.Perm()&0o002 != 0 {
+		warnings = append(warnings, "the database folder is writable by all users, who can replace the database; restrict its permissions to the user running slash")
+	}
+	return warnings
+}
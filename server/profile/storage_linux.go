@@ -0,0 +1,13 @@
+//go:build linux
+
+package profile
+
+import "syscall"
+
+func filesystemType(dir string) (int64, error) {
+	stat := syscall.Statfs_t{}
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return int64(stat.Type), nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package profile
+
+import "github.com/pkg/errors"
+
+func filesystemType(string) (int64, error) {
+	return 0, errors.New("unsupported platform")
+}
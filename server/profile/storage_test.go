@@ -0,0 +1,25 @@
+package profile
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckStorage(t *testing.T) {
+	const ext4 = 0xef53
+	require.Empty(t, checkStorage(ext4, fs.ModeDir|0o750))
+
+	warnings := checkStorage(0x01021994, fs.ModeDir|0o750)
+	require.Equal(t, 1, len(warnings))
+	require.Contains(t, warnings[0], "tmpfs")
+	warnings = checkStorage(0x794c7630, fs.ModeDir|0o750)
+	require.Equal(t, 1, len(warnings))
+	require.Contains(t, warnings[0], "overlay")
+
+	warnings = checkStorage(ext4, fs.ModeDir|0o777)
+	require.Equal(t, 1, len(warnings))
+	require.Contains(t, warnings[0], "writable by all users")
+	require.Equal(t, 2, len(checkStorage(0x858458f6, fs.ModeDir|0o777)))
+}
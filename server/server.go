@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -17,7 +18,14 @@ import (
 
 	apiv1 "github.com/yourselfhosted/slash/api/v1"
 	apiv2 "github.com/yourselfhosted/slash/api/v2"
+	"github.com/yourselfhosted/slash/internal/blocklist"
+	"github.com/yourselfhosted/slash/internal/cron"
+	"github.com/yourselfhosted/slash/internal/hostlist"
+	"github.com/yourselfhosted/slash/internal/iplist"
 	"github.com/yourselfhosted/slash/internal/log"
+	"github.com/yourselfhosted/slash/internal/ratelimit"
+	"github.com/yourselfhosted/slash/internal/requestid"
+	"github.com/yourselfhosted/slash/internal/slug"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/metric"
 	"github.com/yourselfhosted/slash/server/profile"
@@ -34,8 +42,10 @@ type Server struct {
 	Secret  string
 
 	licenseService *license.LicenseService
+	hostDenylist   *hostlist.List
 
 	// API services.
+	apiV1Service *apiv1.APIV1Service
 	apiV2Service *apiv2.APIV2Service
 }
 
@@ -44,6 +54,18 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	e.Debug = true
 	e.HideBanner = true
 	e.HidePort = true
+	e.Server.ReadHeaderTimeout = profile.ReadHeaderTimeout
+	e.Server.ReadTimeout = profile.ReadTimeout
+	e.Server.WriteTimeout = profile.WriteTimeout
+	e.Server.IdleTimeout = profile.IdleTimeout
+	e.HTTPErrorHandler = newHTTPErrorHandler(e)
+	ipExtractor, err := newIPExtractor(profile)
+	if err != nil {
+		return nil, err
+	}
+	if ipExtractor != nil {
+		e.IPExtractor = ipExtractor
+	}
 
 	licenseService := license.NewLicenseService(profile, store)
 
@@ -54,10 +76,32 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		licenseService: licenseService,
 	}
 
-	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
-		Format: `{"time":"${time_rfc3339}",` +
-			`"method":"${method}","uri":"${uri}",` +
-			`"status":${status},"error":"${error}"}` + "\n",
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		TargetHeader: requestid.HeaderName,
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			c.SetRequest(c.Request().WithContext(requestid.WithRequestID(c.Request().Context(), requestID)))
+		},
+	}))
+
+	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		// The errors are handled before logging, so that the status logged is the one responded.
+		HandleError: true,
+		LogMethod:   true,
+		LogURI:      true,
+		LogStatus:   true,
+		LogError:    true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			attrs := []slog.Attr{
+				slog.String("method", v.Method),
+				slog.String("uri", v.URI),
+				slog.Int("status", v.Status),
+			}
+			if v.Error != nil {
+				attrs = append(attrs, slog.String("error", v.Error.Error()))
+			}
+			slog.LogAttrs(c.Request().Context(), slog.LevelInfo, "request", attrs...)
+			return nil
+		},
 	}))
 
 	e.Use(middleware.Gzip())
@@ -69,12 +113,22 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}))
 
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
-		Skipper: grpcRequestSkipper,
+		// The timeout buffers the whole response, which the streamed responses must not be.
+		Skipper: func(c echo.Context) bool {
+			return grpcRequestSkipper(c) || streamRequestSkipper(c)
+		},
 		Timeout: 30 * time.Second,
 	}))
 
+	trustedIPs, err := iplist.Parse(profile.TrustedIPs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse trusted IPs")
+	}
 	e.Use(middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
-		Skipper: grpcRequestSkipper,
+		// Trusted IPs are matched against the peer address, as the forwarded headers can be spoofed.
+		Skipper: func(c echo.Context) bool {
+			return grpcRequestSkipper(c) || trustedIPs.Contains(peerIP(c.Request()))
+		},
 		Store: middleware.NewRateLimiterMemoryStoreWithConfig(
 			middleware.RateLimiterMemoryStoreConfig{Rate: 30, Burst: 60, ExpiresIn: 3 * time.Minute},
 		),
@@ -90,7 +144,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		},
 	}))
 
-	embedFrontend(e)
+	if err := registerFrontend(e, profile); err != nil {
+		return nil, err
+	}
 
 	// In dev mode, we'd like to set the const secret key to make signin session persistence.
 	secret := "slash"
@@ -103,12 +159,36 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
+	e.GET("/healthz", s.healthz)
+
+	nameBlocklist, err := blocklist.Load(profile.BlockedNames, profile.ReviewNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load name blocklist")
+	}
+	hostDenylist, err := hostlist.Load(profile.DeniedHosts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load host denylist")
+	}
+	s.hostDenylist = hostDenylist
+
+	slugGenerator, err := s.newSlugGenerator(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create slug generator")
+	}
+
 	rootGroup := e.Group("")
 	// Register API v1 routes.
-	apiV1Service := apiv1.NewAPIV1Service(profile, store, licenseService)
-	apiV1Service.Start(rootGroup, secret)
+	// The shortcut creation rate is shared by both APIs.
+	creationLimiter := ratelimit.New(profile.CreationRateWindow)
+	s.apiV1Service = apiv1.NewAPIV1Service(profile, store, licenseService, nameBlocklist, hostDenylist, creationLimiter, slugGenerator)
+	s.apiV1Service.Start(rootGroup, secret)
 
-	s.apiV2Service = apiv2.NewAPIV2Service(secret, profile, store, licenseService, s.Profile.Port+1)
+	_, grpcAddress := s.grpcListenAddress()
+	grpcTarget := grpcAddress
+	if profile.Socket != "" {
+		grpcTarget = "unix:" + grpcAddress
+	}
+	s.apiV2Service = apiv2.NewAPIV2Service(secret, profile, store, licenseService, nameBlocklist, hostDenylist, creationLimiter, slugGenerator, grpcTarget)
 	// Register gRPC gateway as api v2.
 	if err := s.apiV2Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -127,18 +207,78 @@ func (s *Server) Start(ctx context.Context) error {
 		log.Error("failed to load subscription", zap.Error(err))
 	}
 	// Start gRPC server.
-	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Profile.Port+1))
+	grpcListener, err := listen(s.grpcListenAddress())
 	if err != nil {
 		return err
 	}
 	go func() {
-		if err := s.apiV2Service.GetGRPCServer().Serve(listen); err != nil {
+		if err := s.apiV2Service.GetGRPCServer().Serve(grpcListener); err != nil {
 			slog.Log(ctx, slog.LevelError, "failed to start grpc server")
 		}
 	}()
 
+	if s.Profile.SweepInterval > 0 {
+		go s.runSweeper(ctx)
+	}
+	if s.Profile.TargetHealthInterval > 0 {
+		go s.runTargetHealthChecker(ctx)
+	}
+	if s.Profile.DeadLinkReportSchedule != "" {
+		if err := s.startDeadLinkReporter(ctx); err != nil {
+			return err
+		}
+	}
+
 	metric.Enqueue("server start")
-	return s.e.Start(fmt.Sprintf(":%d", s.Profile.Port))
+	listener, err := listen(s.listenAddress())
+	if err != nil {
+		return err
+	}
+	if s.Profile.TLSCertFile != "" || s.Profile.TLSKeyFile != "" {
+		if s.Profile.TLSCertFile == "" || s.Profile.TLSKeyFile == "" {
+			return errors.New("both the TLS certificate and key files are required")
+		}
+		// Serving TLS from the HTTP server itself enables HTTP/2, negotiated with ALPN.
+		s.e.Server.Handler = s.e
+		return s.e.Server.ServeTLS(listener, s.Profile.TLSCertFile, s.Profile.TLSKeyFile)
+	}
+	s.e.Listener = listener
+	return s.e.Start("")
+}
+
+// listenAddress returns the network and address the HTTP server listens on.
+func (s *Server) listenAddress() (string, string) {
+	if s.Profile.Socket != "" {
+		return "unix", s.Profile.Socket
+	}
+	return "tcp", fmt.Sprintf(":%d", s.Profile.Port)
+}
+
+// grpcListenAddress returns the network and address the gRPC server listens on, next to the HTTP server.
+func (s *Server) grpcListenAddress() (string, string) {
+	if s.Profile.Socket != "" {
+		return "unix", s.Profile.Socket + ".grpc"
+	}
+	if s.Profile.GRPCPort != 0 {
+		return "tcp", fmt.Sprintf(":%d", s.Profile.GRPCPort)
+	}
+	return "tcp", fmt.Sprintf(":%d", s.Profile.Port+1)
+}
+
+// listen announces on the address, replacing the stale socket file left by a previous run.
+// The socket file is removed when the listener is closed.
+func listen(network, address string) (net.Listener, error) {
+	if network == "unix" {
+		if info, err := os.Stat(address); err == nil {
+			if info.Mode()&os.ModeSocket == 0 {
+				return nil, errors.Errorf("%s exists and is not a socket", address)
+			}
+			if err := os.Remove(address); err != nil {
+				return nil, errors.Wrapf(err, "failed to remove stale socket %s", address)
+			}
+		}
+	}
+	return net.Listen(network, address)
 }
 
 func (s *Server) Shutdown(ctx context.Context) {
@@ -150,22 +290,77 @@ func (s *Server) Shutdown(ctx context.Context) {
 		fmt.Printf("failed to shutdown server, error: %v\n", err)
 	}
 
+	// Shutdown gRPC server.
+	s.apiV2Service.GetGRPCServer().Stop()
+
+	if err := s.apiV1Service.Close(ctx); err != nil {
+		fmt.Printf("failed to close redirect log, error: %v\n", err)
+	}
+
 	// Close database connection.
-	if err := s.Store.Close(); err != nil {
+	if err := s.Store.Close(ctx); err != nil {
 		fmt.Printf("failed to close database, error: %v\n", err)
 	}
 
 	fmt.Printf("server stopped properly\n")
 }
 
+// Reload reads the lists loaded from files again, so that they can change without a restart.
+func (s *Server) Reload() error {
+	if err := s.hostDenylist.Reload(); err != nil {
+		return errors.Wrap(err, "failed to reload host denylist")
+	}
+	return nil
+}
+
 func (s *Server) GetEcho() *echo.Echo {
 	return s.e
 }
 
+// healthz responds whether the server can reach its database, for load balancers and monitors.
+func (s *Server) healthz(c echo.Context) error {
+	if err := s.Store.Ping(c.Request().Context()); err != nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"status": "unavailable"})
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// peerIP returns the IP of the direct peer of the request, ignoring the forwarded headers.
+func peerIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// newHTTPErrorHandler returns the error handler responding to the validation errors returned by
+// the handlers with a 400 listing the invalid fields, and to the other errors as echo does.
+func newHTTPErrorHandler(e *echo.Echo) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		var validationErr *store.ValidationError
+		if _, ok := err.(*echo.HTTPError); !ok && errors.As(err, &validationErr) {
+			err = echo.NewHTTPError(http.StatusBadRequest, validationErr).SetInternal(err)
+		}
+		e.DefaultHTTPErrorHandler(err, c)
+	}
+}
+
 func grpcRequestSkipper(c echo.Context) bool {
 	return strings.HasPrefix(c.Request().URL.Path, "/slash.api.v2.")
 }
 
+// streamRequestSkipper matches the requests of the streamed responses and of the exports, which are
+// written as they go, and the commits of the import sessions, which import all their batches at once.
+// All of them may outlast the request timeout.
+func streamRequestSkipper(c echo.Context) bool {
+	path := c.Request().URL.Path
+	if strings.HasPrefix(path, "/api/v1/workspace/import/sessions/") && strings.HasSuffix(path, "/commit") {
+		return true
+	}
+	return strings.HasSuffix(path, ":stream") || strings.HasSuffix(path, ":export") || path == "/api/v1/workspace/export"
+}
+
 func (s *Server) getSecretSessionName(ctx context.Context) (string, error) {
 	secretSessionSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
@@ -187,3 +382,91 @@ func (s *Server) getSecretSessionName(ctx context.Context) (string, error) {
 	}
 	return secretSessionSetting.GetSecretSession(), nil
 }
+
+// runSweeper periodically deletes the orphaned rows, the shortcuts archived for longer than the
+// prune age of the profile and the abandoned import sessions, until ctx is done.
+func (s *Server) runSweeper(ctx context.Context) {
+	ticker := time.NewTicker(s.Profile.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := s.Store.Sweep(ctx)
+			if err != nil {
+				slog.Error("failed to sweep database", "error", err)
+				continue
+			}
+			slog.Info("swept database", "shortcuts", result.Shortcuts, "userSettings", result.UserSettings, "activities", result.Activities, "shortcutCollaborators", result.ShortcutCollaborators)
+			if s.Profile.PruneArchivedAfter > 0 {
+				pruneResult, err := s.Store.PruneArchivedShortcuts(ctx, time.Now().Add(-s.Profile.PruneArchivedAfter).Unix())
+				if err != nil {
+					slog.Error("failed to prune archived shortcuts", "error", err)
+					continue
+				}
+				slog.Info("pruned archived shortcuts", "shortcuts", pruneResult.Shortcuts, "activities", pruneResult.Activities, "shortcutCollaborators", pruneResult.ShortcutCollaborators)
+			}
+			expired, err := s.Store.ExpireImportSessions(ctx, time.Now().Add(-store.ImportSessionTTL).Unix())
+			if err != nil {
+				slog.Error("failed to expire import sessions", "error", err)
+				continue
+			}
+			if expired > 0 {
+				slog.Info("expired abandoned import sessions", "importSessions", expired)
+			}
+		}
+	}
+}
+
+// runTargetHealthChecker periodically health checks the targets of the shortcuts until ctx is done.
+func (s *Server) runTargetHealthChecker(ctx context.Context) {
+	ticker := time.NewTicker(s.Profile.TargetHealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.apiV1Service.CheckTargetHealth(ctx); err != nil {
+				slog.Error("failed to check target health", "error", err)
+			}
+		}
+	}
+}
+
+// startDeadLinkReporter sends the dead link report on the schedule of the profile until ctx is done.
+func (s *Server) startDeadLinkReporter(ctx context.Context) error {
+	if s.Profile.TargetHealthInterval <= 0 {
+		return errors.New("the dead link report requires the target health checks, which are disabled")
+	}
+	reporter := cron.New()
+	if err := reporter.Add("deadLinkReport", s.Profile.DeadLinkReportSchedule, func() {
+		if err := s.apiV1Service.SendDeadLinkReport(ctx); err != nil {
+			slog.Error("failed to send dead link report", "error", err)
+		}
+	}); err != nil {
+		return errors.Wrap(err, "invalid dead link report schedule")
+	}
+	reporter.Start()
+	go func() {
+		<-ctx.Done()
+		reporter.Stop()
+	}()
+	return nil
+}
+
+// newSlugGenerator returns the generator of the profile, nil when names are required. The increment
+// generator continues after the number of shortcuts, so that it rarely starts on taken names.
+func (s *Server) newSlugGenerator(ctx context.Context) (slug.Generator, error) {
+	if s.Profile.SlugGenerator == "" {
+		return nil, nil
+	}
+	count, err := s.Store.CountShortcuts(ctx, &store.FindShortcut{})
+	if err != nil {
+		return nil, err
+	}
+	return slug.New(s.Profile.SlugGenerator, slug.NewSource(time.Now().UnixNano()), int64(count)+1)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 )
 
@@ -12,6 +13,8 @@ const (
 	ActivityShortcutCreate ActivityType = "shortcut.create"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
+	// ActivityShortcutConversion is the activity type of a conversion of the goal of a shortcut.
+	ActivityShortcutConversion ActivityType = "shortcut.conversion"
 )
 
 func (t ActivityType) String() string {
@@ -20,6 +23,8 @@ func (t ActivityType) String() string {
 		return "shortcut.create"
 	case ActivityShortcutView:
 		return "shortcut.view"
+	case ActivityShortcutConversion:
+		return "shortcut.conversion"
 	}
 	return ""
 }
@@ -60,28 +65,41 @@ type FindActivity struct {
 	Type  ActivityType
 	Level ActivityLevel
 	Where []string
+
+	// CreatedTsAfter matches the activities created at or after the unix timestamp.
+	CreatedTsAfter *int64
+	// CreatedTsBefore matches the activities created before the unix timestamp.
+	CreatedTsBefore *int64
+	// ShortcutCreatorID matches the activities of the shortcuts created by the user.
+	ShortcutCreatorID *int32
+	// IDAfter matches the activities with an id greater than it, which were created after it.
+	IDAfter *int32
+	// Goal matches the conversions of the goal.
+	Goal *string
 }
 
+// CreateActivity creates the activity, at the current time unless its CreatedTs is set.
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	placeholder := []string{"?", "?", "?", "?"}
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
+
 	stmt := `
 		INSERT INTO activity (
-			creator_id,
-			type,
-			level,
-			payload
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (` + strings.Join(placeholder, ", ") + `)
 		RETURNING id, created_ts
 	`
-	if err := s.db.QueryRowContext(ctx, stmt,
-		create.CreatorID,
-		create.Type.String(),
-		create.Level.String(),
-		create.Payload,
-	).Scan(
-		&create.ID,
-		&create.CreatedTs,
-	); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt, args...).Scan(
+			&create.ID,
+			&create.CreatedTs,
+		)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -90,6 +108,20 @@ func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity
 }
 
 func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error) {
+	list := []*Activity{}
+	if err := s.StreamActivities(ctx, find, func(activity *Activity) error {
+		list = append(list, activity)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// StreamActivities calls fn with the activities matching the find conditions in the order they were created,
+// without loading them all in memory. It stops at the first error returned by fn, and once the context is
+// done, such as when the client of a streamed export disconnects.
+func (s *Store) StreamActivities(ctx context.Context, find *FindActivity, fn func(*Activity) error) error {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.Type != "" {
 		where, args = append(where, "type = ?"), append(args, find.Type.String())
@@ -97,6 +129,21 @@ func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Acti
 	if find.Level != "" {
 		where, args = append(where, "level = ?"), append(args, find.Level.String())
 	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *v)
+	}
+	if v := find.ShortcutCreatorID; v != nil {
+		where, args = append(where, "CAST(json_extract(payload, '$.shortcutId') AS INTEGER) IN (SELECT id FROM shortcut WHERE creator_id = ?)"), append(args, *v)
+	}
+	if v := find.IDAfter; v != nil {
+		where, args = append(where, "id > ?"), append(args, *v)
+	}
+	if v := find.Goal; v != nil {
+		where, args = append(where, "json_extract(payload, '$.goal') = ?"), append(args, *v)
+	}
 	if find.Where != nil {
 		where = append(where, find.Where...)
 	}
@@ -110,15 +157,18 @@ func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Acti
 			level,
 			payload
 		FROM activity
-		WHERE ` + strings.Join(where, " AND ")
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, id ASC`
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	list := []*Activity{}
 	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		activity := &Activity{}
 		if err := rows.Scan(
 			&activity.ID,
@@ -128,17 +178,15 @@ func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Acti
 			&activity.Level,
 			&activity.Payload,
 		); err != nil {
-			return nil, err
+			return err
 		}
 
-		list = append(list, activity)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
+		if err := fn(activity); err != nil {
+			return err
+		}
 	}
 
-	return list, nil
+	return rows.Err()
 }
 
 func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity, error) {
@@ -154,3 +202,25 @@ func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity,
 	activity := list[0]
 	return activity, nil
 }
+
+// vacuumActivity deletes the shortcut activities whose shortcut no longer exists and returns the number of deleted rows.
+func vacuumActivity(ctx context.Context, tx *sql.Tx) (int64, error) {
+	stmt := `
+	DELETE FROM 
+		activity 
+	WHERE 
+		type IN (?, ?)
+		AND json_valid(payload)
+		AND CAST(json_extract(payload, '$.shortcutId') AS INTEGER) NOT IN (
+			SELECT 
+				id 
+			FROM 
+				shortcut
+		)`
+	result, err := tx.ExecContext(ctx, stmt, ActivityShortcutCreate.String(), ActivityShortcutView.String())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
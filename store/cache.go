@@ -1,7 +1,18 @@
 package store
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 func getUserSettingCacheKey(userID int32, key string) string {
 	return fmt.Sprintf("%d-%s", userID, key)
 }
+
+// clearCache drops every entry of the cache.
+func clearCache(cache *sync.Map) {
+	cache.Range(func(key, _ any) bool {
+		cache.Delete(key)
+		return true
+	})
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,13 +15,14 @@ import (
 type UpdateCollection struct {
 	ID int32
 
-	RowStatus   *RowStatus
-	Name        *string
-	Link        *string
-	Title       *string
-	Description *string
-	ShortcutIDs []int32
-	Visibility  *Visibility
+	RowStatus     *RowStatus
+	Name          *string
+	Link          *string
+	Title         *string
+	Description   *string
+	ShortcutIDs   []int32
+	Visibility    *Visibility
+	LinkDecorator *storepb.LinkDecorator
 }
 
 type FindCollection struct {
@@ -28,6 +30,8 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []Visibility
+	// ShortcutID finds the collections containing the shortcut.
+	ShortcutID *int32
 }
 
 type DeleteCollection struct {
@@ -38,6 +42,9 @@ func (s *Store) CreateCollection(ctx context.Context, create *storepb.Collection
 	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
 	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String()}
 	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	if create.LinkDecorator != nil {
+		set, args, placeholder = append(set, "link_decorator"), append(args, encodeLinkDecorator(create.LinkDecorator)), append(placeholder, "?")
+	}
 
 	stmt := `
 		INSERT INTO collection (
@@ -46,13 +53,18 @@ func (s *Store) CreateCollection(ctx context.Context, create *storepb.Collection
 		VALUES (` + strings.Join(placeholder, ",") + `)
 		RETURNING id, created_ts, updated_ts
 	`
-	if err := s.db.QueryRowContext(ctx, stmt, args...).Scan(
-		&create.Id,
-		&create.CreatedTs,
-		&create.UpdatedTs,
-	); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt, args...).Scan(
+			&create.Id,
+			&create.CreatedTs,
+			&create.UpdatedTs,
+		)
+	}); err != nil {
 		return nil, err
 	}
+	if create.LinkDecorator == nil {
+		create.LinkDecorator = &storepb.LinkDecorator{}
+	}
 	collection := create
 	return collection, nil
 }
@@ -74,6 +86,9 @@ func (s *Store) UpdateCollection(ctx context.Context, update *UpdateCollection)
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
+	if update.LinkDecorator != nil {
+		set, args = append(set, "link_decorator = ?"), append(args, encodeLinkDecorator(update.LinkDecorator))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -85,21 +100,24 @@ func (s *Store) UpdateCollection(ctx context.Context, update *UpdateCollection)
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, link_decorator
 	`
 	collection := &storepb.Collection{}
-	var shortcutIDs, visibility string
-	if err := s.db.QueryRowContext(ctx, stmt, args...).Scan(
-		&collection.Id,
-		&collection.CreatorId,
-		&collection.CreatedTs,
-		&collection.UpdatedTs,
-		&collection.Name,
-		&collection.Title,
-		&collection.Description,
-		&shortcutIDs,
-		&visibility,
-	); err != nil {
+	var shortcutIDs, visibility, linkDecorator string
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt, args...).Scan(
+			&collection.Id,
+			&collection.CreatorId,
+			&collection.CreatedTs,
+			&collection.UpdatedTs,
+			&collection.Name,
+			&collection.Title,
+			&collection.Description,
+			&shortcutIDs,
+			&visibility,
+			&linkDecorator,
+		)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -114,6 +132,11 @@ func (s *Store) UpdateCollection(ctx context.Context, update *UpdateCollection)
 		}
 	}
 	collection.Visibility = convertVisibilityStringToStorepb(visibility)
+	linkDecoratorMessage, err := decodeLinkDecorator(linkDecorator)
+	if err != nil {
+		return nil, err
+	}
+	collection.LinkDecorator = linkDecoratorMessage
 	return collection, nil
 }
 
@@ -136,6 +159,9 @@ func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*s
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "(',' || shortcut_ids || ',') LIKE ?"), append(args, fmt.Sprintf("%%,%d,%%", *v))
+	}
 
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT
@@ -147,7 +173,8 @@ func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*s
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			link_decorator
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -161,7 +188,7 @@ func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*s
 	list := make([]*storepb.Collection, 0)
 	for rows.Next() {
 		collection := &storepb.Collection{}
-		var shortcutIDs, visibility string
+		var shortcutIDs, visibility, linkDecorator string
 		if err := rows.Scan(
 			&collection.Id,
 			&collection.CreatorId,
@@ -172,6 +199,7 @@ func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*s
 			&collection.Description,
 			&shortcutIDs,
 			&visibility,
+			&linkDecorator,
 		); err != nil {
 			return nil, err
 		}
@@ -187,6 +215,10 @@ func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*s
 			}
 		}
 		collection.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
+		collection.LinkDecorator, err = decodeLinkDecorator(linkDecorator)
+		if err != nil {
+			return nil, err
+		}
 		list = append(list, collection)
 	}
 
@@ -211,9 +243,36 @@ func (s *Store) GetCollection(ctx context.Context, find *FindCollection) (*store
 }
 
 func (s *Store) DeleteCollection(ctx context.Context, delete *DeleteCollection) error {
-	if _, err := s.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID)
+		return err
+	}); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// encodeLinkDecorator encodes the params of the link decorator as a query string.
+func encodeLinkDecorator(linkDecorator *storepb.LinkDecorator) string {
+	values := url.Values{}
+	for key, value := range linkDecorator.Params {
+		values.Set(key, value)
+	}
+	return values.Encode()
+}
+
+func decodeLinkDecorator(linkDecorator string) (*storepb.LinkDecorator, error) {
+	values, err := url.ParseQuery(linkDecorator)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse link decorator")
+	}
+	decoded := &storepb.LinkDecorator{}
+	for key := range values {
+		if decoded.Params == nil {
+			decoded.Params = map[string]string{}
+		}
+		decoded.Params[key] = values.Get(key)
+	}
+	return decoded, nil
+}
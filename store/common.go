@@ -1,6 +1,8 @@
 package store
 
 import (
+	"strings"
+
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
@@ -57,3 +59,8 @@ func (e Visibility) String() string {
 	}
 	return "PRIVATE"
 }
+
+// escapeLikePattern escapes the wildcard characters in a LIKE pattern with backslashes.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// dataMigrations are the migrations of minor versions applied after their migration files, for the
+// changes of the data that depend on the profile.
+var dataMigrations = map[string]func(ctx context.Context, db *DB) error{
+	"0.6": normalizeShortcutTags,
+}
+
+// normalizeShortcutTags removes the duplicate tags of shortcuts, keeping the first occurrence, and
+// lowercases them unless the profile preserves the tag case.
+func normalizeShortcutTags(ctx context.Context, db *DB) error {
+	rows, err := db.DBInstance.QueryContext(ctx, "SELECT id, tag FROM shortcut")
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcut tags")
+	}
+	defer rows.Close()
+	updates := map[int32]string{}
+	for rows.Next() {
+		var id int32
+		var tag string
+		if err := rows.Scan(&id, &tag); err != nil {
+			return errors.Wrap(err, "failed to scan shortcut tags")
+		}
+		tags := []string{}
+		for _, word := range strings.Fields(tag) {
+			if !db.profile.PreserveTagCase {
+				word = strings.ToLower(word)
+			}
+			if !slices.Contains(tags, word) {
+				tags = append(tags, word)
+			}
+		}
+		if normalized := strings.Join(tags, " "); normalized != tag {
+			updates[id] = normalized
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to list shortcut tags")
+	}
+	rows.Close()
+
+	for id, tag := range updates {
+		if _, err := db.DBInstance.ExecContext(ctx, "UPDATE shortcut SET tag = ? WHERE id = ?", tag, id); err != nil {
+			return errors.Wrapf(err, "failed to update the tags of shortcut %d", id)
+		}
+	}
+	return nil
+}
@@ -38,7 +38,8 @@ func NewDB(profile *profile.Profile) *DB {
 	return db
 }
 
-func (db *DB) Open(ctx context.Context) (err error) {
+// Connect opens the connection to the database without applying any migration.
+func (db *DB) Connect() error {
 	// Ensure a DSN is set before attempting to open the database.
 	if db.profile.DSN == "" {
 		return errors.New("dsn required")
@@ -63,7 +64,53 @@ func (db *DB) Open(ctx context.Context) (err error) {
 		return errors.Wrapf(err, "failed to open db with dsn: %s", db.profile.DSN)
 	}
 	db.DBInstance = sqliteDB
+	return nil
+}
+
+// OpenReadOnly opens the existing database read-only, without creating, migrating or seeding it, so
+// that the database of another instance is read as it is. In prod mode, the database must have been
+// migrated to the schema of this version, as its queries are this version's.
+func (db *DB) OpenReadOnly(ctx context.Context) error {
+	if db.profile.DSN == "" {
+		return errors.New("dsn required")
+	}
+	if _, err := os.Stat(db.profile.DSN); err != nil {
+		return errors.Wrap(err, "failed to get db file stat")
+	}
+	sqliteDB, err := sql.Open("sqlite", "file:"+db.profile.DSN+"?mode=ro&_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)")
+	if err != nil {
+		return errors.Wrapf(err, "failed to open db with dsn: %s", db.profile.DSN)
+	}
+	db.DBInstance = sqliteDB
+	if db.profile.Mode != "prod" {
+		return nil
+	}
+
+	migrationHistoryList, err := db.FindMigrationHistoryList(ctx, &MigrationHistoryFind{})
+	if err != nil {
+		return errors.Wrap(err, "failed to find migration history")
+	}
+	migrationHistoryVersionList := []string{}
+	for _, migrationHistory := range migrationHistoryList {
+		migrationHistoryVersionList = append(migrationHistoryVersionList, migrationHistory.Version)
+	}
+	sort.Sort(version.SortVersion(migrationHistoryVersionList))
+	schemaVersion := version.GetSchemaVersion(version.GetCurrentVersion(db.profile.Mode))
+	if len(migrationHistoryVersionList) == 0 || version.IsVersionGreaterThan(schemaVersion, migrationHistoryVersionList[len(migrationHistoryVersionList)-1]) {
+		return errors.Errorf("the database %s must be migrated to the schema %s before it is read, by running this version on it once", db.profile.DSN, schemaVersion)
+	}
+	return nil
+}
+
+func (db *DB) Open(ctx context.Context) (err error) {
+	if err := db.Connect(); err != nil {
+		return err
+	}
 	currentVersion := version.GetCurrentVersion(db.profile.Mode)
+	// A broken migration layout would silently skip migrations, so it fails the startup instead.
+	if err := VerifyEmbeddedMigrations(); err != nil {
+		return errors.Wrap(err, "invalid embedded migrations")
+	}
 
 	if db.profile.Mode == "prod" {
 		_, err := os.Stat(db.profile.DSN)
@@ -72,20 +119,32 @@ func (db *DB) Open(ctx context.Context) (err error) {
 				return errors.Wrap(err, "failed to get db file stat")
 			}
 
-			// If db file not exists, we should create a new one with latest schema.
-			err := db.applyLatestSchema(ctx)
-			if err != nil {
-				return errors.Wrap(err, "failed to apply latest schema")
+			// The seed file is read first, so that a missing one does not leave an initialized database behind.
+			var seedStmt string
+			if db.profile.Seed != "" {
+				buf, err := os.ReadFile(db.profile.Seed)
+				if err != nil {
+					return errors.Wrap(err, "failed to read seed file")
+				}
+				seedStmt = string(buf)
 			}
-			_, err = db.UpsertMigrationHistory(ctx, &MigrationHistoryUpsert{
-				Version: currentVersion,
-			})
-			if err != nil {
-				return errors.Wrap(err, "failed to upsert migration history")
+
+			// A database initialized halfway would be taken for an existing one by the next startup,
+			// so it is removed when the initialization fails.
+			if err := db.initialize(ctx, currentVersion, seedStmt); err != nil {
+				if removeErr := db.remove(); removeErr != nil {
+					slog.Log(ctx, slog.LevelError, "failed to remove the partially initialized database", "error", removeErr)
+				}
+				return err
 			}
 			return nil
 		}
 
+		// Seeding an existing database would mix the seed with its data, or fail halfway on conflicts.
+		if db.profile.Seed != "" {
+			slog.Log(ctx, slog.LevelWarn, "the seed file is only applied to a new database, skipping it", "file", db.profile.Seed, "dsn", db.profile.DSN)
+		}
+
 		// If db file exists, we should check if we need to migrate the database.
 		migrationHistoryList, err := db.FindMigrationHistoryList(ctx, &MigrationHistoryFind{})
 		if err != nil {
@@ -109,7 +168,7 @@ func (db *DB) Open(ctx context.Context) (err error) {
 		latestMigrationHistoryVersion := migrationHistoryVersionList[len(migrationHistoryVersionList)-1]
 
 		if version.IsVersionGreaterThan(version.GetSchemaVersion(currentVersion), latestMigrationHistoryVersion) {
-			minorVersionList := getMinorVersionList()
+			minorVersionList := getMinorVersionList(migrationFS)
 
 			// backup the raw database file before migration
 			rawBytes, err := os.ReadFile(db.profile.DSN)
@@ -147,7 +206,7 @@ func (db *DB) Open(ctx context.Context) (err error) {
 			}
 			// In demo mode, we should seed the database.
 			if db.profile.Mode == "demo" {
-				if err := db.seed(ctx); err != nil {
+				if err := db.seed(ctx, demoSeedPattern); err != nil {
 					return errors.Wrap(err, "failed to seed")
 				}
 			}
@@ -157,8 +216,50 @@ func (db *DB) Open(ctx context.Context) (err error) {
 	return nil
 }
 
+// initialize creates the new prod database with the latest schema, the welcome shortcuts and the seed file.
+func (db *DB) initialize(ctx context.Context, currentVersion, seedStmt string) error {
+	if err := db.applyLatestSchema(ctx); err != nil {
+		return errors.Wrap(err, "failed to apply latest schema")
+	}
+	if _, err := db.UpsertMigrationHistory(ctx, &MigrationHistoryUpsert{
+		Version: currentVersion,
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert migration history")
+	}
+	// With the welcome option, we should seed the new database with the welcome shortcuts.
+	if db.profile.Welcome {
+		if err := db.seed(ctx, welcomeSeedPattern); err != nil {
+			return errors.Wrap(err, "failed to seed welcome shortcuts")
+		}
+	}
+	if seedStmt != "" {
+		if err := db.execute(ctx, seedStmt); err != nil {
+			return errors.Wrapf(err, "seed error: file %s", db.profile.Seed)
+		}
+		slog.Log(ctx, slog.LevelInfo, "seeded the new database", "file", db.profile.Seed)
+	}
+	return nil
+}
+
+// remove closes the database and removes its files.
+func (db *DB) remove() error {
+	if err := db.DBInstance.Close(); err != nil {
+		return errors.Wrap(err, "failed to close db")
+	}
+	for _, path := range []string{db.profile.DSN, db.profile.DSN + "-wal", db.profile.DSN + "-shm"} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Wrapf(err, "failed to remove %s", path)
+		}
+	}
+	return nil
+}
+
 const (
 	latestSchemaFileName = "LATEST__SCHEMA.sql"
+	// demoSeedPattern matches the seed files of the demo data.
+	demoSeedPattern = "seed/*.sql"
+	// welcomeSeedPattern matches the seed files of the welcome shortcuts of new prod instances.
+	welcomeSeedPattern = "seed/welcome/*.sql"
 )
 
 func (db *DB) applyLatestSchema(ctx context.Context) error {
@@ -200,6 +301,12 @@ func (db *DB) applyMigrationForMinorVersion(ctx context.Context, minorVersion st
 		}
 	}
 
+	if dataMigration, ok := dataMigrations[minorVersion]; ok {
+		if err := dataMigration(ctx, db); err != nil {
+			return errors.Wrapf(err, "data migration error: version %s", minorVersion)
+		}
+	}
+
 	// Upsert the newest version to migration_history.
 	version := minorVersion + ".0"
 	if _, err = db.UpsertMigrationHistory(ctx, &MigrationHistoryUpsert{
@@ -211,8 +318,8 @@ func (db *DB) applyMigrationForMinorVersion(ctx context.Context, minorVersion st
 	return nil
 }
 
-func (db *DB) seed(ctx context.Context) error {
-	filenames, err := fs.Glob(seedFS, "seed/*.sql")
+func (db *DB) seed(ctx context.Context, pattern string) error {
+	filenames, err := fs.Glob(seedFS, pattern)
 	if err != nil {
 		return errors.Wrap(err, "failed to read seed files")
 	}
@@ -244,10 +351,10 @@ func (db *DB) execute(ctx context.Context, stmt string) error {
 // minorDirRegexp is a regular expression for minor version directory.
 var minorDirRegexp = regexp.MustCompile(`^migration/prod/[0-9]+\.[0-9]+$`)
 
-func getMinorVersionList() []string {
+func getMinorVersionList(fsys fs.FS) []string {
 	minorVersionList := []string{}
 
-	if err := fs.WalkDir(migrationFS, "migration", func(path string, file fs.DirEntry, err error) error {
+	if err := fs.WalkDir(fsys, "migration", func(path string, file fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
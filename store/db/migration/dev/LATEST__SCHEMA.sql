@@ -45,11 +45,35 @@ CREATE TABLE shortcut (
   description TEXT NOT NULL DEFAULT '',
   visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  enabled INTEGER NOT NULL DEFAULT 1,
+  approval_status TEXT NOT NULL CHECK (approval_status IN ('APPROVED', 'PENDING')) DEFAULT 'APPROVED',
+  link_decorator_disabled INTEGER NOT NULL DEFAULT 0,
+  no_cache INTEGER NOT NULL DEFAULT 0,
+  interstitial INTEGER NOT NULL DEFAULT 0,
+  targets TEXT NOT NULL DEFAULT '{}',
+  disable_analytics INTEGER NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  referer_policy TEXT NOT NULL DEFAULT '{}',
+  redirect_delay_ms INTEGER NOT NULL DEFAULT 0,
+  goal TEXT NOT NULL DEFAULT '',
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0,
+  archived_ts BIGINT NOT NULL DEFAULT 0,
+  name_skeleton TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE INDEX idx_shortcut_name_skeleton ON shortcut(name_skeleton);
+
+-- shortcut_collaborator
+CREATE TABLE shortcut_collaborator (
+  shortcut_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(shortcut_id, user_id)
+);
+
 -- activity
 CREATE TABLE activity (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -70,7 +94,34 @@ CREATE TABLE collection (
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER[] NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  link_decorator TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+-- import_session
+CREATE TABLE import_session (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('OPEN', 'COMMITTED')) DEFAULT 'OPEN',
+  result TEXT NOT NULL DEFAULT '{}'
+);
+
+-- import_batch
+CREATE TABLE import_batch (
+  session_id INTEGER NOT NULL,
+  seq INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_count INTEGER NOT NULL,
+  payload TEXT NOT NULL,
+  UNIQUE(session_id, seq)
+);
+
+-- shortcut_tombstone
+CREATE TABLE shortcut_tombstone (
+  name TEXT NOT NULL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN enabled INTEGER NOT NULL DEFAULT 1;
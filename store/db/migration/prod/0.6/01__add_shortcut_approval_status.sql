@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN approval_status TEXT NOT NULL CHECK (approval_status IN ('APPROVED', 'PENDING')) DEFAULT 'APPROVED';
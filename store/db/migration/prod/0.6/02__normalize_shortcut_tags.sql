@@ -0,0 +1,2 @@
+-- The tags of shortcuts are normalized by the data migration of 0.6, which keeps their case
+-- when the profile preserves the tag case.
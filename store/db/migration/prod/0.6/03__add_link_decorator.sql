@@ -0,0 +1,3 @@
+ALTER TABLE shortcut ADD COLUMN link_decorator_disabled INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE collection ADD COLUMN link_decorator TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN no_cache INTEGER NOT NULL DEFAULT 0;
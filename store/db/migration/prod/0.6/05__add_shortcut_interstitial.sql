@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN interstitial INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN targets TEXT NOT NULL DEFAULT '{}';
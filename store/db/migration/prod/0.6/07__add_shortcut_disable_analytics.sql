@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN disable_analytics INTEGER NOT NULL DEFAULT 0;
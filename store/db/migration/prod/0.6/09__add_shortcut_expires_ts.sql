@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN expires_ts BIGINT NOT NULL DEFAULT 0;
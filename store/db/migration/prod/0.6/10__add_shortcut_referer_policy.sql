@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN referer_policy TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,19 @@
+-- import_session
+CREATE TABLE import_session (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('OPEN', 'COMMITTED')) DEFAULT 'OPEN',
+  result TEXT NOT NULL DEFAULT '{}'
+);
+
+-- import_batch
+CREATE TABLE import_batch (
+  session_id INTEGER NOT NULL,
+  seq INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_count INTEGER NOT NULL,
+  payload TEXT NOT NULL,
+  UNIQUE(session_id, seq)
+);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN redirect_delay_ms INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN goal TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN last_viewed_ts BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+ALTER TABLE shortcut ADD COLUMN archived_ts BIGINT NOT NULL DEFAULT 0;
+
+-- The shortcuts archived before are counted as archived from the migration, their archive time being unknown.
+UPDATE shortcut SET archived_ts = strftime('%s', 'now') WHERE row_status = 'ARCHIVED';
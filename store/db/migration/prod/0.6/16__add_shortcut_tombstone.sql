@@ -0,0 +1,5 @@
+-- shortcut_tombstone
+CREATE TABLE shortcut_tombstone (
+  name TEXT NOT NULL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
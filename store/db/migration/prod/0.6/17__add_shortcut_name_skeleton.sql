@@ -0,0 +1,3 @@
+ALTER TABLE shortcut ADD COLUMN name_skeleton TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_shortcut_name_skeleton ON shortcut(name_skeleton);
@@ -2,7 +2,10 @@ package db
 
 import (
 	"context"
+	"sort"
 	"strings"
+
+	"github.com/yourselfhosted/slash/server/version"
 )
 
 type MigrationHistory struct {
@@ -80,3 +83,38 @@ func (db *DB) UpsertMigrationHistory(ctx context.Context, upsert *MigrationHisto
 
 	return migrationHistory, nil
 }
+
+// MigrationStatus is where the database schema stands.
+type MigrationStatus struct {
+	// History is the migration history ordered by version.
+	History []*MigrationHistory
+	// SchemaVersion is the latest migrated version of the database, empty if there is no migration history.
+	SchemaVersion string
+	// TargetSchemaVersion is the schema version of the current server version.
+	TargetSchemaVersion string
+}
+
+// IsPending returns whether the database is behind the schema version of the current server version.
+func (s *MigrationStatus) IsPending() bool {
+	return s.SchemaVersion == "" || version.IsVersionGreaterThan(s.TargetSchemaVersion, s.SchemaVersion)
+}
+
+// GetMigrationStatus returns the migration history and the schema version of the database.
+func (db *DB) GetMigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	migrationHistoryList, err := db.FindMigrationHistoryList(ctx, &MigrationHistoryFind{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(migrationHistoryList, func(i, j int) bool {
+		return version.IsVersionGreaterThan(migrationHistoryList[j].Version, migrationHistoryList[i].Version)
+	})
+
+	status := &MigrationStatus{
+		History:             migrationHistoryList,
+		TargetSchemaVersion: version.GetSchemaVersion(version.GetCurrentVersion(db.profile.Mode)),
+	}
+	if len(migrationHistoryList) > 0 {
+		status.SchemaVersion = migrationHistoryList[len(migrationHistoryList)-1].Version
+	}
+	return status, nil
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/version"
+)
+
+var (
+	// minorVersionRegexp matches the names of the minor version directories of the prod migrations.
+	minorVersionRegexp = regexp.MustCompile(`^([0-9]+)\.([0-9]+)$`)
+	// migrationFileRegexp matches the names of the migration files, which are applied in the order of their number.
+	migrationFileRegexp = regexp.MustCompile(`^([0-9]{2})__[a-z0-9_]+\.sql$`)
+)
+
+// VerifyEmbeddedMigrations verifies the layout of the migrations embedded in the binary.
+func VerifyEmbeddedMigrations() error {
+	return VerifyMigrations(migrationFS)
+}
+
+// VerifyMigrations verifies that the migrations of the file system are laid out as the migrator expects,
+// as a misnamed file or a missing version is otherwise silently skipped when upgrading:
+//   - both modes have a latest schema,
+//   - the prod migrations are in contiguous minor version directories, none newer than the current version,
+//   - each directory holds at least one migration file, numbered contiguously from 00.
+func VerifyMigrations(fsys fs.FS) error {
+	for _, mode := range []string{"dev", "prod"} {
+		latestSchemaPath := fmt.Sprintf("migration/%s/%s", mode, latestSchemaFileName)
+		if _, err := fs.Stat(fsys, latestSchemaPath); err != nil {
+			return errors.Wrapf(err, "missing latest schema %q", latestSchemaPath)
+		}
+	}
+
+	entries, err := fs.ReadDir(fsys, "migration/prod")
+	if err != nil {
+		return errors.Wrap(err, "failed to read prod migrations")
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			if entry.Name() != latestSchemaFileName {
+				return errors.Errorf("unexpected file %q in prod migrations, migrations belong in a minor version directory", entry.Name())
+			}
+			continue
+		}
+		if !minorVersionRegexp.MatchString(entry.Name()) {
+			return errors.Errorf("invalid minor version directory %q in prod migrations", entry.Name())
+		}
+		if err := verifyMinorVersionMigrations(fsys, path.Join("migration/prod", entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	// The migrator applies the minor versions in the order of this list.
+	minorVersionList := getMinorVersionList(fsys)
+	if len(minorVersionList) == 0 {
+		return errors.New("no minor version directory in prod migrations")
+	}
+	for i := 1; i < len(minorVersionList); i++ {
+		if !isNextMinorVersion(minorVersionList[i-1], minorVersionList[i]) {
+			return errors.Errorf("minor version %s does not follow %s in prod migrations", minorVersionList[i], minorVersionList[i-1])
+		}
+	}
+	latestMinorVersion := minorVersionList[len(minorVersionList)-1]
+	if version.IsVersionGreaterThan(latestMinorVersion+".0", version.GetSchemaVersion(version.Version)) {
+		return errors.Errorf("minor version %s of prod migrations is newer than version %s, so it would never be applied", latestMinorVersion, version.Version)
+	}
+	return nil
+}
+
+// verifyMinorVersionMigrations verifies the migration files of the minor version directory.
+func verifyMinorVersionMigrations(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read migrations of %s", dir)
+	}
+	if len(entries) == 0 {
+		return errors.Errorf("no migration file in %s", dir)
+	}
+	// The entries are sorted by name, which is the order the migrations are applied in.
+	for i, entry := range entries {
+		matches := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			return errors.Errorf("invalid migration file %q in %s, migration files are named like 00__description.sql", entry.Name(), dir)
+		}
+		if number, _ := strconv.Atoi(matches[1]); number != i {
+			return errors.Errorf("migration file %q in %s is numbered %02d, expected %02d", entry.Name(), dir, number, i)
+		}
+	}
+	return nil
+}
+
+// isNextMinorVersion returns whether next is the minor version released after previous, such as 0.6
+// after 0.5 or 1.0 after 0.6.
+func isNextMinorVersion(previous, next string) bool {
+	previousMajor, previousMinor := parseMinorVersion(previous)
+	nextMajor, nextMinor := parseMinorVersion(next)
+	if nextMajor == previousMajor {
+		return nextMinor == previousMinor+1
+	}
+	return nextMajor == previousMajor+1 && nextMinor == 0
+}
+
+func parseMinorVersion(minorVersion string) (int, int) {
+	majorString, minorString, _ := strings.Cut(minorVersion, ".")
+	major, _ := strconv.Atoi(majorString)
+	minor, _ := strconv.Atoi(minorString)
+	return major, minor
+}
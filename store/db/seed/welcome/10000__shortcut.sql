@@ -0,0 +1,38 @@
+-- The welcome shortcuts are owned by the first user, the admin signing up on the new instance.
+INSERT INTO
+  shortcut (
+    `creator_id`,
+    `name`,
+    `link`,
+    `title`,
+    `visibility`,
+    `tag`
+  )
+VALUES
+  (
+    1,
+    'slash',
+    'https://github.com/yourselfhosted/slash',
+    'Slash',
+    'PUBLIC',
+    'slash'
+  );
+
+INSERT INTO
+  shortcut (
+    `creator_id`,
+    `name`,
+    `link`,
+    `title`,
+    `visibility`,
+    `tag`
+  )
+VALUES
+  (
+    1,
+    'slash-docs',
+    'https://github.com/yourselfhosted/slash/tree/main/docs',
+    'Slash documentation',
+    'PUBLIC',
+    'slash'
+  );
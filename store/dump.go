@@ -0,0 +1,406 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DumpFormat is the format of the database dumps of slash.
+const DumpFormat = "slash"
+
+// ErrDumpConflict is returned when a dump cannot be imported without overwriting existing data.
+var ErrDumpConflict = errors.New("dump conflicts with existing data")
+
+// Dump is an export of the users, shortcuts, collaborators, collections and settings of a workspace.
+// Activities are not exported.
+type Dump struct {
+	Format            string                  `json:"format"`
+	Version           string                  `json:"version"`
+	CreatedTs         int64                   `json:"createdTs"`
+	WorkspaceSettings []*DumpWorkspaceSetting `json:"workspaceSettings"`
+	Users             []*DumpUser             `json:"users"`
+	UserSettings      []*DumpUserSetting      `json:"userSettings"`
+	Shortcuts         []*DumpShortcut         `json:"shortcuts"`
+	Collections       []*DumpCollection       `json:"collections"`
+	// ShortcutCollaborators is missing from the dumps of older versions.
+	ShortcutCollaborators []*DumpShortcutCollaborator `json:"shortcutCollaborators"`
+}
+
+// DumpWorkspaceSetting is a workspace setting row, with its value as stored.
+type DumpWorkspaceSetting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DumpUser is a user row.
+type DumpUser struct {
+	ID           int32  `json:"id"`
+	CreatedTs    int64  `json:"createdTs"`
+	UpdatedTs    int64  `json:"updatedTs"`
+	RowStatus    string `json:"rowStatus"`
+	Email        string `json:"email"`
+	Nickname     string `json:"nickname"`
+	PasswordHash string `json:"passwordHash"`
+	Role         string `json:"role"`
+}
+
+// DumpUserSetting is a user setting row, with its value as stored.
+type DumpUserSetting struct {
+	UserID int32  `json:"userId"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+}
+
+// DumpShortcut is a shortcut row.
+type DumpShortcut struct {
+	ID                    int32  `json:"id"`
+	CreatorID             int32  `json:"creatorId"`
+	CreatedTs             int64  `json:"createdTs"`
+	UpdatedTs             int64  `json:"updatedTs"`
+	RowStatus             string `json:"rowStatus"`
+	Name                  string `json:"name"`
+	Link                  string `json:"link"`
+	Title                 string `json:"title"`
+	Description           string `json:"description"`
+	Visibility            string `json:"visibility"`
+	Tag                   string `json:"tag"`
+	OgMetadata            string `json:"ogMetadata"`
+	Enabled               bool   `json:"enabled"`
+	ApprovalStatus        string `json:"approvalStatus"`
+	LinkDecoratorDisabled bool   `json:"linkDecoratorDisabled"`
+	NoCache               bool   `json:"noCache"`
+	Interstitial          bool   `json:"interstitial"`
+	DisableAnalytics      bool   `json:"disableAnalytics"`
+	RedirectDelayMs       int32  `json:"redirectDelayMs"`
+	Goal                  string `json:"goal"`
+	LastViewedTs          int64  `json:"lastViewedTs"`
+	ArchivedTs            int64  `json:"archivedTs"`
+	ExpiresTs             int64  `json:"expiresTs"`
+	Targets               string `json:"targets"`
+	RefererPolicy         string `json:"refererPolicy"`
+}
+
+// DumpCollection is a collection row.
+type DumpCollection struct {
+	ID            int32   `json:"id"`
+	CreatorID     int32   `json:"creatorId"`
+	CreatedTs     int64   `json:"createdTs"`
+	UpdatedTs     int64   `json:"updatedTs"`
+	Name          string  `json:"name"`
+	Title         string  `json:"title"`
+	Description   string  `json:"description"`
+	ShortcutIDs   []int32 `json:"shortcutIds"`
+	Visibility    string  `json:"visibility"`
+	LinkDecorator string  `json:"linkDecorator"`
+}
+
+// DumpShortcutCollaborator is a shortcut collaborator row.
+type DumpShortcutCollaborator struct {
+	ShortcutID int32 `json:"shortcutId"`
+	UserID     int32 `json:"userId"`
+	CreatedTs  int64 `json:"createdTs"`
+}
+
+// ImportResult is the number of imported rows, and of the rows whose ID was taken and had to be remapped.
+type ImportResult struct {
+	WorkspaceSettings int `json:"workspaceSettings"`
+	Users             int `json:"users"`
+	UserSettings      int `json:"userSettings"`
+	Shortcuts         int `json:"shortcuts"`
+	Collections       int `json:"collections"`
+	// ShortcutCollaborators is the number of imported collaborators, whose shortcut and user are remapped.
+	ShortcutCollaborators int `json:"shortcutCollaborators"`
+	RemappedUsers         int `json:"remappedUsers"`
+	RemappedShortcuts     int `json:"remappedShortcuts"`
+}
+
+// Export returns a dump of the workspace.
+func (s *Store) Export(ctx context.Context) (*Dump, error) {
+	dump := &Dump{
+		Format:            DumpFormat,
+		Version:           s.profile.Version,
+		CreatedTs:         time.Now().Unix(),
+		WorkspaceSettings: []*DumpWorkspaceSetting{},
+		Users:             []*DumpUser{},
+		UserSettings:      []*DumpUserSetting{},
+		Shortcuts:         []*DumpShortcut{},
+		Collections:       []*DumpCollection{},
+
+		ShortcutCollaborators: []*DumpShortcutCollaborator{},
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := queryRows(ctx, tx, `SELECT key, value FROM workspace_setting ORDER BY key`, func(rows *sql.Rows) error {
+		setting := &DumpWorkspaceSetting{}
+		dump.WorkspaceSettings = append(dump.WorkspaceSettings, setting)
+		return rows.Scan(&setting.Key, &setting.Value)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export workspace settings")
+	}
+	if err := queryRows(ctx, tx, `SELECT id, created_ts, updated_ts, row_status, email, nickname, password_hash, role FROM user ORDER BY id`, func(rows *sql.Rows) error {
+		user := &DumpUser{}
+		dump.Users = append(dump.Users, user)
+		return rows.Scan(&user.ID, &user.CreatedTs, &user.UpdatedTs, &user.RowStatus, &user.Email, &user.Nickname, &user.PasswordHash, &user.Role)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export users")
+	}
+	if err := queryRows(ctx, tx, `SELECT user_id, key, value FROM user_setting ORDER BY user_id, key`, func(rows *sql.Rows) error {
+		setting := &DumpUserSetting{}
+		dump.UserSettings = append(dump.UserSettings, setting)
+		return rows.Scan(&setting.UserID, &setting.Key, &setting.Value)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export user settings")
+	}
+	if err := queryRows(ctx, tx, `SELECT id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, enabled, approval_status, link_decorator_disabled, no_cache, interstitial, targets, disable_analytics, expires_ts, referer_policy, redirect_delay_ms, goal, last_viewed_ts, archived_ts FROM shortcut ORDER BY id`, func(rows *sql.Rows) error {
+		shortcut := &DumpShortcut{}
+		dump.Shortcuts = append(dump.Shortcuts, shortcut)
+		return rows.Scan(&shortcut.ID, &shortcut.CreatorID, &shortcut.CreatedTs, &shortcut.UpdatedTs, &shortcut.RowStatus, &shortcut.Name, &shortcut.Link, &shortcut.Title, &shortcut.Description, &shortcut.Visibility, &shortcut.Tag, &shortcut.OgMetadata, &shortcut.Enabled, &shortcut.ApprovalStatus, &shortcut.LinkDecoratorDisabled, &shortcut.NoCache, &shortcut.Interstitial, &shortcut.Targets, &shortcut.DisableAnalytics, &shortcut.ExpiresTs, &shortcut.RefererPolicy, &shortcut.RedirectDelayMs, &shortcut.Goal, &shortcut.LastViewedTs, &shortcut.ArchivedTs)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export shortcuts")
+	}
+	if err := queryRows(ctx, tx, `SELECT id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, link_decorator FROM collection ORDER BY id`, func(rows *sql.Rows) error {
+		collection := &DumpCollection{}
+		dump.Collections = append(dump.Collections, collection)
+		var shortcutIDs string
+		if err := rows.Scan(&collection.ID, &collection.CreatorID, &collection.CreatedTs, &collection.UpdatedTs, &collection.Name, &collection.Title, &collection.Description, &shortcutIDs, &collection.Visibility, &collection.LinkDecorator); err != nil {
+			return err
+		}
+		collection.ShortcutIDs = []int32{}
+		for _, idString := range strings.Split(shortcutIDs, ",") {
+			if idString == "" {
+				continue
+			}
+			id, err := strconv.Atoi(idString)
+			if err != nil {
+				return err
+			}
+			collection.ShortcutIDs = append(collection.ShortcutIDs, int32(id))
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export collections")
+	}
+	if err := queryRows(ctx, tx, `SELECT shortcut_id, user_id, created_ts FROM shortcut_collaborator ORDER BY shortcut_id, user_id`, func(rows *sql.Rows) error {
+		collaborator := &DumpShortcutCollaborator{}
+		dump.ShortcutCollaborators = append(dump.ShortcutCollaborators, collaborator)
+		return rows.Scan(&collaborator.ShortcutID, &collaborator.UserID, &collaborator.CreatedTs)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to export shortcut collaborators")
+	}
+	return dump, nil
+}
+
+// Import restores the dump within a transaction. Rows keep their IDs when they are free, and are
+// given new IDs otherwise, with the references to them remapped. Users whose email already exists
+// are merged into the existing users, while existing shortcut and collection names are conflicts.
+// Workspace and user settings overwrite the existing ones.
+func (s *Store) Import(ctx context.Context, dump *Dump) (*ImportResult, error) {
+	if dump.Format != DumpFormat {
+		return nil, errors.Errorf("unsupported dump format %q", dump.Format)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := importDump(ctx, tx, dump)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	// The imported rows replace whatever the caches hold.
+	s.clearCaches()
+	return result, nil
+}
+
+// importDump inserts the rows of the dump within the transaction.
+func importDump(ctx context.Context, tx *sql.Tx, dump *Dump) (*ImportResult, error) {
+	result := &ImportResult{}
+	for _, setting := range dump.WorkspaceSettings {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO workspace_setting (key, value) VALUES (?, ?)
+			ON CONFLICT(key) DO UPDATE SET value = EXCLUDED.value
+		`, setting.Key, setting.Value); err != nil {
+			return nil, errors.Wrapf(err, "failed to import workspace setting %s", setting.Key)
+		}
+		result.WorkspaceSettings++
+	}
+
+	userIDMap := map[int32]int32{}
+	for _, user := range dump.Users {
+		var existingID int32
+		if err := tx.QueryRowContext(ctx, `SELECT id FROM user WHERE email = ?`, user.Email).Scan(&existingID); err == nil {
+			userIDMap[user.ID] = existingID
+			if existingID != user.ID {
+				result.RemappedUsers++
+			}
+			continue
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		id, err := insertDumpRow(ctx, tx, "user", user.ID,
+			[]string{"created_ts", "updated_ts", "row_status", "email", "nickname", "password_hash", "role"},
+			[]any{user.CreatedTs, user.UpdatedTs, user.RowStatus, user.Email, user.Nickname, user.PasswordHash, user.Role})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import user %s", user.Email)
+		}
+		userIDMap[user.ID] = id
+		if id != user.ID {
+			result.RemappedUsers++
+		}
+		result.Users++
+	}
+	remapUserID := func(id int32) int32 {
+		if newID, ok := userIDMap[id]; ok {
+			return newID
+		}
+		return id
+	}
+
+	for _, setting := range dump.UserSettings {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO user_setting (user_id, key, value) VALUES (?, ?, ?)
+			ON CONFLICT(user_id, key) DO UPDATE SET value = EXCLUDED.value
+		`, remapUserID(setting.UserID), setting.Key, setting.Value); err != nil {
+			return nil, errors.Wrapf(err, "failed to import user setting %s", setting.Key)
+		}
+		result.UserSettings++
+	}
+
+	shortcutIDMap := map[int32]int32{}
+	for _, shortcut := range dump.Shortcuts {
+		if exists, err := rowExists(ctx, tx, `SELECT 1 FROM shortcut WHERE name = ?`, shortcut.Name); err != nil {
+			return nil, err
+		} else if exists {
+			return nil, errors.Wrapf(ErrDumpConflict, "shortcut %s already exists", shortcut.Name)
+		}
+		// The dumps of older versions have no targets nor referer policy, and the hand-written dumps
+		// of chunked imports may have no open graph metadata.
+		ogMetadata := shortcut.OgMetadata
+		if ogMetadata == "" {
+			ogMetadata = "{}"
+		}
+		targets := shortcut.Targets
+		if targets == "" {
+			targets = "{}"
+		}
+		refererPolicy := shortcut.RefererPolicy
+		if refererPolicy == "" {
+			refererPolicy = "{}"
+		}
+		// The archived shortcuts of older dumps are counted as archived from the import, as by the migration.
+		archivedTs := shortcut.ArchivedTs
+		if shortcut.RowStatus == Archived.String() && archivedTs == 0 {
+			archivedTs = time.Now().Unix()
+		}
+		id, err := insertDumpRow(ctx, tx, "shortcut", shortcut.ID,
+			[]string{"creator_id", "created_ts", "updated_ts", "row_status", "name", "link", "title", "description", "visibility", "tag", "og_metadata", "enabled", "approval_status", "link_decorator_disabled", "no_cache", "interstitial", "targets", "disable_analytics", "expires_ts", "referer_policy", "redirect_delay_ms", "goal", "last_viewed_ts", "archived_ts", "name_skeleton"},
+			[]any{remapUserID(shortcut.CreatorID), shortcut.CreatedTs, shortcut.UpdatedTs, shortcut.RowStatus, shortcut.Name, shortcut.Link, shortcut.Title, shortcut.Description, shortcut.Visibility, shortcut.Tag, ogMetadata, shortcut.Enabled, shortcut.ApprovalStatus, shortcut.LinkDecoratorDisabled, shortcut.NoCache, shortcut.Interstitial, targets, shortcut.DisableAnalytics, shortcut.ExpiresTs, refererPolicy, shortcut.RedirectDelayMs, shortcut.Goal, shortcut.LastViewedTs, archivedTs, getNameSkeleton(shortcut.Name)})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import shortcut %s", shortcut.Name)
+		}
+		shortcutIDMap[shortcut.ID] = id
+		if id != shortcut.ID {
+			result.RemappedShortcuts++
+		}
+		result.Shortcuts++
+	}
+
+	for _, collection := range dump.Collections {
+		if exists, err := rowExists(ctx, tx, `SELECT 1 FROM collection WHERE name = ?`, collection.Name); err != nil {
+			return nil, err
+		} else if exists {
+			return nil, errors.Wrapf(ErrDumpConflict, "collection %s already exists", collection.Name)
+		}
+		shortcutIDs := []string{}
+		for _, shortcutID := range collection.ShortcutIDs {
+			if newID, ok := shortcutIDMap[shortcutID]; ok {
+				shortcutID = newID
+			}
+			shortcutIDs = append(shortcutIDs, fmt.Sprint(shortcutID))
+		}
+		if _, err := insertDumpRow(ctx, tx, "collection", collection.ID,
+			[]string{"creator_id", "created_ts", "updated_ts", "name", "title", "description", "shortcut_ids", "visibility", "link_decorator"},
+			[]any{remapUserID(collection.CreatorID), collection.CreatedTs, collection.UpdatedTs, collection.Name, collection.Title, collection.Description, strings.Join(shortcutIDs, ","), collection.Visibility, collection.LinkDecorator}); err != nil {
+			return nil, errors.Wrapf(err, "failed to import collection %s", collection.Name)
+		}
+		result.Collections++
+	}
+
+	for _, collaborator := range dump.ShortcutCollaborators {
+		shortcutID := collaborator.ShortcutID
+		if newID, ok := shortcutIDMap[shortcutID]; ok {
+			shortcutID = newID
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO shortcut_collaborator (shortcut_id, user_id, created_ts) VALUES (?, ?, ?)
+			ON CONFLICT(shortcut_id, user_id) DO NOTHING
+		`, shortcutID, remapUserID(collaborator.UserID), collaborator.CreatedTs); err != nil {
+			return nil, errors.Wrapf(err, "failed to import collaborator of shortcut %d", collaborator.ShortcutID)
+		}
+		result.ShortcutCollaborators++
+	}
+	return result, nil
+}
+
+// insertDumpRow inserts the row with its dumped ID when it is free, or with a new ID otherwise,
+// and returns the ID of the inserted row.
+func insertDumpRow(ctx context.Context, tx *sql.Tx, table string, id int32, columns []string, args []any) (int32, error) {
+	idTaken, err := rowExists(ctx, tx, fmt.Sprintf("SELECT 1 FROM %s WHERE id = ?", table), id)
+	if err != nil {
+		return 0, err
+	}
+	if !idTaken {
+		columns, args = append([]string{"id"}, columns...), append([]any{id}, args...)
+	}
+	placeholder := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	var insertedID int32
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) RETURNING id", table, strings.Join(columns, ", "), placeholder), args...).Scan(&insertedID); err != nil {
+		return 0, err
+	}
+	return insertedID, nil
+}
+
+func rowExists(ctx context.Context, tx *sql.Tx, query string, args ...any) (bool, error) {
+	var exists int
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&exists); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func queryRows(ctx context.Context, tx *sql.Tx, query string, scan func(*sql.Rows) error) error {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		// Exports of large workspaces stop as soon as they are cancelled.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// SetFallback makes the store read through to the secondary store when a shortcut looked up by name
+// only is missing, such as the redirector does, so that a new database can be served while the
+// shortcuts of the old one are copied forward as they are used. It must be set before the store is used.
+func (s *Store) SetFallback(secondary *Store) {
+	s.fallback = secondary
+}
+
+// isFallbackFind returns whether a missing shortcut of the find is looked up in the fallback store.
+// Other finds could miss shortcuts that are in the store, which must not be copied again.
+func isFallbackFind(find *FindShortcut) bool {
+	return find.Name != nil && len(find.NameList) == 0 && find.ID == nil && find.CreatorID == nil && find.RowStatus == nil &&
+		len(find.VisibilityList) == 0 && find.Tag == nil && find.ExpiringAfter == nil && find.ExpiringBefore == nil
+}
+
+// getFallbackShortcut returns the shortcut of the fallback store, copied into the store along with its
+// creator, or nil when the fallback store has none or the name left the store. The hooks are not
+// notified of the copies, which are not new to the instance.
+func (s *Store) getFallbackShortcut(ctx context.Context, name string) (*storepb.Shortcut, error) {
+	tombstoned, err := s.isShortcutTombstoned(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if tombstoned {
+		return nil, nil
+	}
+	ctx = withoutHooks(ctx)
+	fallbackShortcut, err := s.fallback.GetShortcut(ctx, &FindShortcut{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get fallback shortcut")
+	}
+	if fallbackShortcut == nil {
+		return nil, nil
+	}
+	creatorID, err := s.copyFallbackUser(ctx, fallbackShortcut.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fallback cache keeps its copy, and the ids of both stores are unrelated.
+	create := proto.Clone(fallbackShortcut).(*storepb.Shortcut)
+	create.Id = 0
+	create.CreatorId = creatorID
+	shortcut, err := s.CreateShortcut(ctx, create)
+	if err != nil {
+		// Concurrent lookups of the same name may copy it at once, and the first one wins.
+		if copied, findErr := s.findShortcutByName(ctx, name); findErr == nil && copied != nil {
+			return copied, nil
+		}
+		return nil, errors.Wrap(err, "failed to copy fallback shortcut")
+	}
+	// Created shortcuts are always enabled and normal, unlike the ones of the fallback store.
+	if !fallbackShortcut.Enabled || fallbackShortcut.RowStatus != storepb.RowStatus_NORMAL {
+		rowStatus := RowStatus(fallbackShortcut.RowStatus.String())
+		shortcut, err = s.UpdateShortcut(ctx, &UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &rowStatus,
+			Enabled:   &fallbackShortcut.Enabled,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to update fallback shortcut")
+		}
+	}
+	slog.InfoContext(ctx, "copied shortcut from fallback store", "name", name)
+	return shortcut, nil
+}
+
+// copyFallbackUser returns the id in the store of the user of the fallback store, matched by email,
+// copying the user when the store has none. The copies are plain users without a password, as the
+// roles and the credentials of the old instance are not the new one's to grant.
+func (s *Store) copyFallbackUser(ctx context.Context, fallbackUserID int32) (int32, error) {
+	fallbackUser, err := s.fallback.GetUser(ctx, &FindUser{
+		ID: &fallbackUserID,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get fallback user")
+	}
+	if fallbackUser == nil {
+		return 0, errors.Errorf("not found fallback user with id: %d", fallbackUserID)
+	}
+	user, err := s.GetUser(ctx, &FindUser{
+		Email: &fallbackUser.Email,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if user != nil {
+		return user.ID, nil
+	}
+	user, err = s.CreateUser(ctx, &User{
+		Email:    fallbackUser.Email,
+		Nickname: fallbackUser.Nickname,
+		Role:     RoleUser,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to copy fallback user")
+	}
+	return user.ID, nil
+}
+
+func (s *Store) findShortcutByName(ctx context.Context, name string) (*storepb.Shortcut, error) {
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+		Name: &name,
+	})
+	if err != nil || len(shortcuts) == 0 {
+		return nil, err
+	}
+	return shortcuts[0], nil
+}
+
+// recordShortcutTombstones records the names of the shortcuts matching the where clause as gone from
+// the store, before they are deleted or renamed, so that the fallback store does not bring them back.
+func recordShortcutTombstones(ctx context.Context, tx *sql.Tx, where string, args ...any) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO shortcut_tombstone (name) SELECT name FROM shortcut WHERE `+where+` ON CONFLICT(name) DO NOTHING`, args...)
+	return err
+}
+
+// isShortcutTombstoned returns whether a shortcut of the name was deleted or renamed in the store.
+func (s *Store) isShortcutTombstoned(ctx context.Context, name string) (bool, error) {
+	var count int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM shortcut_tombstone WHERE name = ?`, name).Scan(&count); err != nil {
+		return false, errors.Wrap(err, "failed to find shortcut tombstone")
+	}
+	return count > 0, nil
+}
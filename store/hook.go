@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// EventType is the type of a store event.
+type EventType string
+
+const (
+	// EventShortcutCreated is the event type of shortcut create.
+	EventShortcutCreated EventType = "shortcut.created"
+	// EventShortcutUpdated is the event type of shortcut update.
+	EventShortcutUpdated EventType = "shortcut.updated"
+	// EventShortcutDeleted is the event type of shortcut delete.
+	EventShortcutDeleted EventType = "shortcut.deleted"
+	// EventUserCreated is the event type of user create.
+	EventUserCreated EventType = "user.created"
+	// EventUserUpdated is the event type of user update.
+	EventUserUpdated EventType = "user.updated"
+	// EventUserDeleted is the event type of user delete.
+	EventUserDeleted EventType = "user.deleted"
+)
+
+// Event is a change of an entity in the store.
+// Shortcut events carry the shortcut and user events carry the user, as they were before a delete.
+type Event struct {
+	Type     EventType
+	Shortcut *storepb.Shortcut
+	User     *User
+}
+
+// Hook is notified of the events of the store once their change is committed.
+// Hooks run synchronously, so slow side effects should be done in the background.
+type Hook func(ctx context.Context, event *Event)
+
+// RegisterHook registers the hook to be notified of every event of the store.
+func (s *Store) RegisterHook(hook Hook) {
+	s.hookMutex.Lock()
+	defer s.hookMutex.Unlock()
+	s.hooks = append(s.hooks, hook)
+}
+
+func (s *Store) hasHooks() bool {
+	s.hookMutex.RLock()
+	defer s.hookMutex.RUnlock()
+	return len(s.hooks) > 0
+}
+
+type withoutHooksContextKey struct{}
+
+// withoutHooks returns the context of the changes the hooks are not notified of, such as the copies
+// of the fallback store, which are not new to the instance.
+func withoutHooks(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutHooksContextKey{}, true)
+}
+
+// notify calls the registered hooks with the event. A panicking hook is logged
+// and doesn't stop the other hooks, nor fail the operation that triggered the event.
+func (s *Store) notify(ctx context.Context, event *Event) {
+	if ctx.Value(withoutHooksContextKey{}) != nil {
+		return
+	}
+	s.hookMutex.RLock()
+	hooks := s.hooks
+	s.hookMutex.RUnlock()
+
+	for _, hook := range hooks {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					slog.ErrorContext(ctx, "store hook panicked", slog.String("event", string(event.Type)), slog.String("panic", fmt.Sprint(r)))
+				}
+			}()
+			hook(ctx, event)
+		}()
+	}
+}
+
+// notifyShortcut notifies the hooks of the shortcut event with a copy of the shortcut,
+// so that hooks can't modify the cached shortcut.
+func (s *Store) notifyShortcut(ctx context.Context, eventType EventType, shortcut *storepb.Shortcut) {
+	if !s.hasHooks() {
+		return
+	}
+	s.notify(ctx, &Event{
+		Type:     eventType,
+		Shortcut: proto.Clone(shortcut).(*storepb.Shortcut),
+	})
+}
+
+// notifyUser notifies the hooks of the user event with a copy of the user,
+// so that hooks can't modify the cached user.
+func (s *Store) notifyUser(ctx context.Context, eventType EventType, user *User) {
+	if !s.hasHooks() {
+		return
+	}
+	userCopy := *user
+	s.notify(ctx, &Event{
+		Type: eventType,
+		User: &userCopy,
+	})
+}
@@ -0,0 +1,267 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ImportSessionStatus is the status of an import session.
+type ImportSessionStatus string
+
+const (
+	// ImportSessionOpen is the status of the sessions accepting batches.
+	ImportSessionOpen ImportSessionStatus = "OPEN"
+	// ImportSessionCommitted is the status of the sessions whose batches were imported.
+	ImportSessionCommitted ImportSessionStatus = "COMMITTED"
+)
+
+// ImportSessionTTL is how long an open import session is kept without a batch upload, after which it
+// is considered abandoned and expired with its batches.
+const ImportSessionTTL = 24 * time.Hour
+
+var (
+	// ErrImportSessionCommitted is returned when changing an import session that was already committed.
+	ErrImportSessionCommitted = errors.New("import session is already committed")
+	// ErrImportBatchMissing is returned when committing an import session with a gap in its batches.
+	ErrImportBatchMissing = errors.New("import session is missing a batch")
+)
+
+// ImportSession imports a dump too large for a single request, uploaded in batches that are each
+// a part of the dump. The batches are kept until the session is committed, which imports them
+// all at once, so that a failed batch is retried by uploading it again.
+type ImportSession struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+	Status    ImportSessionStatus
+	// Batches is the uploaded batches in order, which are dropped once the session is committed.
+	Batches []*ImportBatch
+	// Result is the result of the import of the committed session, nil until then.
+	Result *ImportResult
+}
+
+// ImportBatch is an uploaded batch of an import session.
+type ImportBatch struct {
+	// Seq is the position of the batch in the dump, the batches of a session are numbered from 0.
+	Seq       int32
+	CreatedTs int64
+	RowCount  int
+}
+
+// CreateImportSession creates an open import session without batches, expiring the abandoned ones
+// so that they don't pile up when the sweeper is disabled.
+func (s *Store) CreateImportSession(ctx context.Context, creatorID int32) (*ImportSession, error) {
+	if _, err := s.ExpireImportSessions(ctx, time.Now().Add(-ImportSessionTTL).Unix()); err != nil {
+		return nil, err
+	}
+	session := &ImportSession{
+		CreatorID: creatorID,
+		Status:    ImportSessionOpen,
+		Batches:   []*ImportBatch{},
+	}
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, `
+			INSERT INTO import_session (creator_id) VALUES (?)
+			RETURNING id, created_ts, updated_ts
+		`, creatorID).Scan(&session.ID, &session.CreatedTs, &session.UpdatedTs)
+	}); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// GetImportSession returns the import session with its batches, nil when it does not exist.
+func (s *Store) GetImportSession(ctx context.Context, id int32) (*ImportSession, error) {
+	session := &ImportSession{
+		Batches: []*ImportBatch{},
+	}
+	var result string
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT id, creator_id, created_ts, updated_ts, status, result FROM import_session WHERE id = ?
+	`, id).Scan(&session.ID, &session.CreatorID, &session.CreatedTs, &session.UpdatedTs, &session.Status, &result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if session.Status == ImportSessionCommitted {
+		session.Result = &ImportResult{}
+		if err := json.Unmarshal([]byte(result), session.Result); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal import result")
+		}
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT seq, created_ts, row_count FROM import_batch WHERE session_id = ? ORDER BY seq ASC
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		batch := &ImportBatch{}
+		if err := rows.Scan(&batch.Seq, &batch.CreatedTs, &batch.RowCount); err != nil {
+			return nil, err
+		}
+		session.Batches = append(session.Batches, batch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// UpsertImportBatch stores the batch of the open import session, replacing the batch it already
+// has at the position, as when a failed upload is retried.
+func (s *Store) UpsertImportBatch(ctx context.Context, sessionID int32, seq int32, batch *Dump) error {
+	if batch.Format != DumpFormat {
+		return errors.Errorf("unsupported dump format %q", batch.Format)
+	}
+	if seq < 0 {
+		return errors.Errorf("invalid batch seq %d", seq)
+	}
+	payload, err := json.Marshal(batch)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal import batch")
+	}
+	return s.runTx(ctx, func(tx *sql.Tx) error {
+		if err := checkImportSessionOpen(ctx, tx, sessionID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO import_batch (session_id, seq, row_count, payload) VALUES (?, ?, ?, ?)
+			ON CONFLICT(session_id, seq) DO UPDATE
+			SET created_ts = strftime('%s', 'now'), row_count = EXCLUDED.row_count, payload = EXCLUDED.payload
+		`, sessionID, seq, batch.rowCount(), string(payload)); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `UPDATE import_session SET updated_ts = strftime('%s', 'now') WHERE id = ?`, sessionID)
+		return err
+	})
+}
+
+// CommitImportSession imports the batches of the open import session as one dump, within the same
+// transaction that marks the session committed and drops its batches. The batches must be numbered
+// contiguously from 0, and a conflict fails the whole import, leaving the session open.
+func (s *Store) CommitImportSession(ctx context.Context, id int32) (*ImportSession, error) {
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		if err := checkImportSessionOpen(ctx, tx, id); err != nil {
+			return err
+		}
+		dump := &Dump{
+			Format: DumpFormat,
+		}
+		var seq int32
+		if err := queryRows(ctx, tx, fmt.Sprintf(`SELECT seq, payload FROM import_batch WHERE session_id = %d ORDER BY seq ASC`, id), func(rows *sql.Rows) error {
+			var batchSeq int32
+			var payload string
+			if err := rows.Scan(&batchSeq, &payload); err != nil {
+				return err
+			}
+			if batchSeq != seq {
+				return errors.Wrapf(ErrImportBatchMissing, "batch %d was not uploaded", seq)
+			}
+			seq++
+			batch := &Dump{}
+			if err := json.Unmarshal([]byte(payload), batch); err != nil {
+				return errors.Wrapf(err, "failed to unmarshal import batch %d", batchSeq)
+			}
+			dump.append(batch)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		result, err := importDump(ctx, tx, dump)
+		if err != nil {
+			return err
+		}
+		resultBytes, err := json.Marshal(result)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal import result")
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE import_session SET status = ?, result = ?, updated_ts = strftime('%s', 'now') WHERE id = ?
+		`, ImportSessionCommitted, string(resultBytes), id); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `DELETE FROM import_batch WHERE session_id = ?`, id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	s.clearCaches()
+	return s.GetImportSession(ctx, id)
+}
+
+// DeleteImportSession deletes the import session with its batches, aborting it when it is open.
+func (s *Store) DeleteImportSession(ctx context.Context, id int32) error {
+	return s.runTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM import_batch WHERE session_id = ?`, id); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM import_session WHERE id = ?`, id)
+		return err
+	})
+}
+
+// ExpireImportSessions deletes the open import sessions last updated before the time with their
+// batches, and returns the number of deleted sessions. The committed sessions are kept for their result.
+func (s *Store) ExpireImportSessions(ctx context.Context, updatedBefore int64) (int64, error) {
+	var count int64
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM import_batch WHERE session_id IN (
+				SELECT id FROM import_session WHERE status = ? AND updated_ts < ?
+			)
+		`, ImportSessionOpen, updatedBefore); err != nil {
+			return err
+		}
+		deleted, err := tx.ExecContext(ctx, `DELETE FROM import_session WHERE status = ? AND updated_ts < ?`, ImportSessionOpen, updatedBefore)
+		if err != nil {
+			return err
+		}
+		count, err = deleted.RowsAffected()
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// checkImportSessionOpen returns ErrImportSessionCommitted when the import session is committed.
+func checkImportSessionOpen(ctx context.Context, tx *sql.Tx, id int32) error {
+	var status ImportSessionStatus
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM import_session WHERE id = ?`, id).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("import session %d not found", id)
+		}
+		return err
+	}
+	if status != ImportSessionOpen {
+		return ErrImportSessionCommitted
+	}
+	return nil
+}
+
+// rowCount returns the number of rows of the dump.
+func (d *Dump) rowCount() int {
+	return len(d.WorkspaceSettings) + len(d.Users) + len(d.UserSettings) + len(d.Shortcuts) + len(d.Collections) + len(d.ShortcutCollaborators)
+}
+
+// append appends the rows of the other dump to the dump.
+func (d *Dump) append(other *Dump) {
+	d.WorkspaceSettings = append(d.WorkspaceSettings, other.WorkspaceSettings...)
+	d.Users = append(d.Users, other.Users...)
+	d.UserSettings = append(d.UserSettings, other.UserSettings...)
+	d.Shortcuts = append(d.Shortcuts, other.Shortcuts...)
+	d.Collections = append(d.Collections, other.Collections...)
+	d.ShortcutCollaborators = append(d.ShortcutCollaborators, other.ShortcutCollaborators...)
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordCost is the bcrypt cost of the password hashes, and stored hashes of a lower cost should be rehashed.
+const PasswordCost = bcrypt.DefaultCost
+
+// HashPassword returns the hash of the password to be stored.
+func HashPassword(password string) (string, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), PasswordCost)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash password")
+	}
+	return string(passwordHash), nil
+}
+
+// VerifyPassword returns whether the password matches the stored hash of the user, and whether the
+// stored hash should be rehashed with the current parameters. A missing user never matches.
+func (s *Store) VerifyPassword(ctx context.Context, userID int32, password string) (bool, bool, error) {
+	user, err := s.GetUser(ctx, &FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return false, false, err
+	}
+	if user == nil {
+		return false, false, nil
+	}
+
+	// A malformed stored hash doesn't match any password either.
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return false, false, nil
+	}
+	needsRehash, err := needsPasswordRehash(user.PasswordHash)
+	if err != nil {
+		return false, false, err
+	}
+	return true, needsRehash, nil
+}
+
+// needsPasswordRehash returns whether the stored hash was made with older parameters than the current ones.
+func needsPasswordRehash(passwordHash string) (bool, error) {
+	cost, err := bcrypt.Cost([]byte(passwordHash))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get password cost")
+	}
+	return cost < PasswordCost, nil
+}
+
+// PasswordHashReport counts the users by the state of their stored password hash.
+type PasswordHashReport struct {
+	// Current is the number of hashes made with the current parameters.
+	Current int
+	// Outdated is the number of hashes made with older parameters, which are rehashed when their
+	// users next sign in, as the passwords themselves are never stored.
+	Outdated int
+	// Malformed is the number of hashes no password matches, whose users cannot sign in with a password.
+	Malformed int
+}
+
+// GetPasswordHashReport counts the password hashes of all users that are pending a rehash.
+func (s *Store) GetPasswordHashReport(ctx context.Context) (*PasswordHashReport, error) {
+	users, err := s.ListUsers(ctx, &FindUser{})
+	if err != nil {
+		return nil, err
+	}
+	report := &PasswordHashReport{}
+	for _, user := range users {
+		// The same check as when signing in decides which hashes are outdated.
+		needsRehash, err := needsPasswordRehash(user.PasswordHash)
+		if err != nil {
+			report.Malformed++
+		} else if needsRehash {
+			report.Outdated++
+		} else {
+			report.Current++
+		}
+	}
+	return report, nil
+}
+
+// RehashPassword stores a new hash of the password of the user, with the current parameters.
+func (s *Store) RehashPassword(ctx context.Context, userID int32, password string) error {
+	passwordHash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	_, err = s.UpdateUser(ctx, &UpdateUser{
+		ID:           userID,
+		PasswordHash: &passwordHash,
+	})
+	return err
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// lockRetryBaseDelay is the delay before the first retry of a write, doubled for each further retry.
+	lockRetryBaseDelay = 20 * time.Millisecond
+	// sqliteBusy and sqliteLocked are the primary result codes of SQLite's lock errors.
+	sqliteBusy   = 5
+	sqliteLocked = 6
+)
+
+// isLockError returns whether the error is a transient lock error of the database, which
+// succeeds when retried, rather than a genuine failure.
+func isLockError(err error) bool {
+	if err == nil {
+		return false
+	}
+	// Drivers such as modernc.org/sqlite expose the result code, whose low byte is the primary code.
+	var codeErr interface{ Code() int }
+	if errors.As(err, &codeErr) {
+		code := codeErr.Code() & 0xff
+		return code == sqliteBusy || code == sqliteLocked
+	}
+	// Other drivers are told apart by SQLite's messages of the lock errors.
+	message := err.Error()
+	return strings.Contains(message, "database is locked") || strings.Contains(message, "database table is locked") || strings.Contains(message, "SQLITE_BUSY")
+}
+
+// retryOnLock runs the write until it doesn't fail with a lock error, retrying it up to the
+// profile's lock retries with a jittered backoff, so that bursts of writes don't all retry at once.
+// The write must be safe to run again, such as a single statement or a whole transaction.
+func (s *Store) retryOnLock(ctx context.Context, write func() error) error {
+	err := write()
+	for attempt := 0; attempt < s.profile.LockRetries && isLockError(err); attempt++ {
+		delay := lockRetryBaseDelay << attempt
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay)))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		err = write()
+	}
+	return err
+}
+
+// runTx runs the writes within a transaction, committed once they all succeed. The whole
+// transaction is run again on lock errors, so the writes must not keep state across runs.
+func (s *Store) runTx(ctx context.Context, write func(tx *sql.Tx) error) error {
+	// The whole transaction is timed as one query, named after the store method running it.
+	defer s.db.logSlow(ctx, time.Now())
+	return s.retryOnLock(ctx, func() error {
+		tx, err := s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if err := write(tx); err != nil {
+			return err
+		}
+		return tx.Commit()
+	})
+}
@@ -4,25 +4,84 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
+// ErrTooManyTags is the cause of the validation error returned when added tags would exceed the maximum number of tags of a shortcut.
+var ErrTooManyTags = errors.New("too many tags")
+
 type UpdateShortcut struct {
 	ID int32
 
-	RowStatus         *RowStatus
-	Name              *string
-	Link              *string
-	Title             *string
-	Description       *string
-	Visibility        *Visibility
-	Tag               *string
-	OpenGraphMetadata *storepb.OpenGraphMetadata
+	RowStatus             *RowStatus
+	Name                  *string
+	Link                  *string
+	Title                 *string
+	Description           *string
+	Visibility            *Visibility
+	Tag                   *string
+	OpenGraphMetadata     *storepb.OpenGraphMetadata
+	Enabled               *bool
+	ApprovalStatus        *storepb.ApprovalStatus
+	LinkDecoratorDisabled *bool
+	NoCache               *bool
+	Interstitial          *bool
+	DisableAnalytics      *bool
+	RedirectDelayMs       *int32
+	Goal                  *string
+	ExpiresTs             *int64
+	Targets               *storepb.ShortcutTargets
+	RefererPolicy         *storepb.RefererPolicy
+	// AddTags and RemoveTags edit the stored tags, or Tag when it is set, within the update.
+	AddTags    []string
+	RemoveTags []string
+}
+
+// ChangesApprovedShortcut returns whether the update of the shortcut leaves it public with another
+// name or link than an admin approved, or with target or locale links the admin did not approve, or
+// makes it public, so that it waits for the approval again.
+func (update *UpdateShortcut) ChangesApprovedShortcut(shortcut *storepb.Shortcut) bool {
+	visibility := shortcut.Visibility
+	if update.Visibility != nil {
+		visibility = convertVisibilityStringToStorepb(string(*update.Visibility))
+	}
+	if visibility != storepb.Visibility_PUBLIC {
+		return false
+	}
+	if shortcut.Visibility != storepb.Visibility_PUBLIC {
+		return true
+	}
+	if (update.Name != nil && *update.Name != shortcut.Name) || (update.Link != nil && *update.Link != shortcut.Link) {
+		return true
+	}
+	if update.Targets != nil {
+		for _, link := range update.Targets.Links {
+			if !slices.Contains(shortcut.Targets.GetLinks(), link) {
+				return true
+			}
+		}
+		for locale, link := range update.Targets.LocaleLinks {
+			if shortcut.Targets.GetLocaleLinks()[locale] != link {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UpdateShortcutTags is the tags to add to and remove from a list of shortcuts.
+type UpdateShortcutTags struct {
+	IDList     []int32
+	AddTags    []string
+	RemoveTags []string
 }
 
 type FindShortcut struct {
@@ -32,16 +91,33 @@ type FindShortcut struct {
 	Name           *string
 	VisibilityList []Visibility
 	Tag            *string
+	// NameList finds the shortcuts of any of the names, at most maxNamesPerQuery of them.
+	NameList []string
+	// ExpiringAfter and ExpiringBefore find the shortcuts expiring in the range, the shortcuts
+	// that never expire are never found.
+	ExpiringAfter  *int64
+	ExpiringBefore *int64
+	// NotViewedSince finds the shortcuts not viewed since the time, including the never viewed ones.
+	NotViewedSince *int64
+	// ArchivedBefore finds the shortcuts archived before the time.
+	ArchivedBefore *int64
 }
 
 type DeleteShortcut struct {
 	ID int32
 }
 
+// CreateShortcut creates a shortcut. New shortcuts are always enabled, and approved unless
+// the approval status is given.
 func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "name_skeleton", "link", "title", "description", "visibility", "tag"}
+	create.Name = s.NormalizeName(create.Name)
+	if err := s.checkConfusableName(ctx, create.Name, 0); err != nil {
+		return nil, err
+	}
+	create.Tags = s.NormalizeTags(create.Tags)
+	args := []any{create.CreatorId, create.Name, getNameSkeleton(create.Name), create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?"}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -51,36 +127,90 @@ func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*
 		args = append(args, string(openGraphMetadataBytes))
 		placeholder = append(placeholder, "?")
 	}
+	if create.ApprovalStatus != storepb.ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED {
+		set, args, placeholder = append(set, "approval_status"), append(args, create.ApprovalStatus.String()), append(placeholder, "?")
+	}
+	if create.LinkDecoratorDisabled {
+		set, args, placeholder = append(set, "link_decorator_disabled"), append(args, create.LinkDecoratorDisabled), append(placeholder, "?")
+	}
+	if create.NoCache {
+		set, args, placeholder = append(set, "no_cache"), append(args, create.NoCache), append(placeholder, "?")
+	}
+	if create.Interstitial {
+		set, args, placeholder = append(set, "interstitial"), append(args, create.Interstitial), append(placeholder, "?")
+	}
+	if create.DisableAnalytics {
+		set, args, placeholder = append(set, "disable_analytics"), append(args, create.DisableAnalytics), append(placeholder, "?")
+	}
+	if create.RedirectDelayMs != 0 {
+		set, args, placeholder = append(set, "redirect_delay_ms"), append(args, create.RedirectDelayMs), append(placeholder, "?")
+	}
+	if create.Goal != "" {
+		set, args, placeholder = append(set, "goal"), append(args, create.Goal), append(placeholder, "?")
+	}
+	if create.ExpiresTs != 0 {
+		set, args, placeholder = append(set, "expires_ts"), append(args, create.ExpiresTs), append(placeholder, "?")
+	}
+	// The targets are always set, so that the created shortcut is the same as when it is read.
+	if create.Targets == nil {
+		create.Targets = &storepb.ShortcutTargets{}
+	}
+	targetsBytes, err := protojson.Marshal(create.Targets)
+	if err != nil {
+		return nil, err
+	}
+	set, args, placeholder = append(set, "targets"), append(args, string(targetsBytes)), append(placeholder, "?")
+	if create.RefererPolicy == nil {
+		create.RefererPolicy = &storepb.RefererPolicy{}
+	}
+	refererPolicyBytes, err := protojson.Marshal(create.RefererPolicy)
+	if err != nil {
+		return nil, err
+	}
+	set, args, placeholder = append(set, "referer_policy"), append(args, string(refererPolicyBytes)), append(placeholder, "?")
 
 	stmt := `
 		INSERT INTO shortcut (
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts, row_status
+		RETURNING id, created_ts, updated_ts, row_status, enabled, approval_status
 	`
-	var rowStatus string
-	if err := s.db.QueryRowContext(ctx, stmt, args...).Scan(
-		&create.Id,
-		&create.CreatedTs,
-		&create.UpdatedTs,
-		&rowStatus,
-	); err != nil {
+	var rowStatus, approvalStatus string
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt, args...).Scan(
+			&create.Id,
+			&create.CreatedTs,
+			&create.UpdatedTs,
+			&rowStatus,
+			&create.Enabled,
+			&approvalStatus,
+		)
+	}); err != nil {
 		return nil, err
 	}
 	create.RowStatus = convertRowStatusStringToStorepb(rowStatus)
+	create.ApprovalStatus = convertApprovalStatusStringToStorepb(approvalStatus)
 	shortcut := create
-	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.cacheShortcut(shortcut)
+	s.notifyShortcut(ctx, EventShortcutCreated, shortcut)
 	return shortcut, nil
 }
 
 func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	editTags := len(update.AddTags) > 0 || len(update.RemoveTags) > 0
 	if update.RowStatus != nil {
 		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+		// The archive time is kept when an archived shortcut is archived again.
+		set, args = append(set, "archived_ts = CASE WHEN ? != 'ARCHIVED' THEN 0 WHEN row_status = 'ARCHIVED' THEN archived_ts ELSE strftime('%s', 'now') END"), append(args, update.RowStatus.String())
 	}
 	if update.Name != nil {
-		set, args = append(set, "name = ?"), append(args, *update.Name)
+		name := s.NormalizeName(*update.Name)
+		if err := s.checkConfusableName(ctx, name, update.ID); err != nil {
+			return nil, err
+		}
+		set, args = append(set, "name = ?", "name_skeleton = ?"), append(args, name, getNameSkeleton(name))
 	}
 	if update.Link != nil {
 		set, args = append(set, "link = ?"), append(args, *update.Link)
@@ -94,8 +224,8 @@ func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*st
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
-	if update.Tag != nil {
-		set, args = append(set, "tag = ?"), append(args, *update.Tag)
+	if update.Tag != nil && !editTags {
+		set, args = append(set, "tag = ?"), append(args, strings.Join(s.NormalizeTags(strings.Fields(*update.Tag)), " "))
 	}
 	if update.OpenGraphMetadata != nil {
 		openGraphMetadataBytes, err := protojson.Marshal(update.OpenGraphMetadata)
@@ -104,38 +234,116 @@ func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*st
 		}
 		set, args = append(set, "og_metadata = ?"), append(args, string(openGraphMetadataBytes))
 	}
-	if len(set) == 0 {
+	if update.Enabled != nil {
+		set, args = append(set, "enabled = ?"), append(args, *update.Enabled)
+	}
+	if update.ApprovalStatus != nil {
+		set, args = append(set, "approval_status = ?"), append(args, update.ApprovalStatus.String())
+	}
+	if update.LinkDecoratorDisabled != nil {
+		set, args = append(set, "link_decorator_disabled = ?"), append(args, *update.LinkDecoratorDisabled)
+	}
+	if update.NoCache != nil {
+		set, args = append(set, "no_cache = ?"), append(args, *update.NoCache)
+	}
+	if update.Interstitial != nil {
+		set, args = append(set, "interstitial = ?"), append(args, *update.Interstitial)
+	}
+	if update.DisableAnalytics != nil {
+		set, args = append(set, "disable_analytics = ?"), append(args, *update.DisableAnalytics)
+	}
+	if update.RedirectDelayMs != nil {
+		set, args = append(set, "redirect_delay_ms = ?"), append(args, *update.RedirectDelayMs)
+	}
+	if update.Goal != nil {
+		set, args = append(set, "goal = ?"), append(args, *update.Goal)
+	}
+	if update.ExpiresTs != nil {
+		set, args = append(set, "expires_ts = ?"), append(args, *update.ExpiresTs)
+	}
+	if update.Targets != nil {
+		targetsBytes, err := protojson.Marshal(update.Targets)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal shortcut targets")
+		}
+		set, args = append(set, "targets = ?"), append(args, string(targetsBytes))
+	}
+	if update.RefererPolicy != nil {
+		refererPolicyBytes, err := protojson.Marshal(update.RefererPolicy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal shortcut referer policy")
+		}
+		set, args = append(set, "referer_policy = ?"), append(args, string(refererPolicyBytes))
+	}
+	if len(set) == 0 && !editTags {
 		return nil, errors.New("no update specified")
 	}
-	args = append(args, update.ID)
 
-	stmt := `
-		UPDATE shortcut
-		SET
-			` + strings.Join(set, ", ") + `
-		WHERE
-			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata
-	`
 	shortcut := &storepb.Shortcut{}
-	var rowStatus, visibility, tags, openGraphMetadataString string
-	if err := s.db.QueryRowContext(ctx, stmt, args...).Scan(
-		&shortcut.Id,
-		&shortcut.CreatorId,
-		&shortcut.CreatedTs,
-		&shortcut.UpdatedTs,
-		&rowStatus,
-		&shortcut.Name,
-		&shortcut.Link,
-		&shortcut.Title,
-		&shortcut.Description,
-		&visibility,
-		&tags,
-		&openGraphMetadataString,
-	); err != nil {
+	var rowStatus, visibility, tags, openGraphMetadataString, approvalStatus, targetsString, refererPolicyString string
+	// The tags are edited within the transaction, so that concurrent edits are not lost.
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		set, args := slices.Clone(set), slices.Clone(args)
+		if editTags {
+			var tag string
+			if update.Tag != nil {
+				tag = *update.Tag
+			} else if err := tx.QueryRowContext(ctx, `SELECT tag FROM shortcut WHERE id = ?`, update.ID).Scan(&tag); err != nil {
+				return err
+			}
+			newTag, err := s.editTags(tag, update.AddTags, update.RemoveTags)
+			if err != nil {
+				return err
+			}
+			set, args = append(set, "tag = ?"), append(args, newTag)
+		}
+		args = append(args, update.ID)
+		if update.Name != nil {
+			if err := recordShortcutTombstones(ctx, tx, `id = ? AND name != ?`, update.ID, s.NormalizeName(*update.Name)); err != nil {
+				return err
+			}
+		}
+
+		stmt := `
+			UPDATE shortcut
+			SET
+				` + strings.Join(set, ", ") + `
+			WHERE
+				id = ?
+			RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, enabled, approval_status, link_decorator_disabled, no_cache, interstitial, targets, disable_analytics, expires_ts, referer_policy, redirect_delay_ms, goal, last_viewed_ts, archived_ts
+		`
+		return tx.QueryRowContext(ctx, stmt, args...).Scan(
+			&shortcut.Id,
+			&shortcut.CreatorId,
+			&shortcut.CreatedTs,
+			&shortcut.UpdatedTs,
+			&rowStatus,
+			&shortcut.Name,
+			&shortcut.Link,
+			&shortcut.Title,
+			&shortcut.Description,
+			&visibility,
+			&tags,
+			&openGraphMetadataString,
+			&shortcut.Enabled,
+			&approvalStatus,
+			&shortcut.LinkDecoratorDisabled,
+			&shortcut.NoCache,
+			&shortcut.Interstitial,
+			&targetsString,
+			&shortcut.DisableAnalytics,
+			&shortcut.ExpiresTs,
+			&refererPolicyString,
+			&shortcut.RedirectDelayMs,
+			&shortcut.Goal,
+			&shortcut.LastViewedTs,
+			&shortcut.ArchivedTs,
+		)
+	}); err != nil {
 		return nil, err
 	}
 	shortcut.RowStatus = convertRowStatusStringToStorepb(rowStatus)
+	shortcut.ApprovalStatus = convertApprovalStatusStringToStorepb(approvalStatus)
 	shortcut.Visibility = convertVisibilityStringToStorepb(visibility)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
@@ -143,36 +351,93 @@ func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*st
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
-	s.shortcutCache.Store(shortcut.Id, shortcut)
+	var targets storepb.ShortcutTargets
+	if err := protojson.Unmarshal([]byte(targetsString), &targets); err != nil {
+		return nil, err
+	}
+	shortcut.Targets = &targets
+	var refererPolicy storepb.RefererPolicy
+	if err := protojson.Unmarshal([]byte(refererPolicyString), &refererPolicy); err != nil {
+		return nil, err
+	}
+	shortcut.RefererPolicy = &refererPolicy
+	s.cacheShortcut(shortcut)
+	s.notifyShortcut(ctx, EventShortcutUpdated, shortcut)
 	return shortcut, nil
 }
 
-func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error) {
-	where, args := []string{"1 = 1"}, []any{}
-	if v := find.ID; v != nil {
-		where, args = append(where, "id = ?"), append(args, *v)
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, "creator_id = ?"), append(args, *v)
+// UpdateShortcutTags adds and removes the tags of the shortcuts within a transaction.
+// Missing shortcuts are skipped, and the updated shortcuts are returned.
+func (s *Store) UpdateShortcutTags(ctx context.Context, update *UpdateShortcutTags) ([]*storepb.Shortcut, error) {
+	updatedIDList := []int32{}
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		updatedIDList = []int32{}
+		for _, id := range update.IDList {
+			var tag string
+			if err := tx.QueryRowContext(ctx, `SELECT tag FROM shortcut WHERE id = ?`, id).Scan(&tag); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				return err
+			}
+
+			newTag, err := s.editTags(tag, update.AddTags, update.RemoveTags)
+			if err != nil {
+				return errors.Wrapf(err, "failed to edit the tags of shortcut %d", id)
+			}
+			if newTag != tag {
+				if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET tag = ? WHERE id = ?`, newTag, id); err != nil {
+					return err
+				}
+			}
+			updatedIDList = append(updatedIDList, id)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	if v := find.RowStatus; v != nil {
-		where, args = append(where, "row_status = ?"), append(args, *v)
+
+	list := []*storepb.Shortcut{}
+	for _, id := range updatedIDList {
+		s.shortcutCache.Delete(id)
+		shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+			ID: &id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if shortcut != nil {
+			s.notifyShortcut(ctx, EventShortcutUpdated, shortcut)
+			list = append(list, shortcut)
+		}
 	}
-	if v := find.Name; v != nil {
-		where, args = append(where, "name = ?"), append(args, *v)
+	return list, nil
+}
+
+// UpdateShortcutLastViewedTs sets the last view time of the shortcut, unless it was viewed later.
+// The cached shortcut is updated rather than evicted, as every redirect updates it.
+func (s *Store) UpdateShortcutLastViewedTs(ctx context.Context, id int32, lastViewedTs int64) error {
+	cache, cached := s.shortcutCache.Load(id)
+	if cached && cache.(*storepb.Shortcut).LastViewedTs >= lastViewedTs {
+		return nil
 	}
-	if v := find.VisibilityList; len(v) != 0 {
-		list := []string{}
-		for _, visibility := range v {
-			list = append(list, fmt.Sprintf("$%d", len(args)+1))
-			args = append(args, visibility)
-		}
-		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
+	if err := s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, `UPDATE shortcut SET last_viewed_ts = ? WHERE id = ? AND last_viewed_ts < ?`, lastViewedTs, id, lastViewedTs)
+		return err
+	}); err != nil {
+		return err
 	}
-	if v := find.Tag; v != nil {
-		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
+	if cached {
+		shortcut := proto.Clone(cache.(*storepb.Shortcut)).(*storepb.Shortcut)
+		shortcut.LastViewedTs = lastViewedTs
+		// The shortcut is not cached over a concurrent update of it.
+		s.shortcutCache.CompareAndSwap(id, cache, shortcut)
 	}
+	return nil
+}
 
+func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error) {
+	where, args := s.buildShortcutWhere(find)
 	rows, err := s.db.QueryContext(ctx, `
 		SELECT
 			id,
@@ -186,7 +451,20 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 			description,
 			visibility,
 			tag,
-			og_metadata
+			og_metadata,
+			enabled,
+			approval_status,
+			link_decorator_disabled,
+			no_cache,
+			interstitial,
+			targets,
+			disable_analytics,
+			expires_ts,
+			referer_policy,
+			redirect_delay_ms,
+			goal,
+			last_viewed_ts,
+			archived_ts
 		FROM shortcut
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -200,7 +478,7 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var rowStatus, visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString, approvalStatus, targetsString, refererPolicyString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
@@ -214,10 +492,24 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&shortcut.Enabled,
+			&approvalStatus,
+			&shortcut.LinkDecoratorDisabled,
+			&shortcut.NoCache,
+			&shortcut.Interstitial,
+			&targetsString,
+			&shortcut.DisableAnalytics,
+			&shortcut.ExpiresTs,
+			&refererPolicyString,
+			&shortcut.RedirectDelayMs,
+			&shortcut.Goal,
+			&shortcut.LastViewedTs,
+			&shortcut.ArchivedTs,
 		); err != nil {
 			return nil, err
 		}
 		shortcut.RowStatus = convertRowStatusStringToStorepb(rowStatus)
+		shortcut.ApprovalStatus = convertApprovalStatusStringToStorepb(approvalStatus)
 		shortcut.Visibility = storepb.Visibility(storepb.Visibility_value[visibility])
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
@@ -225,6 +517,16 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		var targets storepb.ShortcutTargets
+		if err := protojson.Unmarshal([]byte(targetsString), &targets); err != nil {
+			return nil, err
+		}
+		shortcut.Targets = &targets
+		var refererPolicy storepb.RefererPolicy
+		if err := protojson.Unmarshal([]byte(refererPolicyString), &refererPolicy); err != nil {
+			return nil, err
+		}
+		shortcut.RefererPolicy = &refererPolicy
 		list = append(list, shortcut)
 	}
 
@@ -232,11 +534,149 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 		return nil, err
 	}
 	for _, shortcut := range list {
-		s.shortcutCache.Store(shortcut.Id, shortcut)
+		s.cacheShortcut(shortcut)
 	}
 	return list, nil
 }
 
+// maxNamesPerQuery is the number of names looked up per query by ListShortcutsByNames, well below the
+// limit of SQLite on the number of parameters of a statement.
+const maxNamesPerQuery = 500
+
+// ListShortcutsByNames returns the shortcuts of the names keyed by the names as given, which are
+// normalized as for GetShortcut. The names are looked up in one query per maxNamesPerQuery names,
+// and the shortcuts found are cached. As for GetShortcut, the names missing from the store are read
+// through the fallback store, and the names without a shortcut are missing from the result.
+func (s *Store) ListShortcutsByNames(ctx context.Context, names []string) (map[string]*storepb.Shortcut, error) {
+	shortcuts := map[string]*storepb.Shortcut{}
+	for start := 0; start < len(names); start += maxNamesPerQuery {
+		chunk := names[start:min(start+maxNamesPerQuery, len(names))]
+		list, err := s.ListShortcuts(ctx, &FindShortcut{
+			NameList: chunk,
+		})
+		if err != nil {
+			return nil, err
+		}
+		byName := map[string]*storepb.Shortcut{}
+		for _, shortcut := range list {
+			byName[shortcut.Name] = shortcut
+		}
+		for _, name := range chunk {
+			shortcut, ok := byName[s.NormalizeName(name)]
+			if !ok && s.fallback != nil {
+				if shortcut, err = s.getFallbackShortcut(ctx, name); err != nil {
+					return nil, err
+				}
+				ok = shortcut != nil
+			}
+			if ok {
+				shortcuts[name] = shortcut
+			}
+		}
+	}
+	return shortcuts, nil
+}
+
+// WalkPublicShortcuts calls fn with at most limit public shortcuts that redirect, ordered by name.
+// Expired shortcuts no longer redirect.
+// The shortcuts are passed as they are read so that large sets are not held in memory, and only
+// their id, name and update time are set.
+func (s *Store) WalkPublicShortcuts(ctx context.Context, limit int, fn func(shortcut *storepb.Shortcut) error) error {
+	now := time.Now().Unix()
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			id,
+			name,
+			updated_ts
+		FROM shortcut
+		WHERE visibility = ? AND row_status = ? AND enabled = 1 AND approval_status = ? AND (expires_ts = 0 OR expires_ts > ?)
+		ORDER BY name ASC
+		LIMIT ?`,
+		VisibilityPublic.String(), Normal.String(), storepb.ApprovalStatus_APPROVED.String(), now, limit,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		shortcut := &storepb.Shortcut{}
+		if err := rows.Scan(&shortcut.Id, &shortcut.Name, &shortcut.UpdatedTs); err != nil {
+			return err
+		}
+		if err := fn(shortcut); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// CountShortcuts returns the number of shortcuts matching the find conditions.
+func (s *Store) CountShortcuts(ctx context.Context, find *FindShortcut) (int, error) {
+	where, args := s.buildShortcutWhere(find)
+	var count int
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM shortcut
+		WHERE `+strings.Join(where, " AND "),
+		args...,
+	).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *Store) buildShortcutWhere(find *FindShortcut) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, s.NormalizeName(*v))
+	}
+	if v := find.NameList; len(v) != 0 {
+		list := []string{}
+		for _, name := range v {
+			list = append(list, "?")
+			args = append(args, s.NormalizeName(name))
+		}
+		where = append(where, fmt.Sprintf("name IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		list := []string{}
+		for _, visibility := range v {
+			list = append(list, fmt.Sprintf("$%d", len(args)+1))
+			args = append(args, visibility)
+		}
+		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
+	}
+	if find.ExpiringAfter != nil || find.ExpiringBefore != nil {
+		where = append(where, "expires_ts != 0")
+	}
+	if v := find.ExpiringAfter; v != nil {
+		where, args = append(where, "expires_ts >= ?"), append(args, *v)
+	}
+	if v := find.ExpiringBefore; v != nil {
+		where, args = append(where, "expires_ts < ?"), append(args, *v)
+	}
+	if v := find.NotViewedSince; v != nil {
+		where, args = append(where, "last_viewed_ts < ?"), append(args, *v)
+	}
+	if v := find.ArchivedBefore; v != nil {
+		where, args = append(where, "row_status = ?", "archived_ts < ?"), append(args, Archived, *v)
+	}
+	return where, args
+}
+
 func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.Shortcut, error) {
 	if find.ID != nil {
 		if cache, ok := s.shortcutCache.Load(*find.ID); ok {
@@ -250,41 +690,111 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	}
 
 	if len(shortcuts) == 0 {
+		if s.fallback != nil && isFallbackFind(find) {
+			return s.getFallbackShortcut(ctx, *find.Name)
+		}
 		return nil, nil
 	}
 
 	shortcut := shortcuts[0]
-	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.cacheShortcut(shortcut)
 	return shortcut, nil
 }
 
 func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
-	if _, err := s.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+	// The shortcut is only looked up for the hooks, which are notified with the deleted shortcut.
+	var shortcut *storepb.Shortcut
+	if s.hasHooks() {
+		var err error
+		shortcut, err = s.GetShortcut(ctx, &FindShortcut{
+			ID: &delete.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		if err := recordShortcutTombstones(ctx, tx, `id = ?`, delete.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM shortcut_collaborator WHERE shortcut_id = ?`, delete.ID)
+		return err
+	}); err != nil {
 		return err
 	}
 
 	s.shortcutCache.Delete(delete.ID)
+	if shortcut != nil {
+		s.notifyShortcut(ctx, EventShortcutDeleted, shortcut)
+	}
 
 	return nil
 }
 
-func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
-	stmt := `
-	DELETE FROM 
-		shortcut 
-	WHERE 
-		creator_id NOT IN (
-			SELECT 
-				id 
-			FROM 
-				user
-		)`
-	_, err := tx.ExecContext(ctx, stmt)
+// cacheShortcut caches the shortcut, unless it is a no cache shortcut which is always read fresh.
+func (s *Store) cacheShortcut(shortcut *storepb.Shortcut) {
+	if shortcut.NoCache {
+		s.shortcutCache.Delete(shortcut.Id)
+		return
+	}
+	s.shortcutCache.Store(shortcut.Id, shortcut)
+}
+
+// orphanShortcutCondition matches the shortcuts whose user no longer exists. The welcome shortcuts of a new
+// instance are owned by the first user, whose id is 1, before they sign up, so they are kept until then.
+const orphanShortcutCondition = `creator_id NOT IN (SELECT id FROM user) AND (creator_id != 1 OR EXISTS (SELECT 1 FROM user))`
+
+// vacuumShortcut deletes the rows whose user no longer exists and returns the number of deleted rows.
+func vacuumShortcut(ctx context.Context, tx *sql.Tx) (int64, error) {
+	if err := recordShortcutTombstones(ctx, tx, orphanShortcutCondition); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE `+orphanShortcutCondition)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return result.RowsAffected()
+}
+
+// NormalizeTags trims the tags, joins the words of multi-word tags with dashes, lowercases them
+// unless the profile preserves the tag case, and removes the empty and duplicate tags.
+func (s *Store) NormalizeTags(tags []string) []string {
+	result := []string{}
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), "-")
+		if !s.profile.PreserveTagCase {
+			tag = strings.ToLower(tag)
+		}
+		if tag != "" && !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// editTags returns the tag column with the tags added and then removed. Adding tags beyond the
+// maximum number of tags returns a validation error matching ErrTooManyTags, while removing tags always succeeds.
+func (s *Store) editTags(tag string, addTags, removeTags []string) (string, error) {
+	tags := s.NormalizeTags(append(strings.Fields(tag), addTags...))
+	removeTags = s.NormalizeTags(removeTags)
+	tags = slices.DeleteFunc(tags, func(tag string) bool {
+		return slices.Contains(removeTags, tag)
+	})
+	if len(addTags) > 0 && len(tags) > s.profile.GetMaxTagsPerShortcut() {
+		return "", &ValidationError{
+			Message: "invalid shortcut",
+			Fields: map[string]string{
+				"tags": fmt.Sprintf("a shortcut must not have more than %d tags", s.profile.GetMaxTagsPerShortcut()),
+			},
+			Err: ErrTooManyTags,
+		}
+	}
+	return strings.Join(tags, " "), nil
 }
 
 func filterTags(tags []string) []string {
@@ -300,3 +810,7 @@ func filterTags(tags []string) []string {
 func convertVisibilityStringToStorepb(visibility string) storepb.Visibility {
 	return storepb.Visibility(storepb.Visibility_value[visibility])
 }
+
+func convertApprovalStatusStringToStorepb(approvalStatus string) storepb.ApprovalStatus {
+	return storepb.ApprovalStatus(storepb.ApprovalStatus_value[approvalStatus])
+}
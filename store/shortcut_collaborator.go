@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// ShortcutCollaborator grants a user the edit rights on a shortcut, besides its creator.
+type ShortcutCollaborator struct {
+	ShortcutID int32
+	UserID     int32
+	CreatedTs  int64
+}
+
+type FindShortcutCollaborator struct {
+	ShortcutID *int32
+	UserID     *int32
+}
+
+type DeleteShortcutCollaborator struct {
+	ShortcutID int32
+	UserID     int32
+}
+
+// UpsertShortcutCollaborator adds the user as a collaborator of the shortcut, keeping the existing
+// collaborator as is.
+func (s *Store) UpsertShortcutCollaborator(ctx context.Context, upsert *ShortcutCollaborator) (*ShortcutCollaborator, error) {
+	stmt := `
+		INSERT INTO shortcut_collaborator (
+			shortcut_id, user_id
+		)
+		VALUES (?, ?)
+		ON CONFLICT(shortcut_id, user_id) DO UPDATE
+		SET shortcut_id = EXCLUDED.shortcut_id
+		RETURNING created_ts
+	`
+	collaborator := &ShortcutCollaborator{
+		ShortcutID: upsert.ShortcutID,
+		UserID:     upsert.UserID,
+	}
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt, upsert.ShortcutID, upsert.UserID).Scan(&collaborator.CreatedTs)
+	}); err != nil {
+		return nil, err
+	}
+	return collaborator, nil
+}
+
+func (s *Store) ListShortcutCollaborators(ctx context.Context, find *FindShortcutCollaborator) ([]*ShortcutCollaborator, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			shortcut_id,
+			user_id,
+			created_ts
+		FROM shortcut_collaborator
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*ShortcutCollaborator{}
+	for rows.Next() {
+		collaborator := &ShortcutCollaborator{}
+		if err := rows.Scan(&collaborator.ShortcutID, &collaborator.UserID, &collaborator.CreatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, collaborator)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// IsShortcutCollaborator returns whether the user is a collaborator of the shortcut.
+func (s *Store) IsShortcutCollaborator(ctx context.Context, shortcutID, userID int32) (bool, error) {
+	list, err := s.ListShortcutCollaborators(ctx, &FindShortcutCollaborator{
+		ShortcutID: &shortcutID,
+		UserID:     &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(list) > 0, nil
+}
+
+func (s *Store) DeleteShortcutCollaborator(ctx context.Context, delete *DeleteShortcutCollaborator) error {
+	return s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, `DELETE FROM shortcut_collaborator WHERE shortcut_id = ? AND user_id = ?`, delete.ShortcutID, delete.UserID)
+		return err
+	})
+}
+
+// vacuumShortcutCollaborator deletes the rows whose shortcut or user no longer exists and returns the number of deleted rows.
+func vacuumShortcutCollaborator(ctx context.Context, tx *sql.Tx) (int64, error) {
+	stmt := `
+	DELETE FROM 
+		shortcut_collaborator 
+	WHERE 
+		shortcut_id NOT IN (
+			SELECT 
+				id 
+			FROM 
+				shortcut
+		)
+		OR user_id NOT IN (
+			SELECT 
+				id 
+			FROM 
+				user
+		)`
+	result, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,14 @@
+package store
+
+import (
+	"time"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// IsShortcutExpired returns whether the shortcut has expired at now. The expired shortcuts are kept,
+// but no longer redirect nor resolve, and are left out of the sitemap. Shortcuts without expiry time
+// never expire.
+func IsShortcutExpired(shortcut *storepb.Shortcut, now time.Time) bool {
+	return shortcut.ExpiresTs != 0 && shortcut.ExpiresTs <= now.Unix()
+}
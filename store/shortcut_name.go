@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrConfusableName is the cause of the validation error returned when a shortcut name looks the same as the name of another shortcut.
+var ErrConfusableName = errors.New("name is confusable with an existing shortcut")
+
+// confusableReplacer maps the Cyrillic and Greek letters that look like Latin ones to them, which
+// covers the usual homographs of the names typed by users.
+var confusableReplacer = strings.NewReplacer(
+	"а", "a", "с", "c", "ԁ", "d", "е", "e", "һ", "h", "і", "i", "ј", "j", "к", "k", "ӏ", "l",
+	"о", "o", "р", "p", "ԛ", "q", "ѕ", "s", "џ", "u", "ѵ", "v", "ԝ", "w", "х", "x", "у", "y",
+	"α", "a", "ε", "e", "ι", "i", "κ", "k", "ν", "v", "ο", "o", "ρ", "p", "τ", "t", "υ", "u", "χ", "x",
+)
+
+// NormalizeName returns the name as stored and looked up, normalized to the NFC unicode form and
+// case-folded when the profile enables them, so that the forms of a visually identical name are the
+// same shortcut. Names saved before they were enabled are only found in their saved form.
+func (s *Store) NormalizeName(name string) string {
+	if s.profile.NormalizeNames {
+		name = norm.NFC.String(name)
+	}
+	if s.profile.FoldNameCase {
+		name = cases.Fold().String(name)
+	}
+	return name
+}
+
+// getNameSkeleton returns the form shared by the names that look the same, in which the compatibility
+// characters, the case and the homographs of Latin letters are replaced.
+func getNameSkeleton(name string) string {
+	return confusableReplacer.Replace(cases.Fold().String(norm.NFKC.String(name)))
+}
+
+// checkConfusableName returns a validation error matching ErrConfusableName when the name looks the same as the name of another
+// shortcut than the one of the id, which would let a lookalike shortcut impersonate it. Names are only
+// checked when the profile normalizes them. The shortcuts are found by their stored skeleton, and the ones
+// stored without it, by older versions or seed files, get it on the first check.
+func (s *Store) checkConfusableName(ctx context.Context, name string, id int32) error {
+	if !s.profile.NormalizeNames {
+		return nil
+	}
+	skeleton := getNameSkeleton(name)
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, name_skeleton FROM shortcut WHERE id != ? AND name_skeleton IN (?, '')`, id, skeleton)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	confusableName := ""
+	missingSkeletons := map[int32]string{}
+	for rows.Next() {
+		var otherID int32
+		var otherName, otherSkeleton string
+		if err := rows.Scan(&otherID, &otherName, &otherSkeleton); err != nil {
+			return err
+		}
+		if otherSkeleton == "" {
+			otherSkeleton = getNameSkeleton(otherName)
+			missingSkeletons[otherID] = otherSkeleton
+		}
+		if otherName != name && otherSkeleton == skeleton && confusableName == "" {
+			confusableName = otherName
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	for otherID, otherSkeleton := range missingSkeletons {
+		if _, err := s.db.ExecContext(ctx, `UPDATE shortcut SET name_skeleton = ? WHERE id = ?`, otherSkeleton, otherID); err != nil {
+			return err
+		}
+	}
+	if confusableName != "" {
+		return &ValidationError{
+			Message: "invalid shortcut",
+			Fields: map[string]string{
+				"name": fmt.Sprintf("%q looks like the name of the shortcut %q", name, confusableName),
+			},
+			Err: ErrConfusableName,
+		}
+	}
+	return nil
+}
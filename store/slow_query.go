@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// closureSuffix matches the suffix of the names of the closures, e.g. ".func1".
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+$`)
+
+// timedDB times the queries of the store, and logs the ones slower than the threshold. Only the
+// name of the store method running the query is logged, never the statement's arguments, which
+// may be secrets.
+type timedDB struct {
+	*sql.DB
+	threshold time.Duration
+}
+
+func (db *timedDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	defer db.logSlow(ctx, time.Now())
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+func (db *timedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	defer db.logSlow(ctx, time.Now())
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+func (db *timedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	defer db.logSlow(ctx, time.Now())
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// logSlow logs the query when it took longer than the threshold since start. It is deferred by the
+// methods running the queries, and names the query after their caller, which is only looked up for
+// the slow queries as walking the stack is not free.
+func (db *timedDB) logSlow(ctx context.Context, start time.Time) {
+	if db.threshold <= 0 {
+		return
+	}
+	if elapsed := time.Since(start); elapsed > db.threshold {
+		slog.WarnContext(ctx, "slow query", slog.String("query", callerName(3)), slog.Duration("elapsed", elapsed))
+	}
+}
+
+// callerName returns the name of the function skip frames up the stack, without its package and
+// receiver, closures being named after the function they are in, e.g. "ListShortcuts".
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	function := runtime.FuncForPC(pc)
+	if function == nil {
+		return "unknown"
+	}
+	name := closureSuffix.ReplaceAllString(function.Name(), "")
+	return name[strings.LastIndex(name, ".")+1:]
+}
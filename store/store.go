@@ -1,32 +1,75 @@
 package store
 
 import (
+	"context"
 	"database/sql"
+	"log/slog"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/yourselfhosted/slash/server/profile"
 )
 
 // Store provides database access to all raw objects.
 type Store struct {
-	db      *sql.DB
+	db      *timedDB
 	profile *profile.Profile
 
 	workspaceSettingCache sync.Map // map[string]*WorkspaceSetting
 	userCache             sync.Map // map[int]*User
 	userSettingCache      sync.Map // map[string]*UserSetting
 	shortcutCache         sync.Map // map[int]*Shortcut
+
+	hookMutex sync.RWMutex
+	hooks     []Hook
+
+	// accessTokensMutex serializes the updates of the access tokens of users, see UpdateUserAccessTokens.
+	accessTokensMutex sync.Mutex
+
+	// fallback is the store missing shortcuts are read through to, see SetFallback.
+	fallback *Store
+
+	closeOnce sync.Once
+	closeErr  error
 }
 
 // New creates a new instance of Store.
 func New(db *sql.DB, profile *profile.Profile) *Store {
 	return &Store{
-		db:      db,
+		db:      &timedDB{DB: db, threshold: profile.SlowQueryThreshold},
 		profile: profile,
 	}
 }
 
-// Close closes the database connection.
-func (s *Store) Close() error {
-	return s.db.Close()
+// Ping checks that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// Close checkpoints the write-ahead log into the database file, drops the caches and closes the
+// database connection, after the queries in progress are done. The fallback store is closed along
+// with it. Closing a closed store does nothing and returns the error of the first close.
+func (s *Store) Close(ctx context.Context) error {
+	s.closeOnce.Do(func() {
+		// A failed checkpoint loses nothing, the log is checkpointed when the database is opened again.
+		if _, err := s.db.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+			slog.WarnContext(ctx, "failed to checkpoint the write-ahead log", "error", err)
+		}
+		s.clearCaches()
+		s.closeErr = s.db.Close()
+		if s.fallback != nil {
+			if err := s.fallback.Close(ctx); err != nil && s.closeErr == nil {
+				s.closeErr = errors.Wrap(err, "failed to close fallback store")
+			}
+		}
+	})
+	return s.closeErr
+}
+
+// clearCaches drops all the cached rows.
+func (s *Store) clearCaches() {
+	for _, cache := range []*sync.Map{&s.workspaceSettingCache, &s.userCache, &s.userSettingCache, &s.shortcutCache} {
+		clearCache(cache)
+	}
 }
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// SweepResult is the number of orphaned rows deleted by a sweep.
+type SweepResult struct {
+	Shortcuts             int64
+	UserSettings          int64
+	Activities            int64
+	ShortcutCollaborators int64
+}
+
+// Sweep deletes the rows left behind by deleted users and shortcuts, and drops the
+// cached entries so that they are reloaded from the database.
+func (s *Store) Sweep(ctx context.Context) (*SweepResult, error) {
+	result := &SweepResult{}
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if result.Shortcuts, err = vacuumShortcut(ctx, tx); err != nil {
+			return err
+		}
+		if result.UserSettings, err = vacuumUserSetting(ctx, tx); err != nil {
+			return err
+		}
+		if result.Activities, err = vacuumActivity(ctx, tx); err != nil {
+			return err
+		}
+		result.ShortcutCollaborators, err = vacuumShortcutCollaborator(ctx, tx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	clearCache(&s.shortcutCache)
+	clearCache(&s.userSettingCache)
+	return result, nil
+}
+
+// PruneResult is the number of rows deleted by a prune of the archived shortcuts.
+type PruneResult struct {
+	Shortcuts             int64
+	Activities            int64
+	ShortcutCollaborators int64
+}
+
+// PruneArchivedShortcuts permanently deletes the shortcuts archived before the time, then the
+// activities and collaborators they leave behind. The shortcuts restored meanwhile are kept.
+func (s *Store) PruneArchivedShortcuts(ctx context.Context, archivedBefore int64) (*PruneResult, error) {
+	// The shortcuts are listed first for the hooks, which are notified with the deleted shortcuts.
+	list, err := s.ListShortcuts(ctx, &FindShortcut{
+		ArchivedBefore: &archivedBefore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PruneResult{}
+	pruned := []*storepb.Shortcut{}
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		*result, pruned = PruneResult{}, []*storepb.Shortcut{}
+		for _, shortcut := range list {
+			if err := recordShortcutTombstones(ctx, tx, `id = ? AND row_status = ? AND archived_ts < ?`, shortcut.Id, Archived, archivedBefore); err != nil {
+				return err
+			}
+			deleted, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ? AND row_status = ? AND archived_ts < ?`, shortcut.Id, Archived, archivedBefore)
+			if err != nil {
+				return err
+			}
+			count, err := deleted.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				continue
+			}
+			result.Shortcuts++
+			pruned = append(pruned, shortcut)
+		}
+		var err error
+		if result.Activities, err = vacuumActivity(ctx, tx); err != nil {
+			return err
+		}
+		result.ShortcutCollaborators, err = vacuumShortcutCollaborator(ctx, tx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, shortcut := range pruned {
+		s.shortcutCache.Delete(shortcut.Id)
+		s.notifyShortcut(ctx, EventShortcutDeleted, shortcut)
+	}
+	return result, nil
+}
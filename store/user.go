@@ -2,7 +2,9 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -16,6 +18,9 @@ const (
 	RoleUser Role = "USER"
 )
 
+// ErrLastAdmin is returned when an operation would leave the workspace without an admin.
+var ErrLastAdmin = errors.New("cannot remove the last admin user")
+
 type User struct {
 	ID int32
 
@@ -47,6 +52,52 @@ type FindUser struct {
 	Email     *string
 	Nickname  *string
 	Role      *Role
+
+	// Search matches the users whose email or nickname contains the given value.
+	Search *string
+	// CreatedTsAfter matches the users created at or after the unix timestamp.
+	CreatedTsAfter *int64
+	// CreatedTsBefore matches the users created before the unix timestamp.
+	CreatedTsBefore *int64
+	// UpdatedTsAfter matches the users updated at or after the unix timestamp.
+	UpdatedTsAfter *int64
+	// UpdatedTsBefore matches the users updated before the unix timestamp.
+	UpdatedTsBefore *int64
+	// OrderBy is the column to order users by, defaults to the most recently updated first.
+	OrderBy   UserOrderBy
+	OrderDesc bool
+}
+
+// UserOrderBy is the column to order users by.
+type UserOrderBy string
+
+const (
+	// UserOrderByEmail orders users by email.
+	UserOrderByEmail UserOrderBy = "email"
+	// UserOrderByNickname orders users by nickname.
+	UserOrderByNickname UserOrderBy = "nickname"
+	// UserOrderByCreatedTs orders users by created time.
+	UserOrderByCreatedTs UserOrderBy = "created_ts"
+	// UserOrderByUpdatedTs orders users by updated time.
+	UserOrderByUpdatedTs UserOrderBy = "updated_ts"
+)
+
+// userOrderByColumns is the allowlist of columns users can be ordered by.
+var userOrderByColumns = map[UserOrderBy]string{
+	UserOrderByEmail:     "email",
+	UserOrderByNickname:  "nickname",
+	UserOrderByCreatedTs: "created_ts",
+	UserOrderByUpdatedTs: "updated_ts",
+}
+
+// IsValidUserOrderBy returns whether the users can be ordered by the given column.
+// An empty value is valid and means the default order.
+func IsValidUserOrderBy(orderBy UserOrderBy) bool {
+	if orderBy == "" {
+		return true
+	}
+	_, ok := userOrderByColumns[orderBy]
+	return ok
 }
 
 type DeleteUser struct {
@@ -64,22 +115,25 @@ func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
 		VALUES (?, ?, ?, ?)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
-	if err := s.db.QueryRowContext(ctx, stmt,
-		create.Email,
-		create.Nickname,
-		create.PasswordHash,
-		create.Role,
-	).Scan(
-		&create.ID,
-		&create.CreatedTs,
-		&create.UpdatedTs,
-		&create.RowStatus,
-	); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		return s.db.QueryRowContext(ctx, stmt,
+			create.Email,
+			create.Nickname,
+			create.PasswordHash,
+			create.Role,
+		).Scan(
+			&create.ID,
+			&create.CreatedTs,
+			&create.UpdatedTs,
+			&create.RowStatus,
+		)
+	}); err != nil {
 		return nil, err
 	}
 
 	user := create
 	s.userCache.Store(user.ID, user)
+	s.notifyUser(ctx, EventUserCreated, user)
 	return user, nil
 }
 
@@ -112,41 +166,51 @@ func (s *Store) UpdateUser(ctx context.Context, update *UpdateUser) (*User, erro
 		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role
 	`
 	args = append(args, update.ID)
+
 	user := &User{}
-	if err := s.db.QueryRowContext(ctx, stmt, args...).Scan(
-		&user.ID,
-		&user.CreatedTs,
-		&user.UpdatedTs,
-		&user.RowStatus,
-		&user.Email,
-		&user.Nickname,
-		&user.PasswordHash,
-		&user.Role,
-	); err != nil {
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		adminCount, err := countActiveAdmins(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
+			&user.ID,
+			&user.CreatedTs,
+			&user.UpdatedTs,
+			&user.RowStatus,
+			&user.Email,
+			&user.Nickname,
+			&user.PasswordHash,
+			&user.Role,
+		); err != nil {
+			return err
+		}
+
+		return checkAdminRemains(ctx, tx, adminCount)
+	}); err != nil {
 		return nil, err
 	}
 
 	s.userCache.Store(user.ID, user)
+	s.notifyUser(ctx, EventUserUpdated, user)
 	return user, nil
 }
 
 func (s *Store) ListUsers(ctx context.Context, find *FindUser) ([]*User, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := buildUserWhere(find)
 
-	if v := find.ID; v != nil {
-		where, args = append(where, "id = ?"), append(args, *v)
-	}
-	if v := find.RowStatus; v != nil {
-		where, args = append(where, "row_status = ?"), append(args, v.String())
-	}
-	if v := find.Email; v != nil {
-		where, args = append(where, "email = ?"), append(args, *v)
-	}
-	if v := find.Nickname; v != nil {
-		where, args = append(where, "nickname = ?"), append(args, *v)
-	}
-	if v := find.Role; v != nil {
-		where, args = append(where, "role = ?"), append(args, *v)
+	orderBy := "updated_ts DESC, created_ts DESC"
+	if find.OrderBy != "" {
+		column, ok := userOrderByColumns[find.OrderBy]
+		if !ok {
+			return nil, errors.New("invalid user order by column")
+		}
+		direction := "ASC"
+		if find.OrderDesc {
+			direction = "DESC"
+		}
+		orderBy = fmt.Sprintf("%s %s, id %s", column, direction, direction)
 	}
 
 	query := `
@@ -161,8 +225,7 @@ func (s *Store) ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 			role
 		FROM user
 		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY updated_ts DESC, created_ts DESC
-	`
+		ORDER BY ` + orderBy
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -198,6 +261,58 @@ func (s *Store) ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 	return list, nil
 }
 
+// CountUsers returns the number of users matching the find conditions.
+func (s *Store) CountUsers(ctx context.Context, find *FindUser) (int, error) {
+	where, args := buildUserWhere(find)
+	var count int
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM user
+		WHERE `+strings.Join(where, " AND "),
+		args...,
+	).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func buildUserWhere(find *FindUser) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
+	if v := find.Email; v != nil {
+		where, args = append(where, "email = ?"), append(args, *v)
+	}
+	if v := find.Nickname; v != nil {
+		where, args = append(where, "nickname = ?"), append(args, *v)
+	}
+	if v := find.Role; v != nil {
+		where, args = append(where, "role = ?"), append(args, *v)
+	}
+	if v := find.Search; v != nil && *v != "" {
+		pattern := "%" + escapeLikePattern(*v) + "%"
+		where, args = append(where, `(email LIKE ? ESCAPE '\' OR nickname LIKE ? ESCAPE '\')`), append(args, pattern, pattern)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "updated_ts >= ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "updated_ts < ?"), append(args, *v)
+	}
+	return where, args
+}
+
 func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 	if find.ID != nil {
 		if cache, ok := s.userCache.Load(*find.ID); ok {
@@ -218,31 +333,80 @@ func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 }
 
 func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
+	// The user is only looked up for the hooks, which are notified with the deleted user.
+	var user *User
+	if s.hasHooks() {
+		var err error
+		user, err = s.GetUser(ctx, &FindUser{
+			ID: &delete.ID,
+		})
+		if err != nil {
+			return err
+		}
 	}
-	defer tx.Rollback()
 
-	if _, err := tx.ExecContext(ctx, `
-		DELETE FROM user WHERE id = ?
-	`, delete.ID); err != nil {
+	if err := s.runTx(ctx, func(tx *sql.Tx) error {
+		adminCount, err := countActiveAdmins(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			DELETE FROM user WHERE id = ?
+		`, delete.ID); err != nil {
+			return err
+		}
+
+		if err := checkAdminRemains(ctx, tx, adminCount); err != nil {
+			return err
+		}
+
+		if _, err := vacuumUserSetting(ctx, tx); err != nil {
+			return err
+		}
+
+		if _, err := vacuumShortcut(ctx, tx); err != nil {
+			return err
+		}
+
+		_, err = vacuumShortcutCollaborator(ctx, tx)
+		return err
+	}); err != nil {
 		return err
 	}
 
-	if err := vacuumUserSetting(ctx, tx); err != nil {
-		return err
+	s.userCache.Delete(delete.ID)
+	s.deleteUserSettingCache(delete.ID)
+	if user != nil {
+		s.notifyUser(ctx, EventUserDeleted, user)
 	}
 
-	if err := vacuumShortcut(ctx, tx); err != nil {
-		return err
+	return nil
+}
+
+// countActiveAdmins returns the number of admin users that are not archived.
+func countActiveAdmins(ctx context.Context, tx *sql.Tx) (int, error) {
+	var count int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM user WHERE role = ? AND row_status = ?
+	`, RoleAdmin, Normal).Scan(&count); err != nil {
+		return 0, err
 	}
+	return count, nil
+}
 
-	if err := tx.Commit(); err != nil {
+// checkAdminRemains returns ErrLastAdmin if a write within the transaction removed the last active admin.
+// It runs within the transaction of the write, so SQLite's write lock keeps it race-safe.
+func checkAdminRemains(ctx context.Context, tx *sql.Tx, countBefore int) error {
+	if countBefore == 0 {
+		return nil
+	}
+	count, err := countActiveAdmins(ctx, tx)
+	if err != nil {
 		return err
 	}
-
-	s.userCache.Delete(delete.ID)
-
+	if count == 0 {
+		return ErrLastAdmin
+	}
 	return nil
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
@@ -16,6 +19,11 @@ type FindUserSetting struct {
 	Key    storepb.UserSettingKey
 }
 
+type DeleteUserSetting struct {
+	UserID int32
+	Key    storepb.UserSettingKey
+}
+
 func (s *Store) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
 	stmt := `
 		INSERT INTO user_setting (
@@ -36,11 +44,20 @@ func (s *Store) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetti
 		valueString = upsert.GetLocale().String()
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_COLOR_THEME {
 		valueString = upsert.GetColorTheme().String()
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_PREFERENCES {
+		valueBytes, err := protojson.Marshal(upsert.GetPreferences())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid user setting key")
 	}
 
-	if _, err := s.db.ExecContext(ctx, stmt, upsert.UserId, upsert.Key.String(), valueString); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, stmt, upsert.UserId, upsert.Key.String(), valueString)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
@@ -100,6 +117,14 @@ func (s *Store) ListUserSettings(ctx context.Context, find *FindUserSetting) ([]
 			userSetting.Value = &storepb.UserSetting_ColorTheme{
 				ColorTheme: storepb.ColorThemeUserSetting(storepb.ColorThemeUserSetting_value[valueString]),
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_PREFERENCES {
+			preferencesUserSetting := &storepb.PreferencesUserSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), preferencesUserSetting); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Preferences{
+				Preferences: preferencesUserSetting,
+			}
 		} else {
 			return nil, errors.New("invalid user setting key")
 		}
@@ -137,7 +162,30 @@ func (s *Store) GetUserSetting(ctx context.Context, find *FindUserSetting) (*sto
 	return userSetting, nil
 }
 
-func vacuumUserSetting(ctx context.Context, tx *sql.Tx) error {
+// DeleteUserSetting deletes the setting of the user, which then reads as unset.
+func (s *Store) DeleteUserSetting(ctx context.Context, delete *DeleteUserSetting) error {
+	if err := s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, `
+			DELETE FROM user_setting WHERE user_id = ? AND key = ?
+		`, delete.UserID, delete.Key.String())
+		return err
+	}); err != nil {
+		return err
+	}
+
+	s.userSettingCache.Delete(getUserSettingCacheKey(delete.UserID, delete.Key.String()))
+	return nil
+}
+
+// deleteUserSettingCache drops the cached settings of the user, so that those of a deleted user are not read from the cache.
+func (s *Store) deleteUserSettingCache(userID int32) {
+	for key := range storepb.UserSettingKey_value {
+		s.userSettingCache.Delete(getUserSettingCacheKey(userID, key))
+	}
+}
+
+// vacuumUserSetting deletes the rows whose user no longer exists and returns the number of deleted rows.
+func vacuumUserSetting(ctx context.Context, tx *sql.Tx) (int64, error) {
 	stmt := `
 	DELETE FROM 
 		user_setting 
@@ -148,12 +196,12 @@ func vacuumUserSetting(ctx context.Context, tx *sql.Tx) error {
 			FROM 
 				user
 		)`
-	_, err := tx.ExecContext(ctx, stmt)
+	result, err := tx.ExecContext(ctx, stmt)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return result.RowsAffected()
 }
 
 // GetUserAccessTokens returns the access tokens of the user.
@@ -172,3 +220,54 @@ func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*store
 	accessTokensUserSetting := userSetting.GetAccessTokens()
 	return accessTokensUserSetting.AccessTokens, nil
 }
+
+// UpdateUserAccessTokens replaces the access tokens of the user with those returned by update, which
+// is passed copies of the current ones. The updates are serialized, so that concurrent updates, such
+// as a sign-in recording a new token while another token is revoked, don't overwrite each other.
+func (s *Store) UpdateUserAccessTokens(ctx context.Context, userID int32, update func(accessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken) error {
+	s.accessTokensMutex.Lock()
+	defer s.accessTokensMutex.Unlock()
+
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	copies := make([]*storepb.AccessTokensUserSetting_AccessToken, 0, len(accessTokens))
+	for _, accessToken := range accessTokens {
+		copies = append(copies, proto.Clone(accessToken).(*storepb.AccessTokensUserSetting_AccessToken))
+	}
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: update(copies),
+			},
+		},
+	})
+	return err
+}
+
+// accessTokenLastUsedInterval is the interval at which the last use of an access token is recorded,
+// so that a burst of requests doesn't write the access tokens of the user on each request.
+const accessTokenLastUsedInterval = time.Minute
+
+// RecordAccessTokenUse records the last use of the access token, one of the access tokens of the user,
+// when it was not recorded within accessTokenLastUsedInterval.
+func (s *Store) RecordAccessTokenUse(ctx context.Context, userID int32, accessToken string, accessTokens []*storepb.AccessTokensUserSetting_AccessToken) error {
+	now := time.Now()
+	stale := slices.ContainsFunc(accessTokens, func(userAccessToken *storepb.AccessTokensUserSetting_AccessToken) bool {
+		return userAccessToken.AccessToken == accessToken && now.Sub(time.Unix(userAccessToken.LastUsedTs, 0)) >= accessTokenLastUsedInterval
+	})
+	if !stale {
+		return nil
+	}
+	return s.UpdateUserAccessTokens(ctx, userID, func(userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+		for _, userAccessToken := range userAccessTokens {
+			if userAccessToken.AccessToken == accessToken {
+				userAccessToken.LastUsedTs = now.Unix()
+			}
+		}
+		return userAccessTokens
+	})
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidationError is returned when data is rejected as invalid, with the error of each invalid
+// field keyed by its name. The store and the APIs return it alike, and the APIs respond to it
+// with a 400 listing the fields.
+type ValidationError struct {
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields"`
+	// Err is the cause of the error, such as ErrConfusableName, which errors.Is matches.
+	Err error `json:"-"`
+}
+
+// NewValidationError returns the validation error of the invalid fields.
+func NewValidationError(message string, fields map[string]string) *ValidationError {
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	return &ValidationError{
+		Message: message,
+		Fields:  fields,
+	}
+}
+
+// Error returns the message followed by the error of each field, sorted by field.
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field, message := range e.Fields {
+		fields = append(fields, fmt.Sprintf("%s: %s", field, message))
+	}
+	if len(fields) == 0 {
+		return e.Message
+	}
+	sort.Strings(fields)
+	return fmt.Sprintf("%s, %s", e.Message, strings.Join(fields, ", "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON marshals the message and the fields, so that the error is not marshaled as its string.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	type validationError ValidationError
+	v := validationError(*e)
+	if v.Fields == nil {
+		v.Fields = map[string]string{}
+	}
+	return json.Marshal(&v)
+}
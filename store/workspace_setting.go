@@ -42,11 +42,76 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE {
+		valueString = strconv.Itoa(int(upsert.GetRedirectCacheMaxAge()))
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW {
+		valueString = upsert.GetUniqueVisitorWindow().String()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA {
+		valueBytes, err := protojson.Marshal(upsert.GetDefaultOgMetadata())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE {
+		valueString = upsert.GetDisabledShortcutMessage()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL {
+		valueString = strconv.FormatBool(upsert.GetRequirePublicShortcutApproval())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS {
+		valueString = strconv.FormatBool(upsert.GetHashVisitorIps())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR {
+		valueBytes, err := protojson.Marshal(upsert.GetLinkDecorator())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS {
+		valueString = strconv.FormatBool(upsert.GetRequireAuthForAllRedirects())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL {
+		valueBytes, err := protojson.Marshal(upsert.GetInterstitial())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN {
+		valueString = upsert.GetCustomDomain()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN {
+		valueBytes, err := protojson.Marshal(upsert.GetInternalChain())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS {
+		valueBytes, err := protojson.Marshal(upsert.GetRedirectStatus())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT {
+		valueBytes, err := protojson.Marshal(upsert.GetLoginRedirect())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES {
+		valueBytes, err := protojson.Marshal(upsert.GetPrefixRoutes())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE {
+		valueString = upsert.GetTimezone()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE {
+		valueString = upsert.GetInvalidLinkMode().String()
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS {
+		valueString = strconv.FormatBool(upsert.GetAnonymizeVisitors())
 	} else {
 		return nil, errors.New("invalid workspace setting key")
 	}
 
-	if _, err := s.db.ExecContext(ctx, stmt, upsert.Key.String(), valueString); err != nil {
+	if err := s.retryOnLock(ctx, func() error {
+		_, err := s.db.ExecContext(ctx, stmt, upsert.Key.String(), valueString)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
@@ -106,6 +171,88 @@ func (s *Store) ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSe
 				return nil, err
 			}
 			workspaceSetting.Value = &storepb.WorkspaceSetting_AutoBackup{AutoBackup: autoBackupSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE {
+			maxAge, err := strconv.Atoi(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RedirectCacheMaxAge{RedirectCacheMaxAge: int32(maxAge)}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_UniqueVisitorWindow{UniqueVisitorWindow: storepb.UniqueVisitorWindow(storepb.UniqueVisitorWindow_value[valueString])}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DEFAULT_OG_METADATA {
+			defaultOgMetadata := &storepb.OpenGraphMetadata{}
+			if err := protojson.Unmarshal([]byte(valueString), defaultOgMetadata); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_DefaultOgMetadata{DefaultOgMetadata: defaultOgMetadata}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_DisabledShortcutMessage{DisabledShortcutMessage: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL {
+			requireApproval, err := strconv.ParseBool(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RequirePublicShortcutApproval{RequirePublicShortcutApproval: requireApproval}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS {
+			hashVisitorIPs, err := strconv.ParseBool(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_HashVisitorIps{HashVisitorIps: hashVisitorIPs}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LINK_DECORATOR {
+			linkDecorator := &storepb.LinkDecorator{}
+			if err := protojson.Unmarshal([]byte(valueString), linkDecorator); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_LinkDecorator{LinkDecorator: linkDecorator}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS {
+			requireAuth, err := strconv.ParseBool(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RequireAuthForAllRedirects{RequireAuthForAllRedirects: requireAuth}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERSTITIAL {
+			interstitial := &storepb.InterstitialWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), interstitial); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Interstitial{Interstitial: interstitial}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_CustomDomain{CustomDomain: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INTERNAL_CHAIN {
+			internalChain := &storepb.InternalChainWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), internalChain); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_InternalChain{InternalChain: internalChain}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_STATUS {
+			redirectStatus := &storepb.RedirectStatusWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), redirectStatus); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RedirectStatus{RedirectStatus: redirectStatus}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LOGIN_REDIRECT {
+			loginRedirect := &storepb.LoginRedirectWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), loginRedirect); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_LoginRedirect{LoginRedirect: loginRedirect}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PREFIX_ROUTES {
+			prefixRoutes := &storepb.PrefixRoutesWorkspaceSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), prefixRoutes); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_PrefixRoutes{PrefixRoutes: prefixRoutes}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Timezone{Timezone: valueString}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_INVALID_LINK_MODE {
+			workspaceSetting.Value = &storepb.WorkspaceSetting_InvalidLinkMode{InvalidLinkMode: storepb.InvalidLinkMode(storepb.InvalidLinkMode_value[valueString])}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS {
+			anonymizeVisitors, err := strconv.ParseBool(valueString)
+			if err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_AnonymizeVisitors{AnonymizeVisitors: anonymizeVisitors}
 		} else {
 			continue
 		}
@@ -0,0 +1,763 @@
+package testserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestShortcutAnalyticsExport(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	adminSignUp := &apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(adminSignUp)
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	// Seed 3 views on the first day from 2 IPs, and 1 view on the third day.
+	dayStart := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	views := []struct {
+		ip        string
+		createdAt time.Time
+	}{
+		{ip: "1.1.1.1", createdAt: dayStart.Add(time.Hour)},
+		{ip: "1.1.1.1", createdAt: dayStart.Add(2 * time.Hour)},
+		{ip: "2.2.2.2", createdAt: dayStart.Add(3 * time.Hour)},
+		{ip: "1.1.1.1", createdAt: dayStart.AddDate(0, 0, 2)},
+	}
+	for _, view := range views {
+		payload, err := json.Marshal(&apiv1.ActivityShorcutViewPayload{
+			ShortcutID: shortcut.ID,
+			IP:         view.ip,
+		})
+		require.NoError(t, err)
+		_, err = s.server.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: apiv1.BotID,
+			CreatedTs: view.createdAt.Unix(),
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		})
+		require.NoError(t, err)
+	}
+
+	exportURL := fmt.Sprintf("/api/v1/shortcuts/%d/analytics:export", shortcut.ID)
+	records, err := s.getCSV(exportURL, map[string]string{"from": "2023-10-01", "to": "2023-10-31", "format": "csv"})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"date", "views", "unique_visitors"},
+		{"2023-10-01", "3", "2"},
+		{"2023-10-03", "1", "1"},
+	}, records)
+
+	// The to date is inclusive.
+	records, err = s.getCSV(exportURL, map[string]string{"from": "2023-10-01", "to": "2023-10-01"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+
+	records, err = s.getCSV(exportURL, map[string]string{"raw": "true"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "created_at", "ip", "referer", "user_agent"}, records[0])
+	require.Equal(t, 5, len(records))
+	require.Equal(t, "1.1.1.1", records[1][2])
+
+	// The IPs are hashed when the workspace hashes visitor IPs.
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+		Value: &storepb.WorkspaceSetting_HashVisitorIps{
+			HashVisitorIps: true,
+		},
+	})
+	require.NoError(t, err)
+	records, err = s.getCSV(exportURL, map[string]string{"raw": "true"})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(records))
+	require.NotEqual(t, "1.1.1.1", records[1][2])
+	require.Equal(t, records[1][2], records[2][2])
+	require.NotEqual(t, records[1][2], records[3][2])
+
+	_, err = s.getCSV(exportURL, map[string]string{"format": "xlsx"})
+	require.ErrorContains(t, err, "400")
+	_, err = s.getCSV(exportURL, map[string]string{"from": "2023-10-31", "to": "2023-10-01"})
+	require.ErrorContains(t, err, "400")
+
+	// Other users can neither export the shortcut's analytics nor raw events.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.getCSV(exportURL, nil)
+	require.ErrorContains(t, err, "403")
+	userShortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "user",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	userExportURL := fmt.Sprintf("/api/v1/shortcuts/%d/analytics:export", userShortcut.ID)
+	records, err = s.getCSV(userExportURL, nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"date", "views", "unique_visitors"}}, records)
+	_, err = s.getCSV(userExportURL, map[string]string{"raw": "true"})
+	require.ErrorContains(t, err, "403")
+}
+
+func TestShortcutActivityStream(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	for _, ip := range []string{"1.1.1.1", "2.2.2.2"} {
+		payload, err := json.Marshal(&apiv1.ActivityShorcutViewPayload{
+			ShortcutID: shortcut.ID,
+			IP:         ip,
+			Referer:    "https://example.com",
+		})
+		require.NoError(t, err)
+		_, err = s.server.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: apiv1.BotID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		})
+		require.NoError(t, err)
+	}
+
+	streamURL := fmt.Sprintf("/api/v1/shortcuts/%d/activities:stream", shortcut.ID)
+	records, err := s.getJSONLines(streamURL, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, shortcut.ID, records[0].ShortcutID)
+	require.Equal(t, "1.1.1.1", records[0].IP)
+	require.Equal(t, "https://example.com", records[0].Referer)
+	require.Equal(t, "2.2.2.2", records[1].IP)
+
+	// Followed streams also send the views made while they are open.
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		resp, err := s.getResponse("/s/test", nil)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	records, err = s.getJSONLines(streamURL, map[string]string{"follow": "2s"})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	require.Greater(t, records[2].ID, records[1].ID)
+
+	// The views reach the client as they are streamed, not once the stream is done following.
+	start := time.Now()
+	body, err := s.get(streamURL, map[string]string{"follow": "2s"})
+	require.NoError(t, err)
+	scanner := bufio.NewScanner(body)
+	require.True(t, scanner.Scan())
+	require.Less(t, time.Since(start), time.Second)
+	body.Close()
+
+	// The IPs are hashed when the workspace hashes visitor IPs.
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+		Value: &storepb.WorkspaceSetting_HashVisitorIps{
+			HashVisitorIps: true,
+		},
+	})
+	require.NoError(t, err)
+	records, err = s.getJSONLines(streamURL, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	require.NotEqual(t, "1.1.1.1", records[0].IP)
+
+	_, err = s.getJSONLines(streamURL, map[string]string{"follow": "1h"})
+	require.ErrorContains(t, err, "400")
+
+	// Other users cannot stream the shortcut's activities.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.getJSONLines(streamURL, nil)
+	require.ErrorContains(t, err, "403")
+}
+
+// getJSONLines returns the records of a JSON Lines response, checking that every line is a JSON object.
+func (s *TestingServer) getJSONLines(url string, params map[string]string) ([]*apiv1.ShortcutActivityRecord, error) {
+	body, err := s.get(url, params)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	records := []*apiv1.ShortcutActivityRecord{}
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		record := &apiv1.ShortcutActivityRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+func (s *TestingServer) getCSV(url string, params map[string]string) ([][]string, error) {
+	body, err := s.get(url, params)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return csv.NewReader(body).ReadAll()
+}
+
+func TestUserActivity(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	other, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "other",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	first, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "first",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	second, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "second",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, view := range []struct {
+		shortcutID int32
+		referer    string
+		createdAt  time.Time
+	}{
+		{shortcutID: first.ID, referer: "https://a.com", createdAt: now.Add(-time.Hour)},
+		{shortcutID: first.ID, referer: "https://a.com", createdAt: now.Add(-2 * time.Hour)},
+		{shortcutID: first.ID, referer: "https://b.com", createdAt: now.Add(-3 * time.Hour)},
+		{shortcutID: second.ID, referer: "", createdAt: now.Add(-4 * time.Hour)},
+		// Views older than a day are only counted with more days.
+		{shortcutID: second.ID, referer: "https://c.com", createdAt: now.AddDate(0, 0, -3)},
+		// Views of other users' shortcuts are never counted.
+		{shortcutID: other.ID, referer: "https://other.com", createdAt: now.Add(-time.Hour)},
+	} {
+		payload, err := json.Marshal(&apiv1.ActivityShorcutViewPayload{
+			ShortcutID: view.shortcutID,
+			IP:         "1.1.1.1",
+			Referer:    view.referer,
+		})
+		require.NoError(t, err)
+		_, err = s.server.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: apiv1.BotID,
+			CreatedTs: view.createdAt.Unix(),
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		})
+		require.NoError(t, err)
+	}
+
+	activity, err := s.getUserActivity(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, activity.Days)
+	require.Equal(t, 4, activity.TotalViews)
+	require.Equal(t, 2, len(activity.ShortcutViews))
+	require.Equal(t, "first", activity.ShortcutViews[0].Name)
+	require.Equal(t, 3, activity.ShortcutViews[0].Count)
+	require.Equal(t, now.Add(-time.Hour).Unix(), activity.ShortcutViews[0].LastViewedTs)
+	require.Equal(t, "second", activity.ShortcutViews[1].Name)
+	require.Equal(t, 1, activity.ShortcutViews[1].Count)
+	require.Equal(t, []apiv1.ReferenceInfo{
+		{Name: "https://a.com", Count: 2},
+		{Name: "https://b.com", Count: 1},
+	}, activity.RecentReferers)
+
+	activity, err = s.getUserActivity(map[string]string{"days": "7"})
+	require.NoError(t, err)
+	require.Equal(t, 5, activity.TotalViews)
+	for _, shortcutView := range activity.ShortcutViews {
+		require.NotEqual(t, other.ID, shortcutView.ShortcutID)
+	}
+
+	_, err = s.getUserActivity(map[string]string{"days": "0"})
+	require.ErrorContains(t, err, "400")
+
+	// Other users only see the activity of their own shortcuts.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	activity, err = s.getUserActivity(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, activity.TotalViews)
+	require.Equal(t, 1, len(activity.ShortcutViews))
+	require.Equal(t, other.ID, activity.ShortcutViews[0].ShortcutID)
+	require.NotEqual(t, user.ID, other.CreatorID)
+}
+
+func (s *TestingServer) getUserActivity(params map[string]string) (*apiv1.UserActivity, error) {
+	body, err := s.get("/api/v1/me/activity", params)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	activity := &apiv1.UserActivity{}
+	if err := json.NewDecoder(body).Decode(activity); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+func TestShortcutAnalyticsParsedUserAgents(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ParseUserAgents = true
+	profile.DiscardRawUserAgents = true
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	iPhone := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+	resp, err := s.getResponse("/s/test", map[string]string{"User-Agent": iPhone})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The parsed fields are recorded instead of the raw user agent.
+	activities, err := s.server.Store.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	payload := &apiv1.ActivityShorcutViewPayload{}
+	require.NoError(t, json.Unmarshal([]byte(activities[0].Payload), payload))
+	require.Empty(t, payload.UserAgent)
+	require.Equal(t, "Safari", payload.Browser)
+	require.Equal(t, "iPhone OS", payload.OS)
+	require.Equal(t, "mobile", payload.DeviceType)
+
+	// The views recorded before parsing are parsed by the analytics, along with the parsed ones.
+	payloadBytes, err := json.Marshal(&apiv1.ActivityShorcutViewPayload{
+		ShortcutID: shortcut.ID,
+		UserAgent:  "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+	})
+	require.NoError(t, err)
+	_, err = s.server.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: apiv1.BotID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadBytes),
+	})
+	require.NoError(t, err)
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcut/%d/analytics", shortcut.ID), nil)
+	require.NoError(t, err)
+	analytics := &apiv1.AnalysisData{}
+	require.NoError(t, json.NewDecoder(body).Decode(analytics))
+	require.Equal(t, 2, analytics.TotalViews)
+	require.ElementsMatch(t, []apiv1.BrowserInfo{{Name: "Safari", Count: 1}, {Name: "Chrome", Count: 1}}, analytics.BrowserData)
+	require.ElementsMatch(t, []apiv1.DeviceInfo{{Name: "iPhone OS", Count: 1}, {Name: "Windows", Count: 1}}, analytics.DeviceData)
+}
+
+func TestShortcutAnalyticsAnonymizedVisitors(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ParseUserAgents = true
+	profile.RedirectLog = filepath.Join(t.TempDir(), "redirects.log")
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	iPhone := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+	visit := func() {
+		resp, err := s.getResponse("/s/test", map[string]string{"User-Agent": iPhone, "Referer": "https://example.com/"})
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	visit()
+
+	// Nothing telling the visitors apart is recorded once the workspace anonymizes them.
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ANONYMIZE_VISITORS,
+		Value: &storepb.WorkspaceSetting_AnonymizeVisitors{
+			AnonymizeVisitors: true,
+		},
+	})
+	require.NoError(t, err)
+	visit()
+	visit()
+
+	activities, err := s.server.Store.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 3)
+	payloads := []*apiv1.ActivityShorcutViewPayload{}
+	for _, activity := range activities {
+		payload := &apiv1.ActivityShorcutViewPayload{}
+		require.NoError(t, json.Unmarshal([]byte(activity.Payload), payload))
+		payloads = append(payloads, payload)
+	}
+	anonymized := 0
+	for _, payload := range payloads {
+		require.Equal(t, shortcut.ID, payload.ShortcutID)
+		require.Equal(t, "https://example.com/", payload.Referer)
+		if payload.IP == "" {
+			anonymized++
+			require.Empty(t, payload.UserAgent)
+			require.Empty(t, payload.Browser)
+			require.Empty(t, payload.OS)
+			require.Empty(t, payload.DeviceType)
+		} else {
+			require.Equal(t, iPhone, payload.UserAgent)
+			require.Equal(t, "Safari", payload.Browser)
+		}
+	}
+	require.Equal(t, 2, anonymized)
+
+	// The anonymous views are still counted.
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcut/%d/analytics", shortcut.ID), nil)
+	require.NoError(t, err)
+	analytics := &apiv1.AnalysisData{}
+	require.NoError(t, json.NewDecoder(body).Decode(analytics))
+	require.Equal(t, 3, analytics.TotalViews)
+
+	data, err := os.ReadFile(profile.RedirectLog)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for i, data := range lines {
+		line := &apiv1.RedirectLogLine{}
+		require.NoError(t, json.Unmarshal([]byte(data), line))
+		require.Equal(t, "https://example.com/", line.Referer)
+		if i == 0 {
+			require.NotEmpty(t, line.IP)
+			require.Equal(t, iPhone, line.UserAgent)
+		} else {
+			require.Empty(t, line.IP)
+			require.Empty(t, line.UserAgent)
+		}
+	}
+}
+
+func TestAnalyticsPause(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.AnalyticsPauseBufferSize = 2
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	follow := func(times int) {
+		for i := 0; i < times; i++ {
+			resp, err := s.getResponse("/s/test", nil)
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+		}
+	}
+	countViews := func() int {
+		activities, err := s.server.Store.ListActivities(ctx, &store.FindActivity{
+			Type: store.ActivityShortcutView,
+		})
+		require.NoError(t, err)
+		return len(activities)
+	}
+	lastViewedTs := func() int64 {
+		name := "test"
+		shortcut, err := s.server.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		require.NoError(t, err)
+		return shortcut.LastViewedTs
+	}
+
+	pause, err := s.analyticsPause(http.MethodPost)
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.AnalyticsPause{Paused: true}, pause)
+
+	// The views are buffered while paused, and those past the buffer are dropped and counted.
+	follow(3)
+	require.Zero(t, countViews())
+	pause, err = s.analyticsPause(http.MethodGet)
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.AnalyticsPause{Paused: true, Buffered: 2, Dropped: 1}, pause)
+	// So is the last view time, the shortcuts being left as they are.
+	require.Zero(t, lastViewedTs())
+
+	// Resuming records the buffered views, and the views are recorded again right away.
+	pause, err = s.analyticsPause(http.MethodDelete)
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.AnalyticsPause{Dropped: 1}, pause)
+	require.Equal(t, 2, countViews())
+	require.NotZero(t, lastViewedTs())
+	follow(1)
+	require.Equal(t, 3, countViews())
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.analyticsPause(http.MethodPost)
+	require.ErrorContains(t, err, "403")
+}
+
+func TestAnalyticsPauseShutdown(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	_, err = s.analyticsPause(http.MethodPost)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resp, err := s.getResponse("/s/test", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// The views buffered while paused are recorded when the server shuts down.
+	s.Shutdown(ctx)
+	reopenedDB := db.NewDB(profile)
+	require.NoError(t, reopenedDB.Open(ctx))
+	reopenedStore := store.New(reopenedDB.DBInstance, profile)
+	defer reopenedStore.Close(ctx)
+	activities, err := reopenedStore.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 2)
+}
+
+func (s *TestingServer) analyticsPause(method string) (*apiv1.AnalyticsPause, error) {
+	body, err := s.request(method, "/api/v1/workspace/analytics/pause", nil, nil, map[string]string{
+		"Cookie": s.cookie,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	pause := &apiv1.AnalyticsPause{}
+	if err := json.NewDecoder(body).Decode(pause); err != nil {
+		return nil, err
+	}
+	return pause, nil
+}
+
+func TestShortcutConversion(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+		Goal:       "signup",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "signup", shortcut.Goal)
+	noGoal, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "no-goal",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		resp, err := s.getResponse("/s/test", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	conversion, err := s.getShortcutConversion(shortcut.ID)
+	require.NoError(t, err)
+	require.Equal(t, "signup", conversion.Goal)
+	require.NotEmpty(t, conversion.Token)
+	_, err = s.getShortcutConversion(noGoal.ID)
+	require.ErrorContains(t, err, "400")
+
+	// The pixels report the conversions without a session, with the token of the shortcut.
+	require.NoError(t, s.postShortcutConversion(shortcut.ID, conversion.Token))
+	require.ErrorContains(t, s.postShortcutConversion(shortcut.ID, "spoofed"), "403")
+	require.ErrorContains(t, s.postShortcutConversion(noGoal.ID, conversion.Token), "403")
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcut/%d/analytics", shortcut.ID), nil)
+	require.NoError(t, err)
+	analytics := &apiv1.AnalysisData{}
+	require.NoError(t, json.NewDecoder(body).Decode(analytics))
+	require.Equal(t, 4, analytics.TotalViews)
+	require.Equal(t, 1, analytics.Conversions)
+	require.Equal(t, 0.25, analytics.ConversionRate)
+
+	// Changing the goal changes the token.
+	goal := "purchase"
+	_, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Goal: &goal,
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, s.postShortcutConversion(shortcut.ID, conversion.Token), "403")
+	newConversion, err := s.getShortcutConversion(shortcut.ID)
+	require.NoError(t, err)
+	require.NotEqual(t, conversion.Token, newConversion.Token)
+	require.NoError(t, s.postShortcutConversion(shortcut.ID, newConversion.Token))
+
+	// Only the conversions of the current goal are counted.
+	require.NoError(t, s.postShortcutConversion(shortcut.ID, newConversion.Token))
+	body, err = s.get(fmt.Sprintf("/api/v1/shortcut/%d/analytics", shortcut.ID), nil)
+	require.NoError(t, err)
+	analytics = &apiv1.AnalysisData{}
+	require.NoError(t, json.NewDecoder(body).Decode(analytics))
+	require.Equal(t, 2, analytics.Conversions)
+	require.Equal(t, 0.5, analytics.ConversionRate)
+
+	// The token is only shown to the users who can edit the shortcut.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.getShortcutConversion(shortcut.ID)
+	require.ErrorContains(t, err, "403")
+}
+
+func (s *TestingServer) getShortcutConversion(shortcutID int32) (*apiv1.ShortcutConversion, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcuts/%d/conversion", shortcutID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	conversion := &apiv1.ShortcutConversion{}
+	if err := json.NewDecoder(body).Decode(conversion); err != nil {
+		return nil, err
+	}
+	return conversion, nil
+}
+
+func (s *TestingServer) postShortcutConversion(shortcutID int32, token string) error {
+	body, err := s.request(http.MethodPost, fmt.Sprintf("/api/v1/shortcuts/%d/conversion", shortcutID), nil, map[string]string{
+		"token": token,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	return body.Close()
+}
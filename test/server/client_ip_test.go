@@ -0,0 +1,113 @@
+package testserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestClientIPHeader(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		clientIPHeader string
+		trustedProxies []string
+		header         map[string]string
+		ip             string
+	}{
+		{
+			name:           "x-real-ip",
+			clientIPHeader: "X-Real-IP",
+			trustedProxies: []string{"127.0.0.1"},
+			header:         map[string]string{"X-Real-IP": "203.0.113.1", "X-Forwarded-For": "198.51.100.1"},
+			ip:             "203.0.113.1",
+		},
+		{
+			name:           "cf-connecting-ip",
+			clientIPHeader: "CF-Connecting-IP",
+			trustedProxies: []string{"127.0.0.0/8"},
+			header:         map[string]string{"CF-Connecting-IP": "203.0.113.2", "X-Real-IP": "198.51.100.1"},
+			ip:             "203.0.113.2",
+		},
+		{
+			// The IPs appended by the trusted proxies are skipped.
+			name:           "x-forwarded-for",
+			clientIPHeader: "X-Forwarded-For",
+			trustedProxies: []string{"127.0.0.1", "10.0.0.0/8"},
+			header:         map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.3, 10.0.0.2"},
+			ip:             "203.0.113.3",
+		},
+		{
+			name:           "missing",
+			clientIPHeader: "CF-Connecting-IP",
+			trustedProxies: []string{"127.0.0.1"},
+			header:         map[string]string{"X-Real-IP": "203.0.113.4"},
+			ip:             "127.0.0.1",
+		},
+		{
+			name:           "invalid",
+			clientIPHeader: "X-Real-IP",
+			trustedProxies: []string{"127.0.0.1"},
+			header:         map[string]string{"X-Real-IP": "not-an-ip"},
+			ip:             "127.0.0.1",
+		},
+		{
+			// The header is ignored from the peers which are not trusted proxies, as it can be spoofed.
+			name:           "untrusted",
+			clientIPHeader: "X-Real-IP",
+			trustedProxies: []string{"10.0.0.0/8"},
+			header:         map[string]string{"X-Real-IP": "203.0.113.5"},
+			ip:             "127.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := test.GetTestingProfile(t)
+			profile.ClientIPHeader = tt.clientIPHeader
+			profile.TrustedProxies = tt.trustedProxies
+			s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+			require.NoError(t, err)
+			defer s.Shutdown(ctx)
+
+			_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+				Email:    "slash@yourselfhosted.com",
+				Password: "testpassword",
+			})
+			require.NoError(t, err)
+			_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+				Name:       "test",
+				Link:       "https://google.com",
+				Visibility: apiv1.VisibilityPublic,
+				Tags:       []string{},
+			})
+			require.NoError(t, err)
+			resp, err := s.getResponse("/s/test", tt.header)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			activities, err := s.server.Store.ListActivities(ctx, &store.FindActivity{
+				Type: store.ActivityShortcutView,
+			})
+			require.NoError(t, err)
+			require.Len(t, activities, 1)
+			payload := &apiv1.ActivityShorcutViewPayload{}
+			require.NoError(t, json.Unmarshal([]byte(activities[0].Payload), payload))
+			require.Equal(t, tt.ip, payload.IP)
+		})
+	}
+}
+
+func TestClientIPHeaderRequiresTrustedProxies(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ClientIPHeader = "X-Real-IP"
+	_, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.ErrorContains(t, err, "trusted proxies")
+}
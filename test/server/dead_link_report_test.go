@@ -0,0 +1,133 @@
+package testserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestDeadLinkReport(t *testing.T) {
+	ctx := context.Background()
+	reports := make(chan *apiv1.DeadLinkReport, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := &apiv1.DeadLinkReport{}
+		if err := json.NewDecoder(r.Body).Decode(report); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reports <- report
+	}))
+	defer webhook.Close()
+	profile := test.GetTestingProfile(t)
+	profile.DeadLinkReportWebhooks = []string{webhook.URL}
+	// Nothing listens on the port of the links, so they fail the health checks of the loopback addresses.
+	profile.HealthCheckPrivateTargets = true
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	admin, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for _, create := range []*apiv1.CreateShortcutRequest{
+		{Name: "broken", Link: "http://127.0.0.1:1/a", Targets: &apiv1.ShortcutTargets{Links: []string{"http://127.0.0.1:1/b", "mailto:slash@yourselfhosted.com"}}},
+		{Name: "unchecked", Link: "mailto:slash@yourselfhosted.com", Targets: &apiv1.ShortcutTargets{Links: []string{"mailto:memos@yourselfhosted.com"}}},
+		{Name: "untargeted", Link: "http://127.0.0.1:1/c"},
+	} {
+		create.Visibility, create.Tags = apiv1.VisibilityPublic, []string{}
+		_, err = s.postShortcutCreate(create)
+		require.NoError(t, err)
+	}
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	other, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "other",
+		Link:       "mailto:user@yourselfhosted.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+		Targets:    &apiv1.ShortcutTargets{Links: []string{"http://127.0.0.1:1/d", "http://127.0.0.1:1/d"}},
+	})
+	require.NoError(t, err)
+
+	// Nothing is reported before the first health check.
+	service := apiv1.NewAPIV1Service(s.server.Profile, s.server.Store, nil, nil, nil, nil, nil)
+	report, err := service.GenerateDeadLinkReport(ctx)
+	require.NoError(t, err)
+	require.Zero(t, report.CheckedTs)
+	require.Empty(t, report.Owners)
+	require.NoError(t, service.SendDeadLinkReport(ctx))
+	require.Empty(t, reports)
+
+	require.NoError(t, service.CheckTargetHealth(ctx))
+	report, err = service.GenerateDeadLinkReport(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, report.CheckedTs)
+	require.Len(t, report.Owners, 2)
+	require.Equal(t, admin.ID, report.Owners[0].CreatorID)
+	require.Equal(t, "slash@yourselfhosted.com", report.Owners[0].Email)
+	require.Len(t, report.Owners[0].Shortcuts, 1)
+	require.Equal(t, "broken", report.Owners[0].Shortcuts[0].Name)
+	require.Equal(t, []string{"http://127.0.0.1:1/a", "http://127.0.0.1:1/b"}, report.Owners[0].Shortcuts[0].Links)
+	require.Equal(t, user.ID, report.Owners[1].CreatorID)
+	require.Equal(t, []*apiv1.DeadLinkReportShortcut{{ID: other.ID, Name: "other", Links: []string{"http://127.0.0.1:1/d"}}}, report.Owners[1].Shortcuts)
+	require.Contains(t, report.Text, "2 shortcuts have links that failed the health check")
+	require.Contains(t, report.Text, "- broken: http://127.0.0.1:1/a, http://127.0.0.1:1/b\n")
+
+	require.NoError(t, service.SendDeadLinkReport(ctx))
+	require.Equal(t, report, <-reports)
+}
+
+func TestTargetHealthPrivateAddresses(t *testing.T) {
+	ctx := context.Background()
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	profile := test.GetTestingProfile(t)
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "internal",
+		Link:       target.URL,
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+		Targets:    &apiv1.ShortcutTargets{Links: []string{"http://127.0.0.1:1/down"}},
+	})
+	require.NoError(t, err)
+
+	// The links on private addresses are refused by default, and are not unhealthy for it.
+	service := apiv1.NewAPIV1Service(s.server.Profile, s.server.Store, nil, nil, nil, nil, nil)
+	require.NoError(t, service.CheckTargetHealth(ctx))
+	report, err := service.GenerateDeadLinkReport(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, report.CheckedTs)
+	require.Empty(t, report.Owners)
+
+	// The profile can allow checking them, which tells the healthy ones from the dead ones.
+	profile.HealthCheckPrivateTargets = true
+	require.NoError(t, service.CheckTargetHealth(ctx))
+	report, err = service.GenerateDeadLinkReport(ctx)
+	require.NoError(t, err)
+	require.Len(t, report.Owners, 1)
+	require.Len(t, report.Owners[0].Shortcuts, 1)
+	require.Equal(t, []string{"http://127.0.0.1:1/down"}, report.Owners[0].Shortcuts[0].Links)
+}
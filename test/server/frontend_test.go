@@ -0,0 +1,104 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/server"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestFrontendFallback(t *testing.T) {
+	ctx := context.Background()
+	frontend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "frontend %s", r.URL.RequestURI())
+	}))
+	defer frontend.Close()
+
+	tests := []struct {
+		fallback string
+		// check checks the response to the path of the web app "/dashboard?tab=1".
+		check func(t *testing.T, resp *http.Response)
+	}{
+		{
+			fallback: server.FrontendFallbackNotFound,
+			check: func(t *testing.T, resp *http.Response) {
+				require.Equal(t, http.StatusNotFound, resp.StatusCode)
+			},
+		},
+		{
+			fallback: server.FrontendFallbackRedirect,
+			check: func(t *testing.T, resp *http.Response) {
+				require.Equal(t, http.StatusFound, resp.StatusCode)
+				require.Equal(t, frontend.URL+"/dashboard?tab=1", resp.Header.Get("Location"))
+			},
+		},
+		{
+			fallback: server.FrontendFallbackProxy,
+			check: func(t *testing.T, resp *http.Response) {
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.Equal(t, "frontend /dashboard?tab=1", string(body))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fallback, func(t *testing.T) {
+			profile := test.GetTestingProfile(t)
+			profile.FrontendFallback = tt.fallback
+			profile.FrontendOrigin = frontend.URL
+			s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+			require.NoError(t, err)
+			defer s.Shutdown(ctx)
+
+			_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+				Email:    "slash@yourselfhosted.com",
+				Password: "testpassword",
+			})
+			require.NoError(t, err)
+			// A shortcut named like the path of the web app is not looked up for it.
+			_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+				Name:       "dashboard",
+				Link:       "https://example.com/dashboard",
+				Visibility: apiv1.VisibilityPublic,
+				Tags:       []string{},
+			})
+			require.NoError(t, err)
+
+			resp, err := s.getResponse("/dashboard?tab=1", nil)
+			require.NoError(t, err)
+			tt.check(t, resp)
+
+			// The API and the redirector are unchanged.
+			resp, err = s.getResponse("/api/v1/workspace/profile", nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			resp, err = s.getResponse("/api/v1/unknown", nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNotFound, resp.StatusCode)
+			resp, err = s.getResponse("/s/dashboard", nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+			require.Equal(t, "https://example.com/dashboard", resp.Header.Get("Location"))
+		})
+	}
+}
+
+func TestFrontendFallbackInvalid(t *testing.T) {
+	ctx := context.Background()
+	for _, fallback := range []string{"spa", server.FrontendFallbackRedirect} {
+		profile := test.GetTestingProfile(t)
+		profile.FrontendFallback = fallback
+		profile.FrontendOrigin = "links.example.com"
+		_, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+		require.Error(t, err, fallback)
+	}
+}
@@ -0,0 +1,210 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yourselfhosted/slash/api/auth"
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	apiv2pb "github.com/yourselfhosted/slash/proto/gen/api/v2"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestGRPCShortcutService(t *testing.T) {
+	ctx := context.Background()
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	profile := test.GetTestingProfile(t)
+	profile.GRPCPort = grpcPort
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	accessToken := strings.TrimPrefix(s.cookie, auth.AccessTokenCookieName+"=")
+	// The use of the access token is not recorded yet.
+	require.NoError(t, s.server.Store.UpdateUserAccessTokens(ctx, user.ID, func(accessTokens []*storepb.AccessTokensUserSetting_AccessToken) []*storepb.AccessTokensUserSetting_AccessToken {
+		for _, userAccessToken := range accessTokens {
+			userAccessToken.LastUsedTs = 0
+		}
+		return accessTokens
+	}))
+
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("localhost:%d", grpcPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := apiv2pb.NewShortcutServiceClient(conn)
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+
+	// Requests without a token are rejected.
+	_, err = client.ListShortcuts(ctx, &apiv2pb.ListShortcutsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	created, err := client.CreateShortcut(authCtx, &apiv2pb.CreateShortcutRequest{
+		Shortcut: &apiv2pb.Shortcut{
+			Name:       "test",
+			Link:       "https://google.com",
+			Visibility: apiv2pb.Visibility_WORKSPACE,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test", created.Shortcut.Name)
+	require.True(t, created.Shortcut.Enabled)
+
+	// The requests record the use of their access token, as those of the v1 API do.
+	accessTokens, err := s.server.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, accessTokens, 1)
+	require.NotZero(t, accessTokens[0].LastUsedTs)
+
+	// Invalid shortcuts are rejected with the violation of each field.
+	_, err = client.CreateShortcut(authCtx, &apiv2pb.CreateShortcutRequest{
+		Shortcut: &apiv2pb.Shortcut{
+			Name: "invalid",
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Equal(t, 1, len(details))
+	violations := details[0].(*errdetails.BadRequest).FieldViolations
+	require.Equal(t, 2, len(violations))
+	require.Equal(t, "link", violations[0].Field)
+	require.Equal(t, "visibility", violations[1].Field)
+	_, err = client.UpdateShortcut(authCtx, &apiv2pb.UpdateShortcutRequest{
+		Shortcut:   &apiv2pb.Shortcut{Id: created.Shortcut.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	got, err := client.GetShortcut(authCtx, &apiv2pb.GetShortcutRequest{Id: created.Shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://google.com", got.Shortcut.Link)
+
+	updated, err := client.UpdateShortcut(authCtx, &apiv2pb.UpdateShortcutRequest{
+		Shortcut: &apiv2pb.Shortcut{
+			Id:   created.Shortcut.Id,
+			Link: "https://github.com",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com", updated.Shortcut.Link)
+
+	listed, err := client.ListShortcuts(authCtx, &apiv2pb.ListShortcutsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listed.Shortcuts))
+
+	// Resolving follows the redirector rules.
+	resolved, err := client.ResolveShortcut(authCtx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com", resolved.Shortcut.Link)
+	_, err = client.ResolveShortcut(ctx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.ResolveShortcut(authCtx, &apiv2pb.ResolveShortcutRequest{Name: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// The expired shortcuts no longer resolve, as they no longer redirect.
+	expiresTs := time.Now().Add(-time.Minute).Unix()
+	_, err = s.server.Store.UpdateShortcut(ctx, &store.UpdateShortcut{ID: created.Shortcut.Id, ExpiresTs: &expiresTs})
+	require.NoError(t, err)
+	_, err = client.ResolveShortcut(authCtx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	expiresTs = 0
+	_, err = s.server.Store.UpdateShortcut(ctx, &store.UpdateShortcut{ID: created.Shortcut.Id, ExpiresTs: &expiresTs})
+	require.NoError(t, err)
+	// Public shortcuts resolve for anyone, unless the workspace requires auth for all redirects.
+	visibility := store.VisibilityPublic
+	_, err = s.server.Store.UpdateShortcut(ctx, &store.UpdateShortcut{ID: created.Shortcut.Id, Visibility: &visibility})
+	require.NoError(t, err)
+	_, err = client.ResolveShortcut(ctx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.NoError(t, err)
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS,
+		Value: &storepb.WorkspaceSetting_RequireAuthForAllRedirects{
+			RequireAuthForAllRedirects: true,
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.ResolveShortcut(ctx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.ResolveShortcut(authCtx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.NoError(t, err)
+	_, err = client.UpdateShortcut(authCtx, &apiv2pb.UpdateShortcutRequest{
+		Shortcut:   &apiv2pb.Shortcut{Id: created.Shortcut.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enabled"}},
+	})
+	require.NoError(t, err)
+	_, err = client.ResolveShortcut(authCtx, &apiv2pb.ResolveShortcutRequest{Name: "test"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.DeleteShortcut(authCtx, &apiv2pb.DeleteShortcutRequest{Id: created.Shortcut.Id})
+	require.NoError(t, err)
+	_, err = client.GetShortcut(authCtx, &apiv2pb.GetShortcutRequest{Id: created.Shortcut.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCWorkspacePrefixRoutes(t *testing.T) {
+	ctx := context.Background()
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	profile := test.GetTestingProfile(t)
+	profile.GRPCPort = grpcPort
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	accessToken := strings.TrimPrefix(s.cookie, auth.AccessTokenCookieName+"=")
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("localhost:%d", grpcPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := apiv2pb.NewWorkspaceServiceClient(conn)
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+
+	for base, code := range map[string]codes.Code{
+		"https://jira.example.com/browse/": codes.OK,
+		// The rest of the routed names would be appended to the host of the bases without path.
+		"https://jira.example.com":        codes.InvalidArgument,
+		"https://jira.example.com/browse": codes.InvalidArgument,
+		"ftp://jira.example.com/browse/":  codes.InvalidArgument,
+	} {
+		_, err := client.UpdateWorkspaceSetting(authCtx, &apiv2pb.UpdateWorkspaceSettingRequest{
+			Setting: &apiv2pb.WorkspaceSetting{
+				PrefixRoutes: &apiv2pb.PrefixRoutesWorkspaceSetting{
+					Routes: []*apiv2pb.PrefixRoute{{Prefix: "j", Base: base}},
+				},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"prefix_routes"}},
+		})
+		require.Equal(t, code, status.Code(err), base)
+	}
+}
@@ -0,0 +1,71 @@
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestHealthz(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	// Health checks don't need auth.
+	resp, err := s.getResponse("/healthz", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTrustedIPsBypassRateLimit(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		trustedIPs  []string
+		header      map[string]string
+		rateLimited bool
+	}{
+		{
+			name:        "untrusted",
+			rateLimited: true,
+		},
+		{
+			name:        "trusted",
+			trustedIPs:  []string{"127.0.0.0/8", "::1"},
+			rateLimited: false,
+		},
+		{
+			// Forwarded headers are ignored, as they can be spoofed.
+			name:        "spoofed",
+			trustedIPs:  []string{"10.0.0.0/8"},
+			header:      map[string]string{"X-Real-IP": "10.0.0.1", "X-Forwarded-For": "10.0.0.1"},
+			rateLimited: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := test.GetTestingProfile(t)
+			profile.TrustedIPs = tt.trustedIPs
+			s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+			require.NoError(t, err)
+			defer s.Shutdown(ctx)
+
+			rateLimited := false
+			for i := 0; i < 100; i++ {
+				resp, err := s.getResponse("/healthz", tt.header)
+				require.NoError(t, err)
+				if resp.StatusCode == http.StatusTooManyRequests {
+					rateLimited = true
+					break
+				}
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+			}
+			require.Equal(t, tt.rateLimited, rateLimited)
+		})
+	}
+}
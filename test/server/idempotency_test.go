@@ -0,0 +1,74 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	create := &apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	}
+	shortcut, err := s.postShortcutCreateWithIdempotencyKey(create, "key-1")
+	require.NoError(t, err)
+
+	// A retried create returns the original shortcut.
+	retried, err := s.postShortcutCreateWithIdempotencyKey(create, "key-1")
+	require.NoError(t, err)
+	require.Equal(t, shortcut.ID, retried.ID)
+	shortcuts, err := s.server.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+
+	// A different key creates a new shortcut.
+	create.Name = "test2"
+	other, err := s.postShortcutCreateWithIdempotencyKey(create, "key-2")
+	require.NoError(t, err)
+	require.NotEqual(t, shortcut.ID, other.ID)
+	shortcuts, err = s.server.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+}
+
+func (s *TestingServer) postShortcutCreateWithIdempotencyKey(request *apiv1.CreateShortcutRequest, idempotencyKey string) (*apiv1.Shortcut, error) {
+	rawData, err := json.Marshal(&request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal shortcut create")
+	}
+	body, err := s.request("POST", "/api/v1/shortcut", bytes.NewReader(rawData), nil, map[string]string{
+		"Cookie":                   s.cookie,
+		apiv1.IdempotencyKeyHeader: idempotencyKey,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to post request")
+	}
+	defer body.Close()
+
+	shortcut := &apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(shortcut); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal post shortcut response")
+	}
+	return shortcut, nil
+}
@@ -0,0 +1,146 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestUserRateLimit(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.UserRateLimit = 2
+	profile.AdminRateLimit = 3
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// The remaining requests decrement until the limit of the admin role is exhausted.
+	for remaining := 2; remaining >= 0; remaining-- {
+		resp, err := s.getResponse("/api/v1/user/me", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "3", resp.Header.Get(apiv1.RateLimitLimitHeader))
+		require.Equal(t, strconv.Itoa(remaining), resp.Header.Get(apiv1.RateLimitRemainingHeader))
+		reset, err := strconv.ParseInt(resp.Header.Get(apiv1.RateLimitResetHeader), 10, 64)
+		require.NoError(t, err)
+		require.InDelta(t, time.Now().Add(time.Minute).Unix(), reset, 2)
+	}
+	resp, err := s.getResponse("/api/v1/user/me", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "0", resp.Header.Get(apiv1.RateLimitRemainingHeader))
+	retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	require.NoError(t, err)
+	require.True(t, retryAfter > 0 && retryAfter <= 60)
+
+	// Users are limited separately, with the limit of their role.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	resp, err = s.getResponse("/api/v1/user/me", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "2", resp.Header.Get(apiv1.RateLimitLimitHeader))
+	require.Equal(t, "1", resp.Header.Get(apiv1.RateLimitRemainingHeader))
+
+	// Redirects are not limited per user.
+	resp, err = s.getResponse("/s/missing", nil)
+	require.NoError(t, err)
+	require.Empty(t, resp.Header.Get(apiv1.RateLimitLimitHeader))
+}
+
+func TestShortcutCreationRateLimit(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.CreationRateLimit = 2
+	profile.CreationRateWindow = 2 * time.Second
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	// Admins are never limited.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		resp, err := s.postShortcutCreateResponse(fmt.Sprintf("admin-%d", i))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resp, err := s.postShortcutCreateResponse(fmt.Sprintf("user-%d", i))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	resp, err := s.postShortcutCreateResponse("user-2")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	require.NoError(t, err)
+	require.True(t, retryAfter > 0 && retryAfter <= 2)
+	// The refused shortcut isn't created.
+	name := "user-2"
+	shortcut, err := s.server.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+
+	// The user can create shortcuts again once the window ends.
+	time.Sleep(time.Duration(retryAfter) * time.Second)
+	resp, err = s.postShortcutCreateResponse("user-2")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// postShortcutCreateResponse creates a shortcut with the given name and returns the raw response.
+func (s *TestingServer) postShortcutCreateResponse(name string) (*http.Response, error) {
+	rawData, err := json.Marshal(&apiv1.CreateShortcutRequest{
+		Name:       name,
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPrivate,
+		Tags:       []string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("http://localhost:%d/api/v1/shortcut", s.profile.Port), bytes.NewReader(rawData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Cookie", s.cookie)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/internal/requestid"
+)
+
+func TestRequestID(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	buf := &bytes.Buffer{}
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(requestid.NewHandler(slog.NewJSONHandler(buf, nil))))
+	defer slog.SetDefault(defaultLogger)
+
+	// A request ID given by the client is propagated.
+	resp, err := s.getResponse("/api/v1/workspace/profile", map[string]string{
+		requestid.HeaderName: "test-request-id",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-request-id", resp.Header.Get(requestid.HeaderName))
+	require.Contains(t, buf.String(), `"request_id":"test-request-id"`)
+
+	// Otherwise a request ID is generated.
+	buf.Reset()
+	resp, err = s.getResponse("/api/v1/workspace/profile", nil)
+	require.NoError(t, err)
+	requestID := resp.Header.Get(requestid.HeaderName)
+	require.NotEmpty(t, requestID)
+	require.Contains(t, buf.String(), fmt.Sprintf(`"request_id":"%s"`, requestID))
+}
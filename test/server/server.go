@@ -1,6 +1,7 @@
 package testserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -30,7 +31,10 @@ type TestingServer struct {
 }
 
 func NewTestingServer(ctx context.Context, t *testing.T) (*TestingServer, error) {
-	profile := test.GetTestingProfile(t)
+	return newTestingServerWithProfile(ctx, test.GetTestingProfile(t), &http.Client{})
+}
+
+func newTestingServerWithProfile(ctx context.Context, profile *profile.Profile, client *http.Client) (*TestingServer, error) {
 	db := db.NewDB(profile)
 	if err := db.Open(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to open db")
@@ -44,7 +48,7 @@ func NewTestingServer(ctx context.Context, t *testing.T) (*TestingServer, error)
 
 	s := &TestingServer{
 		server:  server,
-		client:  &http.Client{},
+		client:  client,
 		profile: profile,
 		cookie:  "",
 	}
@@ -84,7 +88,7 @@ func (s *TestingServer) waitForServerStart(errChan <-chan error) error {
 				continue
 			}
 			addr := e.ListenerAddr()
-			if addr != nil && strings.Contains(addr.String(), ":") {
+			if addr != nil && (strings.Contains(addr.String(), ":") || addr.Network() == "unix") {
 				return nil // was started
 			}
 		case err := <-errChan:
@@ -170,9 +174,48 @@ func (s *TestingServer) patch(url string, body io.Reader, params map[string]stri
 	})
 }
 
+// put sends a PUT client request.
+func (s *TestingServer) put(url string, body io.Reader, params map[string]string) (io.ReadCloser, error) {
+	return s.request("PUT", url, body, params, map[string]string{
+		"Cookie": s.cookie,
+	})
+}
+
 // delete sends a DELETE client request.
 func (s *TestingServer) delete(url string, params map[string]string) (io.ReadCloser, error) {
 	return s.request("DELETE", url, nil, params, map[string]string{
 		"Cookie": s.cookie,
 	})
 }
+
+// getResponse sends a GET client request without following redirects and returns the raw response.
+func (s *TestingServer) getResponse(uri string, header map[string]string) (*http.Response, error) {
+	fullURL := fmt.Sprintf("http://localhost:%d%s", s.profile.Port, uri)
+	req, err := http.NewRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to create a new GET request(%q)", fullURL)
+	}
+	req.Header.Set("Cookie", s.cookie)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fail to send a GET request(%q)", fullURL)
+	}
+	defer resp.Body.Close()
+
+	// Buffer the body so that it can be read after the connection is released.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read http response body")
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+package testserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+)
+
+func TestSessionServer(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	admin, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	firstCookie := s.cookie
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	secondCookie := s.cookie
+
+	// The user lists their sign ins, the one of the request being the current one.
+	sessions, err := s.listUserSessions(user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	currentSessions, firstSessionID := 0, ""
+	for _, session := range sessions {
+		require.NotEmpty(t, session.ID)
+		require.NotZero(t, session.CreatedTs)
+		require.NotZero(t, session.ExpiresTs)
+		require.Equal(t, "127.0.0.1", session.IP)
+		require.NotEmpty(t, session.UserAgent)
+		if session.Current {
+			// The session of the request was used to list the sessions.
+			require.NotZero(t, session.LastUsedTs)
+			currentSessions++
+		} else {
+			firstSessionID = session.ID
+		}
+	}
+	require.Equal(t, 1, currentSessions)
+
+	// The user can't list the sessions of another user.
+	_, err = s.listUserSessions(admin.ID)
+	require.ErrorContains(t, err, "403")
+
+	// Revoking the first sign in leaves the second one valid.
+	_, err = s.delete(fmt.Sprintf("/api/v1/users/%d/sessions/%s", user.ID, firstSessionID), nil)
+	require.NoError(t, err)
+	_, err = s.delete(fmt.Sprintf("/api/v1/users/%d/sessions/%s", user.ID, firstSessionID), nil)
+	require.ErrorContains(t, err, "404")
+	s.cookie = firstCookie
+	_, err = s.getCurrentUser()
+	require.ErrorContains(t, err, "401")
+	s.cookie = secondCookie
+	_, err = s.getCurrentUser()
+	require.NoError(t, err)
+	sessions, err = s.listUserSessions(user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].Current)
+
+	// The admins list and revoke the sessions of any user.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	sessions, err = s.listUserSessions(user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.False(t, sessions[0].Current)
+	_, err = s.delete(fmt.Sprintf("/api/v1/users/%d/sessions/%s", user.ID, sessions[0].ID), nil)
+	require.NoError(t, err)
+	s.cookie = secondCookie
+	_, err = s.getCurrentUser()
+	require.ErrorContains(t, err, "401")
+}
+
+func (s *TestingServer) listUserSessions(userID int32) ([]*apiv1.UserSession, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/users/%d/sessions", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+	sessions := []*apiv1.UserSession{}
+	if err = json.Unmarshal(data, &sessions); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal list sessions response")
+	}
+	return sessions, nil
+}
@@ -5,12 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestShortcutServer(t *testing.T) {
@@ -67,7 +77,1340 @@ func (s *TestingServer) postShortcutCreate(request *apiv1.CreateShortcutRequest)
 	return shortcut, nil
 }
 
+func (s *TestingServer) patchShortcut(shortcutID int32, request *apiv1.PatchShortcutRequest) (*apiv1.Shortcut, error) {
+	rawData, err := json.Marshal(&request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal shortcut patch")
+	}
+	reader := bytes.NewReader(rawData)
+	body, err := s.patch(fmt.Sprintf("/api/v1/shortcut/%d", shortcutID), reader, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	shortcut := &apiv1.Shortcut{}
+	if err = json.Unmarshal(buf.Bytes(), shortcut); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal patch shortcut response")
+	}
+	return shortcut, nil
+}
+
 func (s *TestingServer) deleteShortcut(shortcutID int32) error {
 	_, err := s.delete(fmt.Sprintf("/api/v1/shortcut/%d", shortcutID), nil)
 	return err
 }
+
+func TestShortcutServerUpdateTags(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	adminShortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "admin",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	first, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "first",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{"import"},
+	})
+	require.NoError(t, err)
+	second, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "second",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	// Tags are added to the shortcuts of the user only.
+	shortcuts, err := s.postShortcutTags("addTags", &apiv1.UpdateShortcutTagsRequest{
+		ShortcutIDs: []int32{adminShortcut.ID, first.ID, second.ID},
+		Tags:        []string{"docs", "import"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	require.Equal(t, []string{"import", "docs"}, shortcuts[0].Tags)
+	require.Equal(t, []string{"docs", "import"}, shortcuts[1].Tags)
+	adminShortcut, err = s.getShortcut(adminShortcut.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, adminShortcut.Tags)
+
+	// Removing a tag that isn't present is a no-op.
+	shortcuts, err = s.postShortcutTags("removeTags", &apiv1.UpdateShortcutTagsRequest{
+		ShortcutIDs: []int32{first.ID},
+		Tags:        []string{"missing"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, []string{"import", "docs"}, shortcuts[0].Tags)
+
+	shortcuts, err = s.postShortcutTags("removeTags", &apiv1.UpdateShortcutTagsRequest{
+		ShortcutIDs: []int32{first.ID, second.ID},
+		Tags:        []string{"import"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	require.Equal(t, []string{"docs"}, shortcuts[0].Tags)
+	require.Equal(t, []string{"docs"}, shortcuts[1].Tags)
+
+	// Tags must not be empty nor contain whitespaces.
+	_, err = s.postShortcutTags("addTags", &apiv1.UpdateShortcutTagsRequest{
+		ShortcutIDs: []int32{first.ID},
+		Tags:        []string{"two words"},
+	})
+	require.Error(t, err)
+}
+
+func TestShortcutServerNameBlocklist(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.BlockedNames = filepath.Join(profile.Data, "blocked.txt")
+	require.NoError(t, os.WriteFile(profile.BlockedNames, []byte("paypal\n/^g[o0]{2}gle/\n"), 0644))
+	profile.ReviewNames = filepath.Join(profile.Data, "review.txt")
+	require.NoError(t, os.WriteFile(profile.ReviewNames, []byte("bank\n"), 0644))
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	adminSignUp := &apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(adminSignUp)
+	require.NoError(t, err)
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	create := func(name string) (*apiv1.Shortcut, error) {
+		return s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+			Name:       name,
+			Link:       "https://google.com",
+			Visibility: apiv1.VisibilityPublic,
+			Tags:       []string{},
+		})
+	}
+
+	// Blocked names are rejected regardless of case and diacritics.
+	for _, name := range []string{"paypal", "PàyPal", "g00gle-login"} {
+		_, err = create(name)
+		require.ErrorContains(t, err, "403", name)
+	}
+
+	// Names to review are rejected for users.
+	_, err = create("Bänk")
+	require.ErrorContains(t, err, "403")
+
+	// Other names are allowed, but cannot be renamed to a blocked name.
+	shortcut, err := create("docs")
+	require.NoError(t, err)
+	name := "PAYPAL"
+	_, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Name: &name,
+	})
+	require.ErrorContains(t, err, "403")
+
+	// Admins can use the names to review, but not the blocked ones.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = create("bank")
+	require.NoError(t, err)
+	_, err = create("paypal")
+	require.ErrorContains(t, err, "403")
+}
+
+func TestShortcutServerApproval(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	adminSignUp := &apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(adminSignUp)
+	require.NoError(t, err)
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_PUBLIC_SHORTCUT_APPROVAL,
+		Value: &storepb.WorkspaceSetting_RequirePublicShortcutApproval{
+			RequirePublicShortcutApproval: true,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "public",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusPending, shortcut.ApprovalStatus)
+	workspaceShortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "workspace",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusApproved, workspaceShortcut.ApprovalStatus)
+
+	// Pending shortcuts don't redirect.
+	resp, err := s.getResponse("/s/public", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	require.Equal(t, "/404?shortcut=public", resp.Header.Get("Location"))
+
+	// The owner still sees the pending shortcut, but cannot approve it.
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	_, err = s.getShortcut(shortcut.ID)
+	require.NoError(t, err)
+	_, err = s.postShortcutApprove(shortcut.ID)
+	require.ErrorContains(t, err, "403")
+
+	// Other users don't see it.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "other@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcuts, err = s.listShortcuts()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "workspace", shortcuts[0].Name)
+	_, err = s.getShortcut(shortcut.ID)
+	require.ErrorContains(t, err, "404")
+
+	// Once approved by an admin, the shortcut goes live.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	shortcut, err = s.postShortcutApprove(shortcut.ID)
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusApproved, shortcut.ApprovalStatus)
+	resp, err = s.getResponse("/s/public", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	require.Equal(t, "https://google.com", resp.Header.Get("Location"))
+
+	// Changing where the approved shortcut redirects to makes it wait for the approval again.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	title := "Search"
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{Title: &title})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusApproved, shortcut.ApprovalStatus)
+	link := "https://example.com"
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{Link: &link})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusPending, shortcut.ApprovalStatus)
+	resp, err = s.getResponse("/s/public", nil)
+	require.NoError(t, err)
+	require.Equal(t, "/404?shortcut=public", resp.Header.Get("Location"))
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutApprove(shortcut.ID)
+	require.NoError(t, err)
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	name := "renamed"
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusPending, shortcut.ApprovalStatus)
+
+	// So does adding target links, while removing them does not.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutApprove(shortcut.ID)
+	require.NoError(t, err)
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Targets: &apiv1.ShortcutTargets{Links: []string{"https://example.org"}, Mode: apiv1.TargetModeRandom},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusPending, shortcut.ApprovalStatus)
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutApprove(shortcut.ID)
+	require.NoError(t, err)
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Targets: &apiv1.ShortcutTargets{Links: []string{}, Mode: apiv1.TargetModeRandom},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusApproved, shortcut.ApprovalStatus)
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Targets: &apiv1.ShortcutTargets{Links: []string{}, LocaleLinks: map[string]string{"fr": "https://example.fr"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusPending, shortcut.ApprovalStatus)
+
+	// Admins' public shortcuts don't wait for approval.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	adminShortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "admin",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ApprovalStatusApproved, adminShortcut.ApprovalStatus)
+}
+
+func (s *TestingServer) listShortcuts() ([]*apiv1.Shortcut, error) {
+	body, err := s.get("/api/v1/shortcut", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcuts := []*apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(&shortcuts); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal list shortcuts response")
+	}
+	return shortcuts, nil
+}
+
+func (s *TestingServer) postShortcutApprove(shortcutID int32) (*apiv1.Shortcut, error) {
+	body, err := s.post(fmt.Sprintf("/api/v1/shortcuts/%d:approve", shortcutID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := &apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(shortcut); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal approve shortcut response")
+	}
+	return shortcut, nil
+}
+
+func TestShortcutServerClone(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	source, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:        "docs",
+		Link:        "https://docs.com",
+		Title:       "Docs",
+		Description: "The docs",
+		Visibility:  apiv1.VisibilityPublic,
+		Tags:        []string{"docs"},
+		OpenGraphMetadata: &apiv1.OpenGraphMetadata{
+			Title: "Docs preview",
+		},
+	})
+	require.NoError(t, err)
+	private, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "private",
+		Link:       "https://private.com",
+		Visibility: apiv1.VisibilityPrivate,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// A public shortcut is cloned into a private copy of the caller, with a generated name.
+	clone, err := s.postShortcutClone(source.ID, &apiv1.CloneShortcutRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "docs-copy", clone.Name)
+	require.Equal(t, user.ID, clone.CreatorID)
+	require.Equal(t, apiv1.VisibilityPrivate, clone.Visibility)
+	require.Equal(t, source.Link, clone.Link)
+	require.Equal(t, source.Title, clone.Title)
+	require.Equal(t, source.Description, clone.Description)
+	require.Equal(t, source.Tags, clone.Tags)
+	require.Equal(t, source.OpenGraphMetadata, clone.OpenGraphMetadata)
+	clone, err = s.postShortcutClone(source.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, "docs-copy-2", clone.Name)
+
+	// A supplied name and visibility are used as they are, unless the name is taken.
+	clone, err = s.postShortcutClone(source.ID, &apiv1.CloneShortcutRequest{
+		Name:       "my-docs",
+		Visibility: apiv1.VisibilityWorkspace,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "my-docs", clone.Name)
+	require.Equal(t, apiv1.VisibilityWorkspace, clone.Visibility)
+	_, err = s.postShortcutClone(source.ID, &apiv1.CloneShortcutRequest{
+		Name: "docs",
+	})
+	require.ErrorContains(t, err, "409")
+
+	// Shortcuts the caller cannot view cannot be cloned.
+	_, err = s.postShortcutClone(private.ID, nil)
+	require.ErrorContains(t, err, "404")
+	_, err = s.postShortcutClone(999, nil)
+	require.ErrorContains(t, err, "404")
+
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(shortcuts))
+}
+
+func (s *TestingServer) postShortcutClone(shortcutID int32, request *apiv1.CloneShortcutRequest) (*apiv1.Shortcut, error) {
+	var reader io.Reader
+	if request != nil {
+		rawData, err := json.Marshal(request)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal shortcut clone")
+		}
+		reader = bytes.NewReader(rawData)
+	}
+	body, err := s.post(fmt.Sprintf("/api/v1/shortcuts/%d:clone", shortcutID), reader, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := &apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(shortcut); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal clone shortcut response")
+	}
+	return shortcut, nil
+}
+
+func (s *TestingServer) getShortcut(shortcutID int32) (*apiv1.Shortcut, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcut/%d", shortcutID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := &apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(shortcut); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get shortcut response")
+	}
+	return shortcut, nil
+}
+
+func (s *TestingServer) postShortcutTags(action string, request *apiv1.UpdateShortcutTagsRequest) ([]*apiv1.Shortcut, error) {
+	rawData, err := json.Marshal(&request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal update shortcut tags request")
+	}
+	body, err := s.post(fmt.Sprintf("/api/v1/shortcuts:%s", action), bytes.NewReader(rawData), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcuts := []*apiv1.Shortcut{}
+	if err := json.NewDecoder(body).Decode(&shortcuts); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal update shortcut tags response")
+	}
+	return shortcuts, nil
+}
+
+func TestShortcutServerValidation(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	patchURL := fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID)
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		fields map[string]string
+	}{
+		{
+			name:   "missing link",
+			method: http.MethodPost,
+			url:    "/api/v1/shortcut",
+			body:   `{"name": "missing-link"}`,
+			fields: map[string]string{"link": "link is required"},
+		},
+		{
+			name:   "missing name and invalid visibility",
+			method: http.MethodPost,
+			url:    "/api/v1/shortcut",
+			body:   `{"link": "https://google.com", "visibility": "EVERYONE"}`,
+			fields: map[string]string{"name": "name is required", "visibility": "invalid visibility: EVERYONE"},
+		},
+		{
+			name:   "wrong field type",
+			method: http.MethodPost,
+			url:    "/api/v1/shortcut",
+			body:   `{"name": "test", "link": "https://google.com", "tags": "tag"}`,
+			fields: map[string]string{"tags": "must not be a string"},
+		},
+		{
+			name:   "bad JSON",
+			method: http.MethodPost,
+			url:    "/api/v1/shortcut",
+			body:   `{"name": `,
+			fields: map[string]string{},
+		},
+		{
+			name:   "patch invalid visibility",
+			method: http.MethodPatch,
+			url:    patchURL,
+			body:   `{"visibility": "EVERYONE"}`,
+			fields: map[string]string{"visibility": "invalid visibility: EVERYONE"},
+		},
+		{
+			name:   "patch tags with tag edits",
+			method: http.MethodPatch,
+			url:    patchURL,
+			body:   `{"tags": ["a"], "addTags": ["b"]}`,
+			fields: map[string]string{"tags": "tags cannot be set along with addTags or removeTags"},
+		},
+		{
+			name:   "patch empty link",
+			method: http.MethodPatch,
+			url:    patchURL,
+			body:   `{"link": " "}`,
+			fields: map[string]string{"link": "link must not be empty"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			statusCode, validationError, err := s.sendValidatedRequest(tc.method, tc.url, tc.body)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, statusCode)
+			require.NotEmpty(t, validationError.Message)
+			require.Equal(t, tc.fields, validationError.Fields)
+		})
+	}
+
+	// Nothing is written by invalid requests.
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, apiv1.VisibilityPublic, shortcuts[0].Visibility)
+	require.Equal(t, "https://google.com", shortcuts[0].Link)
+}
+
+func TestShortcutServerLongLink(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// Links of up to the max length are kept whole, such as the ones with long signed query strings.
+	prefix := "https://example.com/file?signature="
+	maxLink := prefix + strings.Repeat("a", profile.DefaultMaxLinkLength-len(prefix))
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "long",
+		Link:       maxLink,
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxLink, shortcut.Link)
+	resp, err := s.getResponse("/s/long", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	require.Equal(t, maxLink, resp.Header.Get("Location"))
+
+	// Longer links are refused with a validation error, on create and on patch.
+	tooLongLink, err := json.Marshal(maxLink + "a")
+	require.NoError(t, err)
+	wantFields := map[string]string{"link": fmt.Sprintf("link must not be longer than %d bytes", profile.DefaultMaxLinkLength)}
+	statusCode, validationError, err := s.sendValidatedRequest(http.MethodPost, "/api/v1/shortcut", fmt.Sprintf(`{"name": "longer", "link": %s}`, tooLongLink))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, wantFields, validationError.Fields)
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPatch, fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID), fmt.Sprintf(`{"link": %s}`, tooLongLink))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, wantFields, validationError.Fields)
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, maxLink, shortcuts[0].Link)
+}
+
+// sendValidatedRequest sends the request and decodes the validation error it is expected to fail with.
+func (s *TestingServer) sendValidatedRequest(method, uri, body string) (int, *apiv1.ValidationError, error) {
+	req, err := http.NewRequest(method, fmt.Sprintf("http://localhost:%d%s", s.profile.Port, uri), bytes.NewBufferString(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Cookie", s.cookie)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	validationError := &apiv1.ValidationError{}
+	if err := json.NewDecoder(resp.Body).Decode(validationError); err != nil {
+		return 0, nil, errors.Wrap(err, "fail to unmarshal validation error")
+	}
+	return resp.StatusCode, validationError, nil
+}
+
+func TestShortcutCreateGeneratedName(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.SlugGenerator = "increment"
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1", shortcut.Name)
+
+	// Taken names are skipped.
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "2",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	shortcut, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "3", shortcut.Name)
+
+	// Given names are kept.
+	shortcut, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "named",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "named", shortcut.Name)
+}
+
+func TestShortcutPatchEditTags(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{"keep", "old"},
+	})
+	require.NoError(t, err)
+
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		AddTags: []string{"new"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "old", "new"}, shortcut.Tags)
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		RemoveTags: []string{"old"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "new"}, shortcut.Tags)
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		AddTags:    []string{"other"},
+		RemoveTags: []string{"new"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "other"}, shortcut.Tags)
+}
+
+func TestShortcutServerCollaborators(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	collaborator, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "collaborator@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "other@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	creatorSignUp := &apiv1.SignUpRequest{
+		Email:    "creator@yourselfhosted.com",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(creatorSignUp)
+	require.NoError(t, err)
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "shared",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	added, err := s.postShortcutCollaborator(shortcut.ID, collaborator.ID)
+	require.NoError(t, err)
+	require.Equal(t, collaborator.ID, added.UserID)
+	collaborators, err := s.listShortcutCollaborators(shortcut.ID)
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+
+	// Collaborators can update the shortcut, but neither delete it nor add other collaborators.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "collaborator@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	title := "Shared"
+	updated, err := s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Title: &title,
+	})
+	require.NoError(t, err)
+	require.Equal(t, title, updated.Title)
+	err = s.deleteShortcut(shortcut.ID)
+	require.ErrorContains(t, err, "403")
+	_, err = s.postShortcutCollaborator(shortcut.ID, collaborator.ID+1)
+	require.ErrorContains(t, err, "403")
+
+	// Other users cannot update it.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "other@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Title: &title,
+	})
+	require.ErrorContains(t, err, "403")
+	_, err = s.listShortcutCollaborators(shortcut.ID)
+	require.ErrorContains(t, err, "403")
+
+	// Once removed, the collaborator loses the edit rights.
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    creatorSignUp.Email,
+		Password: creatorSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = s.delete(fmt.Sprintf("/api/v1/shortcut/%d/collaborator/%d", shortcut.ID, collaborator.ID), nil)
+	require.NoError(t, err)
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "collaborator@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		Title: &title,
+	})
+	require.ErrorContains(t, err, "403")
+}
+
+func (s *TestingServer) postShortcutCollaborator(shortcutID, userID int32) (*apiv1.ShortcutCollaborator, error) {
+	rawData, err := json.Marshal(&apiv1.AddShortcutCollaboratorRequest{UserID: userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal add shortcut collaborator request")
+	}
+	body, err := s.post(fmt.Sprintf("/api/v1/shortcut/%d/collaborator", shortcutID), bytes.NewReader(rawData), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborator := &apiv1.ShortcutCollaborator{}
+	if err := json.NewDecoder(body).Decode(collaborator); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal add shortcut collaborator response")
+	}
+	return collaborator, nil
+}
+
+func (s *TestingServer) listShortcutCollaborators(shortcutID int32) ([]*apiv1.ShortcutCollaborator, error) {
+	body, err := s.get(fmt.Sprintf("/api/v1/shortcut/%d/collaborator", shortcutID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := []*apiv1.ShortcutCollaborator{}
+	if err := json.NewDecoder(body).Decode(&collaborators); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal list shortcut collaborators response")
+	}
+	return collaborators, nil
+}
+
+func TestShortcutServerTagLimits(t *testing.T) {
+	ctx := context.Background()
+	testingProfile := test.GetTestingProfile(t)
+	testingProfile.MaxTagsPerShortcut = 3
+	testingProfile.MaxTagLength = 8
+	s, err := newTestingServerWithProfile(ctx, testingProfile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// Shortcuts can have up to the max number of tags, each up to the max length.
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "tagged",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{"a", "b", "12345678"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "12345678"}, shortcut.Tags)
+
+	// More tags are refused on create and on patch.
+	tooManyTags := map[string]string{"tags": "a shortcut must not have more than 3 tags"}
+	statusCode, validationError, err := s.sendValidatedRequest(http.MethodPost, "/api/v1/shortcut", `{"name": "more", "link": "https://google.com", "tags": ["a", "b", "c", "d"]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, tooManyTags, validationError.Fields)
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPatch, fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID), `{"tags": ["a", "b", "c", "d"]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, tooManyTags, validationError.Fields)
+	// Added tags are counted along with the current tags.
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPatch, fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID), `{"addTags": ["c"]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, map[string]string{"addTags": "a shortcut must not have more than 3 tags"}, validationError.Fields)
+	_, err = s.postShortcutTags("addTags", &apiv1.UpdateShortcutTagsRequest{
+		ShortcutIDs: []int32{shortcut.ID},
+		Tags:        []string{"c"},
+	})
+	require.ErrorContains(t, err, "400")
+
+	// Longer tags are refused.
+	tooLongTag := map[string]string{"tags": "tags must not be longer than 8 bytes"}
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPost, "/api/v1/shortcut", `{"name": "long", "link": "https://google.com", "tags": ["123456789"]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, tooLongTag, validationError.Fields)
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPatch, fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID), `{"tags": ["123456789"]}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, tooLongTag, validationError.Fields)
+
+	shortcut, err = s.getShortcut(shortcut.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "12345678"}, shortcut.Tags)
+}
+
+func TestShortcutServerExpiring(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	adminSignUp := &apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	}
+	_, err = s.postAuthSignUp(adminSignUp)
+	require.NoError(t, err)
+	now := time.Now()
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "admin-soon",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+		ExpiresTs:  now.Add(2 * 24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	shared, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "shared-soon",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+		ExpiresTs:  now.Add(3 * 24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for name, expiresTs := range map[string]int64{
+		"user-soon":    now.Add(24 * time.Hour).Unix(),
+		"user-later":   now.Add(30 * 24 * time.Hour).Unix(),
+		"user-never":   0,
+		"user-expired": now.Add(-time.Hour).Unix(),
+	} {
+		_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+			Name:       name,
+			Link:       "https://google.com",
+			Visibility: apiv1.VisibilityPublic,
+			Tags:       []string{},
+			ExpiresTs:  expiresTs,
+		})
+		require.NoError(t, err)
+	}
+
+	// Expired shortcuts no longer redirect.
+	resp, err := s.getResponse("/s/user-expired", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "/404?shortcut=user-expired", resp.Header.Get("Location"))
+	resp, err = s.getResponse("/s/user-soon", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "https://google.com", resp.Header.Get("Location"))
+
+	// Users get the expiring shortcuts they can edit, soonest first.
+	require.Equal(t, []string{"user-soon"}, s.listExpiringShortcutNames(t, nil))
+	require.Equal(t, []string{"user-soon", "user-later"}, s.listExpiringShortcutNames(t, map[string]string{"days": "31"}))
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    adminSignUp.Email,
+		Password: adminSignUp.Password,
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCollaborator(shared.ID, user.ID)
+	require.NoError(t, err)
+
+	// Admins get all the expiring shortcuts.
+	require.Equal(t, []string{"user-soon", "admin-soon", "shared-soon"}, s.listExpiringShortcutNames(t, nil))
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"user-soon", "shared-soon"}, s.listExpiringShortcutNames(t, nil))
+
+	_, err = s.get("/api/v1/shortcuts:expiring", map[string]string{"days": "0"})
+	require.ErrorContains(t, err, "400")
+}
+
+func TestShortcutServerLastViewed(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"used", "unused", "untracked"} {
+		shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+			Name:             name,
+			Link:             "https://google.com",
+			Visibility:       apiv1.VisibilityPublic,
+			Tags:             []string{},
+			DisableAnalytics: name == "untracked",
+		})
+		require.NoError(t, err)
+		require.Zero(t, shortcut.LastViewedTs)
+	}
+
+	// The views of the shortcuts disabling analytics are not recorded either.
+	before := time.Now().Unix()
+	for _, name := range []string{"used", "untracked"} {
+		resp, err := s.getResponse("/s/"+name, nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	lastViewedTs := map[string]int64{}
+	for _, shortcut := range shortcuts {
+		lastViewedTs[shortcut.Name] = shortcut.LastViewedTs
+	}
+	require.GreaterOrEqual(t, lastViewedTs["used"], before)
+	require.Zero(t, lastViewedTs["unused"])
+	require.Zero(t, lastViewedTs["untracked"])
+
+	body, err := s.get("/api/v1/shortcut", map[string]string{"notViewedSince": fmt.Sprint(before)})
+	require.NoError(t, err)
+	shortcuts = []*apiv1.Shortcut{}
+	require.NoError(t, json.NewDecoder(body).Decode(&shortcuts))
+	require.Len(t, shortcuts, 2)
+
+	_, err = s.get("/api/v1/shortcut", map[string]string{"notViewedSince": "yesterday"})
+	require.ErrorContains(t, err, "400")
+}
+
+func TestShortcutServerExpiresAtTimezone(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_TIMEZONE,
+		Value: &storepb.WorkspaceSetting_Timezone{
+			Timezone: "America/New_York",
+		},
+	})
+	require.NoError(t, err)
+
+	// The clocks of New York are put forward from 02:00 EST to 03:00 EDT on March 10, 2030.
+	shortcut, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "spring",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+		ExpiresAt:  "2030-03-10T03:30",
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2030, 3, 10, 7, 30, 0, 0, time.UTC).Unix(), shortcut.ExpiresTs)
+	require.Equal(t, "2030-03-10T03:30:00-04:00", shortcut.ExpiresAt)
+
+	// The day before, New York is still 5 hours behind UTC.
+	eve := "2030-03-09T23:00"
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		ExpiresAt: &eve,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2030, 3, 10, 4, 0, 0, 0, time.UTC).Unix(), shortcut.ExpiresTs)
+	require.Equal(t, "2030-03-09T23:00:00-05:00", shortcut.ExpiresAt)
+
+	// The skipped hour is rejected, as is setting both fields.
+	uri := fmt.Sprintf("/api/v1/shortcut/%d", shortcut.ID)
+	statusCode, validationError, err := s.sendValidatedRequest(http.MethodPatch, uri, `{"expiresAt": "2030-03-10T02:30"}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, validationError.Fields["expiresAt"], "time does not exist in the timezone")
+	statusCode, validationError, err = s.sendValidatedRequest(http.MethodPatch, uri, `{"expiresAt": "2030-03-10T03:30", "expiresTs": 1}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, map[string]string{"expiresAt": "expiresAt cannot be set along with expiresTs"}, validationError.Fields)
+
+	// The times with an offset are taken as is, and an empty time never expires.
+	offset := "2030-03-10T02:30:00Z"
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		ExpiresAt: &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2030-03-09T21:30:00-05:00", shortcut.ExpiresAt)
+	never := ""
+	shortcut, err = s.patchShortcut(shortcut.ID, &apiv1.PatchShortcutRequest{
+		ExpiresAt: &never,
+	})
+	require.NoError(t, err)
+	require.Zero(t, shortcut.ExpiresTs)
+	require.Empty(t, shortcut.ExpiresAt)
+}
+
+func (s *TestingServer) listExpiringShortcutNames(t *testing.T, params map[string]string) []string {
+	body, err := s.get("/api/v1/shortcuts:expiring", params)
+	require.NoError(t, err)
+	shortcuts := []*apiv1.Shortcut{}
+	require.NoError(t, json.NewDecoder(body).Decode(&shortcuts))
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	return names
+}
+
+func TestShortcutServerCreateOnBehalf(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	admin, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// Users create shortcuts of their own by default, and cannot create them for others.
+	own, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "own",
+		Link:       "https://own.example.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, own.CreatorID)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "impersonated",
+		Link:       "https://impersonated.example.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+		CreatorID:  &admin.ID,
+	})
+	require.ErrorContains(t, err, "403")
+	// Setting themselves as the creator is the same as the default.
+	self, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "self",
+		Link:       "https://self.example.com",
+		Visibility: apiv1.VisibilityWorkspace,
+		Tags:       []string{},
+		CreatorID:  &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, self.CreatorID)
+
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	imported, err := s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "imported",
+		Link:       "https://imported.example.com",
+		Visibility: apiv1.VisibilityPrivate,
+		Tags:       []string{},
+		CreatorID:  &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, imported.CreatorID)
+	missingUserID := user.ID + 100
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "orphan",
+		Link:       "https://orphan.example.com",
+		Visibility: apiv1.VisibilityPrivate,
+		Tags:       []string{},
+		CreatorID:  &missingUserID,
+	})
+	require.ErrorContains(t, err, "400")
+}
+
+func TestShortcutServerHosts(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "admin@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for _, request := range []*apiv1.CreateShortcutRequest{
+		{Name: "short-1", Link: "https://bit.ly/one", Visibility: apiv1.VisibilityPublic},
+		{Name: "short-2", Link: "https://BIT.ly:443/two", Visibility: apiv1.VisibilityWorkspace},
+		{Name: "docs", Link: "https://docs.example.com/guide", Visibility: apiv1.VisibilityWorkspace},
+		{Name: "secret", Link: "https://secret.example.com", Visibility: apiv1.VisibilityPrivate},
+	} {
+		request.Tags = []string{}
+		_, err = s.postShortcutCreate(request)
+		require.NoError(t, err)
+	}
+
+	// The hosts are grouped case insensitively and regardless of the port, the most linked first.
+	require.Equal(t, []*apiv1.ShortcutHost{
+		{Host: "bit.ly", Count: 2},
+		{Host: "docs.example.com", Count: 1},
+		{Host: "secret.example.com", Count: 1},
+	}, s.listShortcutHosts(t))
+
+	// Other users only count the shortcuts they can view.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "own",
+		Link:       "https://own.example.com",
+		Visibility: apiv1.VisibilityPrivate,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.ShortcutHost{
+		{Host: "bit.ly", Count: 2},
+		{Host: "docs.example.com", Count: 1},
+		{Host: "own.example.com", Count: 1},
+	}, s.listShortcutHosts(t))
+}
+
+func (s *TestingServer) listShortcutHosts(t *testing.T) []*apiv1.ShortcutHost {
+	body, err := s.get("/api/v1/shortcuts:hosts", nil)
+	require.NoError(t, err)
+	hosts := []*apiv1.ShortcutHost{}
+	require.NoError(t, json.NewDecoder(body).Decode(&hosts))
+	return hosts
+}
+
+func TestShortcutServerProtobuf(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for _, create := range []*apiv1.CreateShortcutRequest{
+		{Name: "public", Link: "https://google.com", Visibility: apiv1.VisibilityPublic, RedirectDelayMs: 500},
+		{Name: "private", Link: "https://github.com", Visibility: apiv1.VisibilityPrivate},
+	} {
+		create.Tags = []string{"search"}
+		_, err := s.postShortcutCreate(create)
+		require.NoError(t, err)
+	}
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+
+	// The protobuf list has the same shortcuts as the JSON one, without the private shortcut of another user.
+	shortcuts, err := s.listShortcuts()
+	require.NoError(t, err)
+	list := &storepb.ShortcutList{}
+	s.getProtobuf(t, "/api/v1/shortcut", list)
+	require.Equal(t, len(shortcuts), len(list.Shortcuts))
+	require.Equal(t, 1, len(list.Shortcuts))
+	require.Equal(t, shortcuts[0].ID, list.Shortcuts[0].Id)
+	require.Equal(t, "public", list.Shortcuts[0].Name)
+	require.Equal(t, "https://google.com", list.Shortcuts[0].Link)
+	require.Equal(t, storepb.Visibility_PUBLIC, list.Shortcuts[0].Visibility)
+	require.Equal(t, []string{"search"}, list.Shortcuts[0].Tags)
+	require.Equal(t, int32(500), list.Shortcuts[0].RedirectDelayMs)
+
+	shortcut := &storepb.Shortcut{}
+	s.getProtobuf(t, fmt.Sprintf("/api/v1/shortcut/%d", shortcuts[0].ID), shortcut)
+	require.True(t, proto.Equal(list.Shortcuts[0], shortcut))
+
+	// Clients that don't ask for protobuf still receive JSON.
+	body, err := s.request("GET", "/api/v1/shortcut", nil, nil, map[string]string{
+		"Cookie": s.cookie,
+		"Accept": "application/json, application/x-protobuf;q=0",
+	})
+	require.NoError(t, err)
+	defer body.Close()
+	jsonShortcuts := []*apiv1.Shortcut{}
+	require.NoError(t, json.NewDecoder(body).Decode(&jsonShortcuts))
+	require.Equal(t, 1, len(jsonShortcuts))
+}
+
+func (s *TestingServer) getProtobuf(t *testing.T, uri string, message proto.Message) {
+	fullURL := fmt.Sprintf("http://localhost:%d%s", s.profile.Port, uri)
+	req, err := http.NewRequest("GET", fullURL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Cookie", s.cookie)
+	req.Header.Set("Accept", "application/x-protobuf")
+	resp, err := s.client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/x-protobuf", resp.Header.Get("Content-Type"))
+	require.Contains(t, resp.Header.Values("Vary"), "Accept")
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(data, message))
+}
@@ -0,0 +1,112 @@
+package testserver
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/test"
+)
+
+type sitemapURLSet struct {
+	URLs []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+func TestSitemap(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.Sitemap = true
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for name, visibility := range map[string]apiv1.Visibility{
+		"public":    apiv1.VisibilityPublic,
+		"workspace": apiv1.VisibilityWorkspace,
+		"private":   apiv1.VisibilityPrivate,
+		"archived":  apiv1.VisibilityPublic,
+		"disabled":  apiv1.VisibilityPublic,
+		"expired":   apiv1.VisibilityPublic,
+	} {
+		create := &apiv1.CreateShortcutRequest{
+			Name:       name,
+			Link:       "https://google.com",
+			Visibility: visibility,
+			Tags:       []string{},
+		}
+		if name == "expired" {
+			create.ExpiresTs = time.Now().Add(-time.Hour).Unix()
+		}
+		shortcut, err := s.postShortcutCreate(create)
+		require.NoError(t, err)
+		patch := &apiv1.PatchShortcutRequest{}
+		if name == "archived" {
+			rowStatus := apiv1.Archived
+			patch.RowStatus = &rowStatus
+		} else if name == "disabled" {
+			enabled := false
+			patch.Enabled = &enabled
+		} else {
+			continue
+		}
+		_, err = s.patchShortcut(shortcut.ID, patch)
+		require.NoError(t, err)
+	}
+
+	// Without a custom domain, there is no configured base URL for the absolute URLs.
+	resp, err := s.getResponse("/sitemap.xml", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Only the public shortcuts that redirect are listed, with the custom domain of the workspace
+	// whatever the host of the request.
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_CUSTOM_DOMAIN,
+		Value: &storepb.WorkspaceSetting_CustomDomain{
+			CustomDomain: "https://go.example.com",
+		},
+	})
+	require.NoError(t, err)
+	urlSet := s.getSitemap(t)
+	require.Len(t, urlSet.URLs, 1)
+	require.Equal(t, "https://go.example.com/s/public", urlSet.URLs[0].Loc)
+	require.NotEmpty(t, urlSet.URLs[0].LastMod)
+
+	// No shortcut is public when the workspace requires signing in to follow them.
+	_, err = s.server.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REQUIRE_AUTH_FOR_ALL_REDIRECTS,
+		Value: &storepb.WorkspaceSetting_RequireAuthForAllRedirects{
+			RequireAuthForAllRedirects: true,
+		},
+	})
+	require.NoError(t, err)
+	urlSet = s.getSitemap(t)
+	require.Empty(t, urlSet.URLs)
+}
+
+func (s *TestingServer) getSitemap(t *testing.T) *sitemapURLSet {
+	resp, err := s.getResponse("/sitemap.xml", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/xml; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	urlSet := &sitemapURLSet{}
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(urlSet))
+	return urlSet
+}
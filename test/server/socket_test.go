@@ -0,0 +1,63 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestUnixSocket(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.Socket = filepath.Join(profile.Data, "slash.sock")
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", profile.Socket)
+			},
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	s, err := newTestingServerWithProfile(ctx, profile, client)
+	require.NoError(t, err)
+
+	// Nothing listens on the port.
+	require.Equal(t, "unix", s.server.GetEcho().ListenerAddr().Network())
+	_, err = net.Dial("tcp", fmt.Sprintf("localhost:%d", profile.Port))
+	require.Error(t, err)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+		Name:       "test",
+		Link:       "https://google.com",
+		Visibility: apiv1.VisibilityPublic,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	resp, err := client.Get("http://slash/s/test")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	require.Equal(t, "https://google.com", resp.Header.Get("Location"))
+
+	// The socket file is cleaned up on shutdown.
+	s.Shutdown(ctx)
+	_, err = os.Stat(profile.Socket)
+	require.True(t, os.IsNotExist(err))
+}
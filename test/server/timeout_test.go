@@ -0,0 +1,36 @@
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestServerTimeouts(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ReadHeaderTimeout = 2 * time.Second
+	profile.ReadTimeout = 3 * time.Second
+	profile.WriteTimeout = 4 * time.Second
+	profile.IdleTimeout = 5 * time.Second
+	s, err := newTestingServerWithProfile(ctx, profile, &http.Client{})
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	httpServer := s.server.GetEcho().Server
+	require.Equal(t, 2*time.Second, httpServer.ReadHeaderTimeout)
+	require.Equal(t, 3*time.Second, httpServer.ReadTimeout)
+	require.Equal(t, 4*time.Second, httpServer.WriteTimeout)
+	require.Equal(t, 5*time.Second, httpServer.IdleTimeout)
+
+	// The server keeps serving within the timeouts.
+	resp, err := s.getResponse("/healthz", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
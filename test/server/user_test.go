@@ -41,6 +41,121 @@ func TestUserServer(t *testing.T) {
 	require.Equal(t, newEmail, user.Email)
 }
 
+func TestUserServerLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	user, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.RoleAdmin, user.Role)
+
+	role := apiv1.RoleUser
+	_, err = s.patchUser(user.ID, &apiv1.PatchUserRequest{
+		Role: &role,
+	})
+	require.ErrorContains(t, err, "400")
+	_, err = s.delete(fmt.Sprintf("/api/v1/user/%d", user.ID), nil)
+	require.ErrorContains(t, err, "400")
+	user, err = s.getCurrentUser()
+	require.NoError(t, err)
+	require.Equal(t, apiv1.RoleAdmin, user.Role)
+}
+
+func TestUserServerPreferences(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	preferences, err := s.getUserPreferences()
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.UserPreferences{}, preferences)
+
+	visibility, density, sort := apiv1.VisibilityPrivate, "COMPACT", "NAME"
+	preferences, err = s.patchUserPreferences(&apiv1.PatchUserPreferencesRequest{
+		DefaultVisibility: &visibility,
+		ListDensity:       &density,
+		ShortcutSort:      &sort,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.UserPreferences{DefaultVisibility: apiv1.VisibilityPrivate, ListDensity: "COMPACT", ShortcutSort: "NAME"}, preferences)
+
+	// Only the given preferences are updated, and empty values unset them.
+	density = ""
+	_, err = s.patchUserPreferences(&apiv1.PatchUserPreferencesRequest{
+		ListDensity: &density,
+	})
+	require.NoError(t, err)
+	preferences, err = s.getUserPreferences()
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.UserPreferences{DefaultVisibility: apiv1.VisibilityPrivate, ShortcutSort: "NAME"}, preferences)
+
+	sort = "RANDOM"
+	_, err = s.patchUserPreferences(&apiv1.PatchUserPreferencesRequest{
+		ShortcutSort: &sort,
+	})
+	require.ErrorContains(t, err, "400")
+
+	// The preferences of each user are their own.
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "user@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	preferences, err = s.getUserPreferences()
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.UserPreferences{}, preferences)
+
+	_, err = s.postAuthSignIn(&apiv1.SignInRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	_, err = s.delete("/api/v1/me/settings", nil)
+	require.NoError(t, err)
+	preferences, err = s.getUserPreferences()
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.UserPreferences{}, preferences)
+}
+
+func (s *TestingServer) getUserPreferences() (*apiv1.UserPreferences, error) {
+	body, err := s.get("/api/v1/me/settings", nil)
+	if err != nil {
+		return nil, err
+	}
+	preferences := &apiv1.UserPreferences{}
+	if err := json.NewDecoder(body).Decode(preferences); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get user preferences response")
+	}
+	return preferences, nil
+}
+
+func (s *TestingServer) patchUserPreferences(request *apiv1.PatchUserPreferencesRequest) (*apiv1.UserPreferences, error) {
+	rawData, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	body, err := s.patch("/api/v1/me/settings", bytes.NewReader(rawData), nil)
+	if err != nil {
+		return nil, err
+	}
+	preferences := &apiv1.UserPreferences{}
+	if err := json.NewDecoder(body).Decode(preferences); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal patch user preferences response")
+	}
+	return preferences, nil
+}
+
 func (s *TestingServer) getCurrentUser() (*apiv1.User, error) {
 	body, err := s.get("/api/v1/user/me", nil)
 	if err != nil {
@@ -0,0 +1,160 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/yourselfhosted/slash/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestWorkspaceStats(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	_, err = s.getWorkspaceStats()
+	require.ErrorContains(t, err, "401")
+
+	_, err = s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	for name, visibility := range map[string]apiv1.Visibility{
+		"public-1": apiv1.VisibilityPublic,
+		"public-2": apiv1.VisibilityPublic,
+		"private":  apiv1.VisibilityPrivate,
+	} {
+		_, err = s.postShortcutCreate(&apiv1.CreateShortcutRequest{
+			Name:       name,
+			Link:       "https://google.com",
+			Visibility: visibility,
+			Tags:       []string{},
+		})
+		require.NoError(t, err)
+	}
+
+	stats, err := s.getWorkspaceStats()
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.ShortcutCount)
+	require.Equal(t, 2, stats.ShortcutCountByVisibility[apiv1.VisibilityPublic])
+	require.Equal(t, 0, stats.ShortcutCountByVisibility[apiv1.VisibilityWorkspace])
+	require.Equal(t, 1, stats.ShortcutCountByVisibility[apiv1.VisibilityPrivate])
+	require.Equal(t, 1, stats.UserCount)
+	require.Equal(t, 1, stats.UserCountByRole[apiv1.RoleAdmin])
+	require.Equal(t, 0, stats.UserCountByRole[apiv1.RoleUser])
+}
+
+func (s *TestingServer) getWorkspaceStats() (*apiv1.WorkspaceStats, error) {
+	body, err := s.get("/api/v1/workspace/stats", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	stats := &apiv1.WorkspaceStats{}
+	if err = json.Unmarshal(data, stats); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal get workspace stats response")
+	}
+	return stats, nil
+}
+
+func TestWorkspaceImportSession(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewTestingServer(ctx, t)
+	require.NoError(t, err)
+	defer s.Shutdown(ctx)
+
+	admin, err := s.postAuthSignUp(&apiv1.SignUpRequest{
+		Email:    "slash@yourselfhosted.com",
+		Password: "testpassword",
+	})
+	require.NoError(t, err)
+	session, err := s.importSessionRequest(s.post("/api/v1/workspace/import/sessions", nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, "OPEN", session.Status)
+	require.Equal(t, admin.ID, session.CreatorID)
+
+	batchURL := fmt.Sprintf("/api/v1/workspace/import/sessions/%d/batches/", session.ID)
+	putBatch := func(seq int, names ...string) (*apiv1.ImportSession, error) {
+		batch := &store.Dump{Format: store.DumpFormat}
+		for _, name := range names {
+			batch.Shortcuts = append(batch.Shortcuts, &store.DumpShortcut{
+				CreatorID:      admin.ID,
+				RowStatus:      "NORMAL",
+				Name:           name,
+				Link:           "https://" + name + ".com",
+				Visibility:     "PUBLIC",
+				ApprovalStatus: "APPROVED",
+				Enabled:        true,
+			})
+		}
+		rawData, err := json.Marshal(batch)
+		if err != nil {
+			return nil, err
+		}
+		return s.importSessionRequest(s.put(batchURL+fmt.Sprint(seq), bytes.NewReader(rawData), nil))
+	}
+	_, err = putBatch(0, "google", "github")
+	require.NoError(t, err)
+	// The retried batch replaces the failed upload.
+	_, err = putBatch(1, "memos")
+	require.NoError(t, err)
+	session, err = putBatch(1, "memos", "slash")
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.ImportBatch{{Seq: 0, CreatedTs: session.Batches[0].CreatedTs, RowCount: 2}, {Seq: 1, CreatedTs: session.Batches[1].CreatedTs, RowCount: 2}}, session.Batches)
+	require.Equal(t, 4, session.RowCount)
+	_, err = putBatch(2, "github")
+	require.NoError(t, err)
+
+	// The duplicated shortcut fails the whole commit, which succeeds once its batch is fixed.
+	commitURL := fmt.Sprintf("/api/v1/workspace/import/sessions/%d/commit", session.ID)
+	_, err = s.importSessionRequest(s.post(commitURL, nil, nil))
+	require.ErrorContains(t, err, "409")
+	_, err = putBatch(2, "yourselfhosted")
+	require.NoError(t, err)
+	session, err = s.importSessionRequest(s.post(commitURL, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, "COMMITTED", session.Status)
+	require.Equal(t, 5, session.Result.Shortcuts)
+	require.Empty(t, session.Batches)
+	resp, err := s.getResponse("/s/slash", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://slash.com", resp.Header.Get("Location"))
+
+	session, err = s.importSessionRequest(s.get(fmt.Sprintf("/api/v1/workspace/import/sessions/%d", session.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, 5, session.Result.Shortcuts)
+	_, err = putBatch(3, "late")
+	require.ErrorContains(t, err, "409")
+}
+
+func (s *TestingServer) importSessionRequest(body io.ReadCloser, err error) (*apiv1.ImportSession, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to read response body")
+	}
+
+	session := &apiv1.ImportSession{}
+	if err = json.Unmarshal(data, session); err != nil {
+		return nil, errors.Wrap(err, "fail to unmarshal import session response")
+	}
+	return session, nil
+}
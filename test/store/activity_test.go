@@ -27,3 +27,61 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
 }
+
+func TestActivityStoreCreatedTsRange(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	for _, createdTs := range []int64{300, 100, 200} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: -1,
+			CreatedTs: createdTs,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   "",
+		})
+		require.NoError(t, err)
+	}
+	createdTsAfter, createdTsBefore := int64(100), int64(300)
+	createdTsList := []int64{}
+	err := ts.StreamActivities(ctx, &store.FindActivity{
+		CreatedTsAfter:  &createdTsAfter,
+		CreatedTsBefore: &createdTsBefore,
+	}, func(activity *store.Activity) error {
+		createdTsList = append(createdTsList, activity.CreatedTs)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{100, 200}, createdTsList)
+}
+
+func TestActivityStoreStreamCancelled(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	for i := 0; i < 10; i++ {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: -1,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   "",
+		})
+		require.NoError(t, err)
+	}
+
+	// The stream stops at the next activity once its context is cancelled, as when an export is aborted.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	streamed := 0
+	err := ts.StreamActivities(streamCtx, &store.FindActivity{}, func(_ *store.Activity) error {
+		streamed++
+		if streamed == 3 {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 3, streamed)
+
+	// So do the exports of the workspace.
+	_, err = ts.Export(streamCtx)
+	require.ErrorIs(t, err, context.Canceled)
+}
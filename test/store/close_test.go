@@ -0,0 +1,44 @@
+package teststore
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestStoreClose(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	ts := store.New(database.DBInstance, profile)
+	fallbackStore := NewTestingStore(ctx, t)
+	ts.SetFallback(fallbackStore)
+	_, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	require.NotZero(t, database.DBInstance.Stats().OpenConnections)
+
+	// Closing the store releases its connections and those of its fallback, and checkpoints the
+	// write-ahead log into the database file.
+	require.NoError(t, ts.Close(ctx))
+	require.Zero(t, database.DBInstance.Stats().OpenConnections)
+	require.Error(t, ts.Ping(ctx))
+	require.Error(t, fallbackStore.Ping(ctx))
+	if info, err := os.Stat(profile.DSN + "-wal"); err == nil {
+		require.Zero(t, info.Size())
+	}
+	_, err = ts.ListUsers(ctx, &store.FindUser{})
+	require.Error(t, err)
+
+	// Closing again does nothing.
+	require.NotPanics(t, func() {
+		require.NoError(t, ts.Close(ctx))
+		require.NoError(t, fallbackStore.Close(ctx))
+	})
+}
@@ -50,3 +50,43 @@ func TestCollectionStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(collections))
 }
+
+func TestCollectionStoreLinkDecorator(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		ShortcutIds: []int32{1, 11},
+		Visibility:  storepb.Visibility_PRIVATE,
+		LinkDecorator: &storepb.LinkDecorator{
+			Params: map[string]string{"utm_source": "slash"},
+		},
+	})
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		shortcutID int32
+		want       int
+	}{
+		{shortcutID: 1, want: 1},
+		{shortcutID: 11, want: 1},
+		{shortcutID: 111, want: 0},
+	} {
+		collections, err := ts.ListCollections(ctx, &store.FindCollection{
+			ShortcutID: &tc.shortcutID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.want, len(collections))
+	}
+
+	updatedCollection, err := ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID: collection.Id,
+		LinkDecorator: &storepb.LinkDecorator{
+			Params: map[string]string{"utm_source": "slash", "utm_campaign": "launch"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"utm_source": "slash", "utm_campaign": "launch"}, updatedCollection.LinkDecorator.Params)
+}
@@ -0,0 +1,175 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestDumpRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	seedTestingDump(ctx, t, ts)
+	dump, err := ts.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, store.DumpFormat, dump.Format)
+	require.Equal(t, 2, len(dump.Users))
+	require.Equal(t, 2, len(dump.Shortcuts))
+	require.Equal(t, 1, len(dump.Collections))
+	require.Equal(t, 1, len(dump.UserSettings))
+	require.Equal(t, 1, len(dump.WorkspaceSettings))
+
+	newStore := NewTestingStore(ctx, t)
+	result, err := newStore.Import(ctx, dump)
+	require.NoError(t, err)
+	require.Equal(t, &store.ImportResult{
+		WorkspaceSettings: 1,
+		Users:             2,
+		UserSettings:      1,
+		Shortcuts:         2,
+		Collections:       1,
+	}, result)
+
+	newDump, err := newStore.Export(ctx)
+	require.NoError(t, err)
+	newDump.CreatedTs = dump.CreatedTs
+	require.Equal(t, dump, newDump)
+
+	// The imported rows are served through the store.
+	shortcut, err := newStore.GetShortcut(ctx, &store.FindShortcut{
+		Name: &dump.Shortcuts[0].Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, dump.Shortcuts[0].ID, shortcut.Id)
+	require.Equal(t, []string{"news"}, shortcut.Tags)
+	require.Equal(t, "Test", shortcut.OgMetadata.Title)
+	workspaceSetting, err := newStore.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "disabled", workspaceSetting.GetDisabledShortcutMessage())
+}
+
+func TestDumpImportRemapsTakenIDs(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	seedTestingDump(ctx, t, ts)
+	dump, err := ts.Export(ctx)
+	require.NoError(t, err)
+
+	// The new instance already has a user and a shortcut taking the first IDs,
+	// and a user with the same email as the dump's admin.
+	newStore := NewTestingStore(ctx, t)
+	existingUser, err := newStore.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "existing@test.com",
+		Nickname: "existing",
+	})
+	require.NoError(t, err)
+	sameEmailUser, err := newStore.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    dump.Users[0].Email,
+		Nickname: "same",
+	})
+	require.NoError(t, err)
+	_, err = newStore.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  existingUser.ID,
+		Name:       "existing",
+		Link:       "https://existing.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+
+	result, err := newStore.Import(ctx, dump)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Users)
+	require.Equal(t, 2, result.RemappedUsers)
+	require.Equal(t, 2, result.Shortcuts)
+	// The first shortcut takes the next free ID, which the second one had.
+	require.Equal(t, 2, result.RemappedShortcuts)
+
+	// The references to the remapped users and shortcuts follow them.
+	shortcuts, err := newStore.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &sameEmailUser.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, dump.Shortcuts[0].Name, shortcuts[0].Name)
+	remappedShortcutID := shortcuts[0].Id
+	require.NotEqual(t, dump.Shortcuts[0].ID, remappedShortcutID)
+	collections, err := newStore.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, sameEmailUser.ID, collections[0].CreatorId)
+	require.Contains(t, collections[0].ShortcutIds, remappedShortcutID)
+	userSetting, err := newStore.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &sameEmailUser.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_LOCALE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.LocaleUserSetting_LOCALE_USER_SETTING_ZH, userSetting.GetLocale())
+
+	// Importing the same shortcuts again conflicts, and nothing is imported.
+	_, err = newStore.Import(ctx, dump)
+	require.ErrorIs(t, err, store.ErrDumpConflict)
+	users, err := newStore.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(users))
+}
+
+func seedTestingDump(ctx context.Context, t *testing.T, ts *store.Store) {
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: admin.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_LOCALE,
+		Value: &storepb.UserSetting_Locale{
+			Locale: storepb.LocaleUserSetting_LOCALE_USER_SETTING_ZH,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_DISABLED_SHORTCUT_MESSAGE,
+		Value: &storepb.WorkspaceSetting_DisabledShortcutMessage{
+			DisabledShortcutMessage: "disabled",
+		},
+	})
+	require.NoError(t, err)
+	adminShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "admin",
+		Link:       "https://admin.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{"news"},
+		OgMetadata: &storepb.OpenGraphMetadata{Title: "Test"},
+	})
+	require.NoError(t, err)
+	userShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:      user.ID,
+		Name:           "user",
+		Link:           "https://user.link",
+		Visibility:     storepb.Visibility_PUBLIC,
+		Tags:           []string{},
+		ApprovalStatus: storepb.ApprovalStatus_PENDING,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   admin.ID,
+		Name:        "collection",
+		Title:       "Collection",
+		ShortcutIds: []int32{adminShortcut.Id, userShortcut.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,201 @@
+package teststore
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/version"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestStoreFallback(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	events := []store.EventType{}
+	ts.RegisterHook(func(_ context.Context, event *store.Event) {
+		events = append(events, event.Type)
+	})
+	fallbackProfile := test.GetTestingProfile(t)
+	fallbackDB := db.NewDB(fallbackProfile)
+	require.NoError(t, fallbackDB.Open(ctx))
+	fallbackStore := store.New(fallbackDB.DBInstance, fallbackProfile)
+
+	// The ids of the users in both stores differ, and users are matched by email.
+	_, err := fallbackStore.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "first@test.com",
+		Nickname: "first",
+	})
+	require.NoError(t, err)
+	fallbackUser, err := createTestingAdminUser(ctx, fallbackStore)
+	require.NoError(t, err)
+	fallbackShortcut, err := fallbackStore.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   fallbackUser.ID,
+		Name:        "old",
+		Link:        "https://old.link",
+		Title:       "Old",
+		Visibility:  storepb.Visibility_PUBLIC,
+		Tags:        []string{"legacy"},
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+		Description: "Only in the old store",
+	})
+	require.NoError(t, err)
+	retired, err := fallbackStore.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  fallbackUser.ID,
+		Name:       "retired",
+		Link:       "https://retired.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	disabled := false
+	_, err = fallbackStore.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      retired.Id,
+		Enabled: &disabled,
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"deleted", "renamed"} {
+		_, err = fallbackStore.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  fallbackUser.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_PUBLIC,
+			Tags:       []string{},
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, fallbackStore.Close(ctx))
+
+	// The fallback store is read as it is, and never written to.
+	fallbackDB = db.NewDB(fallbackProfile)
+	require.NoError(t, fallbackDB.OpenReadOnly(ctx))
+	fallbackStore = store.New(fallbackDB.DBInstance, fallbackProfile)
+	ts.SetFallback(fallbackStore)
+	_, err = fallbackStore.CreateUser(ctx, &store.User{Role: store.RoleUser, Email: "new@test.com", Nickname: "new"})
+	require.Error(t, err)
+
+	// Lookups by other fields than the name don't read through.
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Empty(t, list)
+	name := "old"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name:           &name,
+		VisibilityList: []store.Visibility{store.VisibilityPublic},
+	})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+
+	// A shortcut only in the fallback store is served and copied along with its creator.
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, shortcut)
+	require.Equal(t, fallbackShortcut.Link, shortcut.Link)
+	require.Equal(t, fallbackShortcut.Title, shortcut.Title)
+	require.Equal(t, fallbackShortcut.Description, shortcut.Description)
+	require.Equal(t, []string{"legacy"}, shortcut.Tags)
+	require.True(t, shortcut.Enabled)
+	creator, err := ts.GetUser(ctx, &store.FindUser{
+		ID: &shortcut.CreatorId,
+	})
+	require.NoError(t, err)
+	// The creator is copied as a plain user, without the role and the credentials of the old instance.
+	require.Equal(t, fallbackUser.Email, creator.Email)
+	require.Equal(t, store.RoleUser, creator.Role)
+	require.Empty(t, creator.PasswordHash)
+	// The copies are not new to the instance, and the hooks are not notified of them.
+	require.Empty(t, events)
+
+	list, err = ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	// Later lookups are served by the store itself.
+	again, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, again.Id)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	// Disabled shortcuts stay disabled once copied.
+	retiredName := "retired"
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &retiredName,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, shortcut)
+	require.False(t, shortcut.Enabled)
+	users, err = ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	// The shortcuts deleted or renamed in the store are not brought back from the fallback store.
+	for _, name := range []string{"deleted", "renamed"} {
+		shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, shortcut)
+		if name == "deleted" {
+			require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id}))
+		} else {
+			newName := "new-name"
+			_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcut.Id, Name: &newName})
+			require.NoError(t, err)
+		}
+		shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		require.NoError(t, err)
+		require.Nil(t, shortcut)
+	}
+
+	// Names missing from both stores stay missing.
+	missingName := "missing"
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &missingName,
+	})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.Mode = "prod"
+	profile.Version = version.GetCurrentVersion(profile.Mode)
+	// The database is neither created nor migrated, and must be of the schema of this version.
+	require.Error(t, db.NewDB(profile).OpenReadOnly(ctx))
+	_, err := os.Stat(profile.DSN)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	require.NoError(t, database.DBInstance.Close())
+	database = db.NewDB(profile)
+	require.NoError(t, database.OpenReadOnly(ctx))
+	_, err = database.DBInstance.ExecContext(ctx, "INSERT INTO migration_history (version) VALUES ('0.0.1')")
+	require.Error(t, err)
+	require.NoError(t, database.DBInstance.Close())
+
+	sqliteDB, err := sql.Open("sqlite", profile.DSN)
+	require.NoError(t, err)
+	_, err = sqliteDB.ExecContext(ctx, "DELETE FROM migration_history")
+	require.NoError(t, err)
+	_, err = sqliteDB.ExecContext(ctx, "INSERT INTO migration_history (version) VALUES ('0.5.0')")
+	require.NoError(t, err)
+	require.NoError(t, sqliteDB.Close())
+	require.ErrorContains(t, db.NewDB(profile).OpenReadOnly(ctx), "must be migrated")
+}
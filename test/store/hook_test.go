@@ -0,0 +1,112 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestStoreHooks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	events := []*store.Event{}
+	ts.RegisterHook(func(_ context.Context, event *store.Event) {
+		events = append(events, event)
+	})
+
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	nickname := "new_nickname"
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:       user.ID,
+		Nickname: &nickname,
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	newLink := "https://new.link"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &newLink,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpdateShortcutTags(ctx, &store.UpdateShortcutTags{
+		IDList:  []int32{shortcut.Id},
+		AddTags: []string{"tag"},
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 6, len(events))
+	require.Equal(t, store.EventUserCreated, events[0].Type)
+	require.Equal(t, user.ID, events[0].User.ID)
+	require.Nil(t, events[0].Shortcut)
+	require.Equal(t, store.EventUserUpdated, events[1].Type)
+	require.Equal(t, "new_nickname", events[1].User.Nickname)
+	require.Equal(t, store.EventShortcutCreated, events[2].Type)
+	require.Equal(t, "https://test.link", events[2].Shortcut.Link)
+	require.Nil(t, events[2].User)
+	require.Equal(t, store.EventShortcutUpdated, events[3].Type)
+	require.Equal(t, "https://new.link", events[3].Shortcut.Link)
+	require.Equal(t, store.EventShortcutUpdated, events[4].Type)
+	require.Equal(t, []string{"tag"}, events[4].Shortcut.Tags)
+	require.Equal(t, store.EventShortcutDeleted, events[5].Type)
+	require.Equal(t, shortcut.Id, events[5].Shortcut.Id)
+
+	// Hooks receive copies, so they can't modify the cached entities.
+	events[1].User.Nickname = "modified"
+	cachedUser, err := ts.GetUser(ctx, &store.FindUser{
+		ID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "new_nickname", cachedUser.Nickname)
+
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 8, len(events))
+	require.Equal(t, store.EventUserDeleted, events[7].Type)
+	require.Equal(t, member.ID, events[7].User.ID)
+}
+
+func TestStorePanickingHook(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	ts.RegisterHook(func(context.Context, *store.Event) {
+		panic("hook failure")
+	})
+	events := []*store.Event{}
+	ts.RegisterHook(func(_ context.Context, event *store.Event) {
+		events = append(events, event)
+	})
+
+	// The operation succeeds and the other hooks still run.
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	require.Equal(t, 1, len(events))
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+}
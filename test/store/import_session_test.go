@@ -0,0 +1,131 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestImportSession(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	seedTestingDump(ctx, t, ts)
+	dump, err := ts.Export(ctx)
+	require.NoError(t, err)
+	batches := []*store.Dump{
+		{Format: store.DumpFormat, WorkspaceSettings: dump.WorkspaceSettings, Users: dump.Users, UserSettings: dump.UserSettings},
+		{Format: store.DumpFormat, Shortcuts: dump.Shortcuts[:1]},
+		{Format: store.DumpFormat, Shortcuts: dump.Shortcuts[1:], Collections: dump.Collections},
+	}
+
+	newStore := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, newStore)
+	require.NoError(t, err)
+	session, err := newStore.CreateImportSession(ctx, admin.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.ImportSessionOpen, session.Status)
+
+	// The batch in the middle is missing, so the session cannot be committed yet.
+	require.NoError(t, newStore.UpsertImportBatch(ctx, session.ID, 0, batches[0]))
+	require.NoError(t, newStore.UpsertImportBatch(ctx, session.ID, 2, batches[2]))
+	_, err = newStore.CommitImportSession(ctx, session.ID)
+	require.ErrorIs(t, err, store.ErrImportBatchMissing)
+
+	// A retried batch replaces the one uploaded at its position.
+	require.NoError(t, newStore.UpsertImportBatch(ctx, session.ID, 1, &store.Dump{Format: store.DumpFormat, Shortcuts: dump.Shortcuts}))
+	require.NoError(t, newStore.UpsertImportBatch(ctx, session.ID, 1, batches[1]))
+	session, err = newStore.GetImportSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.ImportSessionOpen, session.Status)
+	require.Equal(t, 3, len(session.Batches))
+	for i, batch := range session.Batches {
+		require.Equal(t, int32(i), batch.Seq)
+	}
+	require.Equal(t, []int{4, 1, 2}, []int{session.Batches[0].RowCount, session.Batches[1].RowCount, session.Batches[2].RowCount})
+	require.Nil(t, session.Result)
+
+	session, err = newStore.CommitImportSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.ImportSessionCommitted, session.Status)
+	require.Empty(t, session.Batches)
+	require.Equal(t, &store.ImportResult{
+		WorkspaceSettings: 1,
+		Users:             1,
+		UserSettings:      1,
+		Shortcuts:         2,
+		Collections:       1,
+	}, session.Result)
+	shortcuts, err := newStore.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// The committed session is kept for its result, but takes no more batches.
+	require.ErrorIs(t, newStore.UpsertImportBatch(ctx, session.ID, 3, batches[0]), store.ErrImportSessionCommitted)
+	_, err = newStore.CommitImportSession(ctx, session.ID)
+	require.ErrorIs(t, err, store.ErrImportSessionCommitted)
+
+	require.NoError(t, newStore.DeleteImportSession(ctx, session.ID))
+	session, err = newStore.GetImportSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+}
+
+func TestImportSessionConflict(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	seedTestingDump(ctx, t, ts)
+	dump, err := ts.Export(ctx)
+	require.NoError(t, err)
+
+	// The second batch conflicts with the first, so the whole import fails and the session stays open.
+	session, err := ts.CreateImportSession(ctx, dump.Users[0].ID)
+	require.NoError(t, err)
+	require.NoError(t, ts.UpsertImportBatch(ctx, session.ID, 0, &store.Dump{Format: store.DumpFormat, Shortcuts: []*store.DumpShortcut{{Name: "new", Link: "https://new.link", RowStatus: "NORMAL", Visibility: "PUBLIC", ApprovalStatus: "APPROVED"}}}))
+	require.NoError(t, ts.UpsertImportBatch(ctx, session.ID, 1, &store.Dump{Format: store.DumpFormat, Shortcuts: dump.Shortcuts}))
+	_, err = ts.CommitImportSession(ctx, session.ID)
+	require.ErrorIs(t, err, store.ErrDumpConflict)
+	name := "new"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	session, err = ts.GetImportSession(ctx, session.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.ImportSessionOpen, session.Status)
+	require.Equal(t, 2, len(session.Batches))
+}
+
+func TestImportSessionExpire(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	open, err := ts.CreateImportSession(ctx, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, ts.UpsertImportBatch(ctx, open.ID, 0, &store.Dump{Format: store.DumpFormat}))
+	committed, err := ts.CreateImportSession(ctx, admin.ID)
+	require.NoError(t, err)
+	_, err = ts.CommitImportSession(ctx, committed.ID)
+	require.NoError(t, err)
+
+	// The sessions updated since are kept.
+	count, err := ts.ExpireImportSessions(ctx, open.UpdatedTs)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+
+	// The abandoned open session is expired with its batches, the committed one is kept for its result.
+	count, err = ts.ExpireImportSessions(ctx, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	session, err := ts.GetImportSession(ctx, open.ID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+	session, err = ts.GetImportSession(ctx, committed.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.ImportSessionCommitted, session.Status)
+}
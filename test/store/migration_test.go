@@ -0,0 +1,90 @@
+package teststore
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/server/version"
+	"github.com/yourselfhosted/slash/store/db"
+)
+
+func TestVerifyMigrations(t *testing.T) {
+	require.NoError(t, db.VerifyEmbeddedMigrations())
+
+	newMigrationFS := func() fstest.MapFS {
+		return fstest.MapFS{
+			"migration/dev/LATEST__SCHEMA.sql":              {},
+			"migration/prod/LATEST__SCHEMA.sql":             {},
+			"migration/prod/0.5/00__drop_idp.sql":           {},
+			"migration/prod/0.5/01__collection.sql":         {},
+			"migration/prod/0.6/00__add_shortcut_title.sql": {},
+		}
+	}
+	require.NoError(t, db.VerifyMigrations(newMigrationFS()))
+
+	tests := []struct {
+		name    string
+		broken  func(fstest.MapFS)
+		message string
+	}{
+		{
+			name: "missing latest schema",
+			broken: func(fsys fstest.MapFS) {
+				delete(fsys, "migration/dev/LATEST__SCHEMA.sql")
+			},
+			message: "missing latest schema",
+		},
+		{
+			name: "gap between minor versions",
+			broken: func(fsys fstest.MapFS) {
+				fsys["migration/prod/0.3/00__add_og_metadata.sql"] = &fstest.MapFile{}
+			},
+			message: "minor version 0.5 does not follow 0.3",
+		},
+		{
+			name: "misnamed minor version directory",
+			broken: func(fsys fstest.MapFS) {
+				fsys["migration/prod/v0.7/00__add_column.sql"] = &fstest.MapFile{}
+			},
+			message: `invalid minor version directory "v0.7"`,
+		},
+		{
+			name: "misnamed migration file",
+			broken: func(fsys fstest.MapFS) {
+				fsys["migration/prod/0.6/1_add_column.sql"] = &fstest.MapFile{}
+			},
+			message: `invalid migration file "1_add_column.sql"`,
+		},
+		{
+			name: "gap between migration files",
+			broken: func(fsys fstest.MapFS) {
+				fsys["migration/prod/0.6/02__add_column.sql"] = &fstest.MapFile{}
+			},
+			message: "numbered 02, expected 01",
+		},
+		{
+			name: "migration outside of a minor version directory",
+			broken: func(fsys fstest.MapFS) {
+				fsys["migration/prod/01__add_column.sql"] = &fstest.MapFile{}
+			},
+			message: `unexpected file "01__add_column.sql"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsys := newMigrationFS()
+			test.broken(fsys)
+			require.ErrorContains(t, db.VerifyMigrations(fsys), test.message)
+		})
+	}
+
+	// Migrations of a version newer than the current one would never be applied.
+	currentVersion := version.Version
+	defer func() {
+		version.Version = currentVersion
+	}()
+	version.Version = "0.5.0"
+	require.ErrorContains(t, db.VerifyMigrations(newMigrationFS()), "minor version 0.6 of prod migrations is newer than version 0.5.0")
+}
@@ -0,0 +1,97 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestStoreVerifyPassword(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	passwordHash, err := store.HashPassword("test_password")
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:         store.RoleAdmin,
+		Email:        "test@test.com",
+		Nickname:     "test",
+		PasswordHash: passwordHash,
+	})
+	require.NoError(t, err)
+
+	matched, needsRehash, err := ts.VerifyPassword(ctx, user.ID, "test_password")
+	require.NoError(t, err)
+	require.True(t, matched)
+	require.False(t, needsRehash)
+	matched, needsRehash, err = ts.VerifyPassword(ctx, user.ID, "wrong_password")
+	require.NoError(t, err)
+	require.False(t, matched)
+	require.False(t, needsRehash)
+	matched, _, err = ts.VerifyPassword(ctx, user.ID+1, "test_password")
+	require.NoError(t, err)
+	require.False(t, matched)
+
+	// A hash of a lower cost than the current one should be rehashed.
+	weakHash, err := bcrypt.GenerateFromPassword([]byte("weak_password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	weakUser, err := ts.CreateUser(ctx, &store.User{
+		Role:         store.RoleUser,
+		Email:        "weak@test.com",
+		Nickname:     "weak",
+		PasswordHash: string(weakHash),
+	})
+	require.NoError(t, err)
+	matched, needsRehash, err = ts.VerifyPassword(ctx, weakUser.ID, "weak_password")
+	require.NoError(t, err)
+	require.True(t, matched)
+	require.True(t, needsRehash)
+	require.NoError(t, ts.RehashPassword(ctx, weakUser.ID, "weak_password"))
+	matched, needsRehash, err = ts.VerifyPassword(ctx, weakUser.ID, "weak_password")
+	require.NoError(t, err)
+	require.True(t, matched)
+	require.False(t, needsRehash)
+}
+
+func TestStorePasswordHashReport(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	report, err := ts.GetPasswordHashReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &store.PasswordHashReport{}, report)
+
+	currentHash, err := store.HashPassword("current_password")
+	require.NoError(t, err)
+	weakHash, err := bcrypt.GenerateFromPassword([]byte("weak_password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	for i, passwordHash := range []string{currentHash, string(weakHash), string(weakHash), "malformed"} {
+		_, err := ts.CreateUser(ctx, &store.User{
+			Role:         store.RoleUser,
+			Email:        fmt.Sprintf("user%d@test.com", i),
+			Nickname:     fmt.Sprintf("user%d", i),
+			PasswordHash: passwordHash,
+		})
+		require.NoError(t, err)
+	}
+	report, err = ts.GetPasswordHashReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &store.PasswordHashReport{Current: 1, Outdated: 2, Malformed: 1}, report)
+
+	// Signing in rehashes the outdated hash, which the report then counts as current.
+	weakEmail := "user1@test.com"
+	weakUser, err := ts.GetUser(ctx, &store.FindUser{
+		Email: &weakEmail,
+	})
+	require.NoError(t, err)
+	_, needsRehash, err := ts.VerifyPassword(ctx, weakUser.ID, "weak_password")
+	require.NoError(t, err)
+	require.True(t, needsRehash)
+	require.NoError(t, ts.RehashPassword(ctx, weakUser.ID, "weak_password"))
+	report, err = ts.GetPasswordHashReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &store.PasswordHashReport{Current: 2, Outdated: 1, Malformed: 1}, report)
+}
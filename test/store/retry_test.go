@@ -0,0 +1,144 @@
+package teststore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"modernc.org/sqlite"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+// lockError is a lock error as reported by drivers exposing SQLite's result codes.
+type lockError struct{}
+
+func (lockError) Error() string { return "database is locked (5) (SQLITE_BUSY)" }
+func (lockError) Code() int     { return 5 }
+
+// flakyDriver is the sqlite driver failing the next writes with a lock error.
+type flakyDriver struct {
+	sqlite.Driver
+	failures atomic.Int32
+	writes   atomic.Int32
+}
+
+func (d *flakyDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &flakyConn{Conn: conn, driver: d}, nil
+}
+
+// flakyConn only exposes Prepare to database/sql, so that every statement goes through it.
+type flakyConn struct {
+	driver.Conn
+	driver *flakyDriver
+}
+
+func (c *flakyConn) Prepare(query string) (driver.Stmt, error) {
+	statement := strings.ToUpper(strings.TrimSpace(query))
+	if strings.HasPrefix(statement, "INSERT") || strings.HasPrefix(statement, "UPDATE") || strings.HasPrefix(statement, "DELETE") {
+		c.driver.writes.Add(1)
+		if c.driver.failures.Add(-1) >= 0 {
+			return nil, lockError{}
+		}
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *flakyConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+var (
+	flakyDriverOnce    sync.Once
+	testingFlakyDriver = &flakyDriver{}
+)
+
+// newFlakyTestingStore returns a store whose writes fail with a lock error as many times as failures is set.
+func newFlakyTestingStore(ctx context.Context, t *testing.T, lockRetries int) (*store.Store, *flakyDriver) {
+	flakyDriverOnce.Do(func() {
+		sql.Register("flaky-sqlite", testingFlakyDriver)
+	})
+	testingFlakyDriver.failures.Store(0)
+	testingFlakyDriver.writes.Store(0)
+
+	profile := test.GetTestingProfile(t)
+	profile.LockRetries = lockRetries
+	require.NoError(t, db.NewDB(profile).Open(ctx))
+	flakyDB, err := sql.Open("flaky-sqlite", profile.DSN)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		flakyDB.Close()
+	})
+	return store.New(flakyDB, profile), testingFlakyDriver
+}
+
+func TestStoreRetriesLockedWrites(t *testing.T) {
+	ctx := context.Background()
+	ts, flaky := newFlakyTestingStore(ctx, t, 3)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// A transient lock error is retried until the write succeeds.
+	flaky.failures.Store(2)
+	flaky.writes.Store(0)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test", shortcut.Name)
+	require.Equal(t, int32(3), flaky.writes.Load())
+
+	// Writes within transactions are retried as a whole.
+	flaky.failures.Store(1)
+	nickname := "retried"
+	updatedUser, err := ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:       user.ID,
+		Nickname: &nickname,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "retried", updatedUser.Nickname)
+
+	// Genuine failures are returned at once.
+	flaky.writes.Store(0)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+	})
+	require.Error(t, err)
+	require.Equal(t, int32(1), flaky.writes.Load())
+
+	// The lock error is returned once the retries are used up.
+	flaky.failures.Store(4)
+	flaky.writes.Store(0)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "other",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+	})
+	require.ErrorIs(t, err, lockError{})
+	require.Equal(t, int32(4), flaky.writes.Load())
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+}
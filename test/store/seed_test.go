@@ -0,0 +1,70 @@
+package teststore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestSeedFile(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.Mode = "prod"
+	profile.Seed = filepath.Join(t.TempDir(), "seed.sql")
+	require.NoError(t, os.WriteFile(profile.Seed, []byte(`
+		INSERT INTO user (id, email, nickname, password_hash, role) VALUES (1, 'admin@example.com', 'Admin', '', 'ADMIN');
+		INSERT INTO shortcut (creator_id, name, link, visibility, tag) VALUES (1, 'wiki', 'https://wiki.example.com', 'WORKSPACE', 'docs');
+		INSERT INTO shortcut (creator_id, name, link, visibility) VALUES (1, 'chat', 'https://chat.example.com', 'WORKSPACE');
+	`), 0644))
+
+	// A new prod database is seeded after applying the latest schema.
+	seededDB := db.NewDB(profile)
+	require.NoError(t, seededDB.Open(ctx))
+	ts := store.New(seededDB.DBInstance, profile)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	name := "wiki"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://wiki.example.com", shortcut.Link)
+	require.Equal(t, []string{"docs"}, shortcut.Tags)
+	require.NoError(t, seededDB.DBInstance.Close())
+
+	// Existing databases are never seeded, the seed file is skipped on restarts.
+	reopenedDB := db.NewDB(profile)
+	require.NoError(t, reopenedDB.Open(ctx))
+	shortcuts, err = store.New(reopenedDB.DBInstance, profile).ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// A missing seed file leaves no database behind.
+	missingProfile := test.GetTestingProfile(t)
+	missingProfile.Mode = "prod"
+	missingProfile.Seed = filepath.Join(t.TempDir(), "missing.sql")
+	require.ErrorContains(t, db.NewDB(missingProfile).Open(ctx), "failed to read seed file")
+	_, err = os.Stat(missingProfile.DSN)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// A failing seed file leaves no database behind either, so the next startup initializes it again.
+	failingProfile := test.GetTestingProfile(t)
+	failingProfile.Mode = "prod"
+	failingProfile.Seed = filepath.Join(t.TempDir(), "failing.sql")
+	require.NoError(t, os.WriteFile(failingProfile.Seed, []byte(`INSERT INTO missing (id) VALUES (1);`), 0644))
+	require.ErrorContains(t, db.NewDB(failingProfile).Open(ctx), "seed error")
+	_, err = os.Stat(failingProfile.DSN)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	failingProfile.Seed = ""
+	retriedDB := db.NewDB(failingProfile)
+	require.NoError(t, retriedDB.Open(ctx))
+	require.NoError(t, retriedDB.DBInstance.Close())
+}
@@ -0,0 +1,112 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutCollaboratorStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	creator, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collaborator, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "collaborator@test.com",
+		Nickname: "collaborator",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  creator.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	isCollaborator, err := ts.IsShortcutCollaborator(ctx, shortcut.Id, collaborator.ID)
+	require.NoError(t, err)
+	require.False(t, isCollaborator)
+	added, err := ts.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+		ShortcutID: shortcut.Id,
+		UserID:     collaborator.ID,
+	})
+	require.NoError(t, err)
+	// Adding the collaborator again keeps it as is.
+	again, err := ts.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+		ShortcutID: shortcut.Id,
+		UserID:     collaborator.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, added, again)
+	collaborators, err := ts.ListShortcutCollaborators(ctx, &store.FindShortcutCollaborator{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutCollaborator{added}, collaborators)
+	isCollaborator, err = ts.IsShortcutCollaborator(ctx, shortcut.Id, collaborator.ID)
+	require.NoError(t, err)
+	require.True(t, isCollaborator)
+
+	err = ts.DeleteShortcutCollaborator(ctx, &store.DeleteShortcutCollaborator{
+		ShortcutID: shortcut.Id,
+		UserID:     collaborator.ID,
+	})
+	require.NoError(t, err)
+	isCollaborator, err = ts.IsShortcutCollaborator(ctx, shortcut.Id, collaborator.ID)
+	require.NoError(t, err)
+	require.False(t, isCollaborator)
+}
+
+func TestShortcutCollaboratorVacuum(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	creator, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collaborator, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "collaborator@test.com",
+		Nickname: "collaborator",
+	})
+	require.NoError(t, err)
+	var shortcuts []*storepb.Shortcut
+	for _, name := range []string{"deleted", "kept"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  creator.ID,
+			Name:       name,
+			Link:       "https://test.link",
+			Visibility: storepb.Visibility_WORKSPACE,
+		})
+		require.NoError(t, err)
+		_, err = ts.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+			ShortcutID: shortcut.Id,
+			UserID:     collaborator.ID,
+		})
+		require.NoError(t, err)
+		shortcuts = append(shortcuts, shortcut)
+	}
+
+	// Deleting a shortcut deletes its collaborators.
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcuts[0].Id,
+	})
+	require.NoError(t, err)
+	collaborators, err := ts.ListShortcutCollaborators(ctx, &store.FindShortcutCollaborator{})
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+	require.Equal(t, shortcuts[1].Id, collaborators[0].ShortcutID)
+
+	// Deleting a user deletes the collaborators of the user.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: collaborator.ID,
+	})
+	require.NoError(t, err)
+	collaborators, err = ts.ListShortcutCollaborators(ctx, &store.FindShortcutCollaborator{})
+	require.NoError(t, err)
+	require.Empty(t, collaborators)
+}
@@ -2,12 +2,16 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestShortcutStore(t *testing.T) {
@@ -31,6 +35,7 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(shortcuts))
 	require.Equal(t, shortcut, shortcuts[0])
+	require.True(t, shortcut.Enabled)
 	newLink := "https://new.link"
 	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
 		ID:   shortcut.Id,
@@ -38,6 +43,15 @@ func TestShortcutStore(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, newLink, updatedShortcut.Link)
+	require.True(t, updatedShortcut.Enabled)
+	enabled := false
+	updatedShortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      shortcut.Id,
+		Enabled: &enabled,
+	})
+	require.NoError(t, err)
+	require.False(t, updatedShortcut.Enabled)
+	require.Equal(t, newLink, updatedShortcut.Link)
 	tag := "test"
 	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
 		Tag: &tag,
@@ -53,3 +67,415 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestShortcutStoreCount(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, create := range []*storepb.Shortcut{
+		{Name: "public-1", Visibility: storepb.Visibility_PUBLIC, Tags: []string{"docs"}},
+		{Name: "public-2", Visibility: storepb.Visibility_PUBLIC},
+		{Name: "workspace", Visibility: storepb.Visibility_WORKSPACE, Tags: []string{"docs"}},
+		{Name: "private", Visibility: storepb.Visibility_PRIVATE},
+	} {
+		create.CreatorId = user.ID
+		create.Link = "https://test.link"
+		_, err := ts.CreateShortcut(ctx, create)
+		require.NoError(t, err)
+	}
+
+	count, err := ts.CountShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+	count, err = ts.CountShortcuts(ctx, &store.FindShortcut{
+		VisibilityList: []store.Visibility{store.VisibilityPublic},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	count, err = ts.CountShortcuts(ctx, &store.FindShortcut{
+		VisibilityList: []store.Visibility{store.VisibilityWorkspace, store.VisibilityPrivate},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	tag := "docs"
+	count, err = ts.CountShortcuts(ctx, &store.FindShortcut{
+		Tag: &tag,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	otherUserID := user.ID + 1
+	count, err = ts.CountShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &otherUserID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestShortcutStoreExpiring(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, create := range []*storepb.Shortcut{
+		{Name: "never", ExpiresTs: 0},
+		{Name: "expired", ExpiresTs: 100},
+		{Name: "soon", ExpiresTs: 200},
+		{Name: "later", ExpiresTs: 300},
+	} {
+		create.CreatorId = user.ID
+		create.Link = "https://test.link"
+		create.Visibility = storepb.Visibility_PUBLIC
+		_, err := ts.CreateShortcut(ctx, create)
+		require.NoError(t, err)
+	}
+
+	// The range includes its start and excludes its end.
+	expiringAfter, expiringBefore := int64(200), int64(300)
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ExpiringAfter:  &expiringAfter,
+		ExpiringBefore: &expiringBefore,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "soon", list[0].Name)
+	require.Equal(t, int64(200), list[0].ExpiresTs)
+
+	// The shortcuts that never expire are not found by an open range.
+	list, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ExpiringBefore: &expiringBefore,
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+func TestShortcutStoreLastViewed(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts := map[string]*storepb.Shortcut{}
+	for _, name := range []string{"never", "old", "recent"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link",
+			Visibility: storepb.Visibility_PUBLIC,
+		})
+		require.NoError(t, err)
+		require.Zero(t, shortcut.LastViewedTs)
+		shortcuts[name] = shortcut
+	}
+	require.NoError(t, ts.UpdateShortcutLastViewedTs(ctx, shortcuts["old"].Id, 100))
+	require.NoError(t, ts.UpdateShortcutLastViewedTs(ctx, shortcuts["recent"].Id, 300))
+	// The last view time never goes back.
+	require.NoError(t, ts.UpdateShortcutLastViewedTs(ctx, shortcuts["recent"].Id, 200))
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcuts["recent"].Id})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), shortcut.LastViewedTs)
+
+	// The never viewed shortcuts are not viewed since any time.
+	notViewedSince := int64(250)
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		NotViewedSince: &notViewedSince,
+	})
+	require.NoError(t, err)
+	names := []string{}
+	for _, shortcut := range list {
+		names = append(names, shortcut.Name)
+	}
+	require.ElementsMatch(t, []string{"never", "old"}, names)
+}
+
+func TestShortcutStoreUpdateTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{"old"},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	shortcuts, err := ts.UpdateShortcutTags(ctx, &store.UpdateShortcutTags{
+		IDList:     []int32{shortcut.Id, shortcut.Id + 1},
+		AddTags:    []string{"new", "old"},
+		RemoveTags: []string{"missing"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, []string{"old", "new"}, shortcuts[0].Tags)
+
+	shortcuts, err = ts.UpdateShortcutTags(ctx, &store.UpdateShortcutTags{
+		IDList:     []int32{shortcut.Id},
+		RemoveTags: []string{"old"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"new"}, shortcuts[0].Tags)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"new"}, shortcut.Tags)
+}
+
+func TestShortcutStoreUpdateEditTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{"keep", "old"},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	// Add only.
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      shortcut.Id,
+		AddTags: []string{"new", "keep"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "old", "new"}, shortcut.Tags)
+
+	// Remove only.
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:         shortcut.Id,
+		RemoveTags: []string{"old", "missing"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "new"}, shortcut.Tags)
+
+	// Combined with another field.
+	title := "Test"
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:         shortcut.Id,
+		Title:      &title,
+		AddTags:    []string{"Other"},
+		RemoveTags: []string{"new"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Test", shortcut.Title)
+	require.Equal(t, []string{"keep", "other"}, shortcut.Tags)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"keep", "other"}, shortcut.Tags)
+
+	// The edits apply to the given tags when they are set.
+	tag := "base"
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:      shortcut.Id,
+		Tag:     &tag,
+		AddTags: []string{"added"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"base", "added"}, shortcut.Tags)
+}
+
+func TestShortcutStoreTagNormalization(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{"News", " news ", "", "Read  Later", "NEWS"},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"news", "read-later"}, shortcut.Tags)
+
+	tag := "Go go GO Dev"
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:  shortcut.Id,
+		Tag: &tag,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "dev"}, shortcut.Tags)
+
+	shortcuts, err := ts.UpdateShortcutTags(ctx, &store.UpdateShortcutTags{
+		IDList:     []int32{shortcut.Id},
+		AddTags:    []string{"DEV", "Ops"},
+		RemoveTags: []string{"GO"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, []string{"dev", "ops"}, shortcuts[0].Tags)
+
+	preserveCaseStore := store.New(nil, &profile.Profile{PreserveTagCase: true})
+	require.Equal(t, []string{"News", "news", "Read-Later"}, preserveCaseStore.NormalizeTags([]string{" News", "news", "News", "Read Later"}))
+}
+
+func TestShortcutStoreNoCache(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	// Two stores sharing the database stand for two instances, each with its own cache.
+	ts := store.New(database.DBInstance, profile)
+	otherStore := store.New(database.DBInstance, profile)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	cached, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "cached",
+		Link:       "https://old.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	noCache, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "no-cache",
+		Link:       "https://old.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+		NoCache:    true,
+	})
+	require.NoError(t, err)
+	require.True(t, noCache.NoCache)
+
+	newLink := "https://new.link"
+	for _, id := range []int32{cached.Id, noCache.Id} {
+		_, err = otherStore.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:   id,
+			Link: &newLink,
+		})
+		require.NoError(t, err)
+	}
+
+	// The cached shortcut is stale until its cache entry goes, while the no cache one is read fresh.
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &cached.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://old.link", shortcut.Link)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &noCache.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://new.link", shortcut.Link)
+}
+
+func TestShortcutStoreListByNames(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	ts := store.New(database.DBInstance, profile)
+	otherStore := store.New(database.DBInstance, profile)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The names span more queries than one, to stay below the parameter limit of SQLite.
+	names := []string{}
+	for i := 0; i < 1200; i++ {
+		names = append(names, fmt.Sprintf("name-%d", i))
+	}
+	created := map[string]int32{}
+	for _, name := range []string{"name-0", "name-600", "name-1199"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://old.link",
+			Visibility: storepb.Visibility_PUBLIC,
+			Tags:       []string{},
+		})
+		require.NoError(t, err)
+		created[name] = shortcut.Id
+	}
+	shortcuts, err := ts.ListShortcutsByNames(ctx, names)
+	require.NoError(t, err)
+	require.Len(t, shortcuts, len(created))
+	for name, id := range created {
+		require.Equal(t, id, shortcuts[name].Id)
+		require.Equal(t, name, shortcuts[name].Name)
+	}
+
+	shortcuts, err = ts.ListShortcutsByNames(ctx, []string{"name-600", "missing"})
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 1)
+	require.Equal(t, created["name-600"], shortcuts["name-600"].Id)
+	shortcuts, err = ts.ListShortcutsByNames(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, shortcuts)
+
+	// The shortcuts found are cached.
+	newLink := "https://new.link"
+	_, err = otherStore.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   created["name-0"],
+		Link: &newLink,
+	})
+	require.NoError(t, err)
+	id := created["name-0"]
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &id})
+	require.NoError(t, err)
+	require.Equal(t, "https://old.link", shortcut.Link)
+}
+
+func TestShortcutStoreConfusableName(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.NormalizeNames = true
+	testingDB := db.NewDB(profile)
+	require.NoError(t, testingDB.Open(ctx))
+	ts := store.New(testingDB.DBInstance, profile)
+	defer ts.Close(ctx)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The shortcuts stored without a skeleton, as by seed files, are checked too and get it.
+	_, err = testingDB.DBInstance.ExecContext(ctx, `INSERT INTO shortcut (creator_id, name, link) VALUES (?, 'paypal', 'https://paypal.example.com')`, user.ID)
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "p\u0430yp\u0430l",
+		Link:       "https://phishing.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.ErrorIs(t, err, store.ErrConfusableName)
+	var skeleton string
+	require.NoError(t, testingDB.DBInstance.QueryRowContext(ctx, `SELECT name_skeleton FROM shortcut WHERE name = 'paypal'`).Scan(&skeleton))
+	require.Equal(t, "paypal", skeleton)
+
+	// The skeletons of the created and renamed shortcuts are stored.
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "Docs",
+		Link:       "https://docs.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	name := "W\u0456ki"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.NoError(t, testingDB.DBInstance.QueryRowContext(ctx, `SELECT name_skeleton FROM shortcut WHERE id = ?`, shortcut.Id).Scan(&skeleton))
+	require.Equal(t, "wiki", skeleton)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		Tags:       []string{},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.ErrorIs(t, err, store.ErrConfusableName)
+}
@@ -0,0 +1,69 @@
+package teststore
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	ctx := context.Background()
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	defer slog.SetDefault(defaultLogger)
+
+	// Every query is slower than a threshold of a nanosecond.
+	profile := test.GetTestingProfile(t)
+	profile.SlowQueryThreshold = time.Nanosecond
+	database := db.NewDB(profile)
+	require.NoError(t, database.Open(ctx))
+	ts := store.New(database.DBInstance, profile)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	logs.Reset()
+
+	secretName := "secret-shortcut-name"
+	_, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		Name: &secretName,
+	})
+	require.NoError(t, err)
+	require.Contains(t, logs.String(), `msg="slow query" query=ListShortcuts elapsed=`)
+	// The arguments of the query are never logged.
+	require.NotContains(t, logs.String(), secretName)
+
+	// Transactions are logged as a whole, named after the method running them.
+	logs.Reset()
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		Tags:       []string{},
+	})
+	require.NoError(t, err)
+	title := "Test"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:    shortcut.Id,
+		Title: &title,
+	})
+	require.NoError(t, err)
+	require.Contains(t, logs.String(), "query=CreateShortcut ")
+	require.Contains(t, logs.String(), "query=UpdateShortcut ")
+
+	// Nothing is logged when the store is not configured with a threshold.
+	logs.Reset()
+	defaultStore := NewTestingStore(ctx, t)
+	_, err = defaultStore.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Empty(t, logs.String())
+}
@@ -0,0 +1,150 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestSweep(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	keptShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "kept",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	deletedShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "deleted",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	for _, shortcutID := range []int32{keptShortcut.Id, deletedShortcut.Id, deletedShortcut.Id} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcutID),
+		})
+		require.NoError(t, err)
+	}
+	// Activities without a valid payload are left untouched.
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   "",
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: deletedShortcut.Id,
+	})
+	require.NoError(t, err)
+	// Seed a user setting of a user that does not exist.
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID + 100,
+		Key:    storepb.UserSettingKey_USER_SETTING_LOCALE,
+		Value: &storepb.UserSetting_Locale{
+			Locale: storepb.LocaleUserSetting_LOCALE_USER_SETTING_EN,
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := ts.Sweep(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &store.SweepResult{
+		Shortcuts:    0,
+		UserSettings: 1,
+		Activities:   2,
+	}, result)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(activities))
+	userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userSettings))
+
+	// Sweeping again finds nothing left.
+	result, err = ts.Sweep(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &store.SweepResult{}, result)
+}
+
+func TestPruneArchivedShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts := map[string]*storepb.Shortcut{}
+	for _, name := range []string{"normal", "archived", "restored"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link",
+			Visibility: storepb.Visibility_PUBLIC,
+		})
+		require.NoError(t, err)
+		_, err = ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+		})
+		require.NoError(t, err)
+		_, err = ts.UpsertShortcutCollaborator(ctx, &store.ShortcutCollaborator{
+			ShortcutID: shortcut.Id,
+			UserID:     user.ID,
+		})
+		require.NoError(t, err)
+		shortcuts[name] = shortcut
+	}
+	archived, normal := store.Archived, store.Normal
+	before := time.Now().Unix()
+	for _, name := range []string{"archived", "restored"} {
+		shortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcuts[name].Id, RowStatus: &archived})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, shortcut.ArchivedTs, before)
+		shortcuts[name] = shortcut
+	}
+	// Archiving again keeps the archive time, and restoring clears it.
+	shortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcuts["archived"].Id, RowStatus: &archived})
+	require.NoError(t, err)
+	require.Equal(t, shortcuts["archived"].ArchivedTs, shortcut.ArchivedTs)
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcuts["restored"].Id, RowStatus: &normal})
+	require.NoError(t, err)
+	require.Zero(t, shortcut.ArchivedTs)
+
+	// The shortcuts archived after the cutoff are kept.
+	result, err := ts.PruneArchivedShortcuts(ctx, before-60)
+	require.NoError(t, err)
+	require.Equal(t, &store.PruneResult{}, result)
+
+	result, err = ts.PruneArchivedShortcuts(ctx, time.Now().Unix()+1)
+	require.NoError(t, err)
+	require.Equal(t, &store.PruneResult{Shortcuts: 1, Activities: 1, ShortcutCollaborators: 1}, result)
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	names := []string{}
+	for _, shortcut := range list {
+		names = append(names, shortcut.Name)
+	}
+	require.ElementsMatch(t, []string{"normal", "restored"}, names)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcuts["archived"].Id})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Len(t, activities, 2)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
@@ -118,3 +119,72 @@ func TestUserSettingStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, storepb.ColorThemeUserSetting_COLOR_THEME_USER_SETTING_DARK, colorThemeUserSetting.GetColorTheme())
 }
+
+func TestUserSettingStorePreferences(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	_, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+
+	preferences := &storepb.PreferencesUserSetting{
+		DefaultVisibility: storepb.Visibility_PRIVATE,
+		ListDensity:       storepb.PreferencesUserSetting_LIST_DENSITY_COMPACT,
+		ShortcutSort:      storepb.PreferencesUserSetting_SHORTCUT_SORT_NAME,
+	}
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+		Value: &storepb.UserSetting_Preferences{
+			Preferences: preferences,
+		},
+	})
+	require.NoError(t, err)
+	userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userSettings))
+	require.True(t, proto.Equal(preferences, userSettings[0].GetPreferences()))
+
+	require.NoError(t, ts.DeleteUserSetting(ctx, &store.DeleteUserSetting{
+		UserID: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+	}))
+	userSetting, err := ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+	})
+	require.NoError(t, err)
+	require.Nil(t, userSetting)
+
+	// Deleting the user deletes its settings.
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+		Value: &storepb.UserSetting_Preferences{
+			Preferences: preferences,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	}))
+	userSettings, err = ts.ListUserSettings(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userSettings))
+	userSetting, err = ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PREFERENCES,
+	})
+	require.NoError(t, err)
+	require.Nil(t, userSetting)
+}
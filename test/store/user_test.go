@@ -9,6 +9,8 @@ import (
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestUserStore(t *testing.T) {
@@ -35,18 +37,199 @@ func TestUserStore(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, userPatchNickname, user.Nickname)
+	// Keep another admin around, the last admin cannot be deleted.
+	_, err = ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin2@test.com",
+		Nickname: "admin2",
+	})
+	require.NoError(t, err)
 	err = ts.DeleteUser(ctx, &store.DeleteUser{
 		ID: user.ID,
 	})
 	require.NoError(t, err)
 	users, err = ts.ListUsers(ctx, &store.FindUser{})
 	require.NoError(t, err)
-	require.Equal(t, 0, len(users))
+	require.Equal(t, 1, len(users))
 	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
 
+func TestUserStoreListSearchAndOrder(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	for _, create := range []*store.User{
+		{Role: store.RoleUser, Email: "bob@example.com", Nickname: "bobby"},
+		{Role: store.RoleUser, Email: "alice@example.com", Nickname: "ally_100%"},
+		{Role: store.RoleUser, Email: "carol@test.com", Nickname: "carol"},
+	} {
+		_, err := ts.CreateUser(ctx, create)
+		require.NoError(t, err)
+	}
+
+	listEmails := func(find *store.FindUser) []string {
+		users, err := ts.ListUsers(ctx, find)
+		require.NoError(t, err)
+		emails := []string{}
+		for _, user := range users {
+			emails = append(emails, user.Email)
+		}
+		return emails
+	}
+
+	search := "example"
+	require.ElementsMatch(t, []string{"bob@example.com", "alice@example.com"}, listEmails(&store.FindUser{Search: &search}))
+	search = "CAR"
+	require.Equal(t, []string{"carol@test.com"}, listEmails(&store.FindUser{Search: &search}))
+	search = "100%"
+	require.Equal(t, []string{"alice@example.com"}, listEmails(&store.FindUser{Search: &search}))
+	search = "_"
+	require.Equal(t, []string{"alice@example.com"}, listEmails(&store.FindUser{Search: &search}))
+
+	require.Equal(t, []string{"alice@example.com", "bob@example.com", "carol@test.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByEmail}))
+	require.Equal(t, []string{"carol@test.com", "bob@example.com", "alice@example.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByEmail, OrderDesc: true}))
+	require.Equal(t, []string{"alice@example.com", "bob@example.com", "carol@test.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByNickname}))
+	require.Equal(t, []string{"bob@example.com", "alice@example.com", "carol@test.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByCreatedTs}))
+	require.Equal(t, []string{"carol@test.com", "alice@example.com", "bob@example.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByCreatedTs, OrderDesc: true}))
+	require.Equal(t, []string{"bob@example.com", "alice@example.com", "carol@test.com"}, listEmails(&store.FindUser{OrderBy: store.UserOrderByUpdatedTs}))
+
+	_, err := ts.ListUsers(ctx, &store.FindUser{OrderBy: "password_hash"})
+	require.Error(t, err)
+}
+
+func TestUserStoreListTimeRanges(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	testingDB := db.NewDB(profile)
+	require.NoError(t, testingDB.Open(ctx))
+	ts := store.New(testingDB.DBInstance, profile)
+	for _, user := range []struct {
+		email     string
+		createdTs int64
+		updatedTs int64
+	}{
+		{email: "old@test.com", createdTs: 1000, updatedTs: 1000},
+		{email: "stale@test.com", createdTs: 2000, updatedTs: 2000},
+		{email: "active@test.com", createdTs: 2000, updatedTs: 3000},
+	} {
+		created, err := ts.CreateUser(ctx, &store.User{
+			Role:     store.RoleUser,
+			Email:    user.email,
+			Nickname: user.email,
+		})
+		require.NoError(t, err)
+		_, err = testingDB.DBInstance.ExecContext(ctx, `UPDATE user SET created_ts = ?, updated_ts = ? WHERE id = ?`, user.createdTs, user.updatedTs, created.ID)
+		require.NoError(t, err)
+	}
+
+	listEmails := func(find *store.FindUser) []string {
+		users, err := ts.ListUsers(ctx, find)
+		require.NoError(t, err)
+		emails := []string{}
+		for _, user := range users {
+			emails = append(emails, user.Email)
+		}
+		return emails
+	}
+	ts1000, ts2000, ts3000 := int64(1000), int64(2000), int64(3000)
+
+	// The after bounds are inclusive and the before bounds are exclusive.
+	require.ElementsMatch(t, []string{"stale@test.com", "active@test.com"}, listEmails(&store.FindUser{CreatedTsAfter: &ts2000}))
+	require.Equal(t, []string{"old@test.com"}, listEmails(&store.FindUser{CreatedTsBefore: &ts2000}))
+	require.Equal(t, []string{"old@test.com"}, listEmails(&store.FindUser{CreatedTsAfter: &ts1000, CreatedTsBefore: &ts2000}))
+	require.Empty(t, listEmails(&store.FindUser{CreatedTsAfter: &ts2000, CreatedTsBefore: &ts2000}))
+	require.Equal(t, []string{"active@test.com"}, listEmails(&store.FindUser{UpdatedTsAfter: &ts3000}))
+	// The users created in a range and not updated since.
+	require.Equal(t, []string{"stale@test.com"}, listEmails(&store.FindUser{CreatedTsAfter: &ts2000, UpdatedTsBefore: &ts3000}))
+}
+
+func TestUserStoreCount(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	_, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, create := range []*store.User{
+		{Role: store.RoleUser, Email: "user1@test.com", Nickname: "user1"},
+		{Role: store.RoleUser, Email: "user2@test.com", Nickname: "user2"},
+	} {
+		_, err := ts.CreateUser(ctx, create)
+		require.NoError(t, err)
+	}
+
+	count, err := ts.CountUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	role := store.RoleAdmin
+	count, err = ts.CountUsers(ctx, &store.FindUser{Role: &role})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	role = store.RoleUser
+	count, err = ts.CountUsers(ctx, &store.FindUser{Role: &role})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	search := "user"
+	count, err = ts.CountUsers(ctx, &store.FindUser{Search: &search, Role: &role})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestUserStoreLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Demoting, archiving or deleting the only admin is refused.
+	userRole := store.RoleUser
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:   admin.ID,
+		Role: &userRole,
+	})
+	require.ErrorIs(t, err, store.ErrLastAdmin)
+	archived := store.Archived
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:        admin.ID,
+		RowStatus: &archived,
+	})
+	require.ErrorIs(t, err, store.ErrLastAdmin)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: admin.ID,
+	})
+	require.ErrorIs(t, err, store.ErrLastAdmin)
+	user, err := ts.GetUser(ctx, &store.FindUser{ID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleAdmin, user.Role)
+	require.Equal(t, store.Normal, user.RowStatus)
+
+	// With a second admin, either of them can be demoted or deleted.
+	secondAdmin, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "admin2@test.com",
+		Nickname: "admin2",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:   admin.ID,
+		Role: &userRole,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: secondAdmin.ID,
+	})
+	require.ErrorIs(t, err, store.ErrLastAdmin)
+	adminRole := store.RoleAdmin
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:   admin.ID,
+		Role: &adminRole,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: secondAdmin.ID,
+	})
+	require.NoError(t, err)
+}
+
 // createTestingAdminUser creates a testing admin user.
 func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
@@ -0,0 +1,32 @@
+package teststore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestValidationError(t *testing.T) {
+	err := store.NewValidationError("invalid shortcut", map[string]string{
+		"name": "name is required",
+		"link": "link must not be empty",
+	})
+	require.Equal(t, "invalid shortcut, link: link must not be empty, name: name is required", err.Error())
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+	require.JSONEq(t, `{"message": "invalid shortcut", "fields": {"name": "name is required", "link": "link must not be empty"}}`, string(data))
+
+	// The fields are never null, and the cause is matched but not serialized.
+	err = &store.ValidationError{Message: "invalid shortcut", Err: store.ErrTooManyTags}
+	data, marshalErr = json.Marshal(err)
+	require.NoError(t, marshalErr)
+	require.JSONEq(t, `{"message": "invalid shortcut", "fields": {}}`, string(data))
+	wrapped := errors.Wrap(err, "failed to update shortcut")
+	require.ErrorIs(t, wrapped, store.ErrTooManyTags)
+	var validationErr *store.ValidationError
+	require.True(t, errors.As(wrapped, &validationErr))
+}
@@ -0,0 +1,62 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestWelcomeShortcuts(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.Mode = "prod"
+	profile.Welcome = true
+	welcomeDB := db.NewDB(profile)
+	require.NoError(t, welcomeDB.Open(ctx))
+	ts := store.New(welcomeDB.DBInstance, profile)
+
+	// The welcome shortcuts are kept by sweeps until the first user signs up.
+	result, err := ts.Sweep(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), result.Shortcuts)
+
+	// The welcome shortcuts belong to the first user to sign up.
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	require.ElementsMatch(t, []string{"slash", "slash-docs"}, names)
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &names[0],
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"slash"}, shortcut.Tags)
+
+	// The shortcuts are only seeded on first initialization.
+	require.NoError(t, welcomeDB.DBInstance.Close())
+	reopenedDB := db.NewDB(profile)
+	require.NoError(t, reopenedDB.Open(ctx))
+	shortcuts, err = store.New(reopenedDB.DBInstance, profile).ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// Without the flag, a new instance starts empty.
+	emptyProfile := test.GetTestingProfile(t)
+	emptyProfile.Mode = "prod"
+	emptyDB := db.NewDB(emptyProfile)
+	require.NoError(t, emptyDB.Open(ctx))
+	shortcuts, err = store.New(emptyDB.DBInstance, emptyProfile).ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
@@ -9,6 +9,8 @@ import (
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestWorkspaceSettingStore(t *testing.T) {
@@ -30,3 +32,71 @@ func TestWorkspaceSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(workspaceSettings))
 	require.Equal(t, foundWorkspaceSetting, workspaceSettings[0])
 }
+
+func TestWorkspaceSettingStoreUniqueVisitorWindow(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW,
+		Value: &storepb.WorkspaceSetting_UniqueVisitorWindow{UniqueVisitorWindow: storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION},
+	})
+	require.NoError(t, err)
+	workspaceSettings, err := ts.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_UNIQUE_VISITOR_WINDOW,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(workspaceSettings))
+	require.Equal(t, storepb.UniqueVisitorWindow_UNIQUE_VISITOR_WINDOW_SESSION, workspaceSettings[0].GetUniqueVisitorWindow())
+}
+
+func TestWorkspaceSettingStoreTypedValues(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	testingDB := db.NewDB(profile)
+	require.NoError(t, testingDB.Open(ctx))
+	ts := store.New(testingDB.DBInstance, profile)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+		Value: &storepb.WorkspaceSetting_HashVisitorIps{HashVisitorIps: true},
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE,
+		Value: &storepb.WorkspaceSetting_RedirectCacheMaxAge{RedirectCacheMaxAge: 60},
+	})
+	require.NoError(t, err)
+	// Upserting a key again replaces its value.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE,
+		Value: &storepb.WorkspaceSetting_RedirectCacheMaxAge{RedirectCacheMaxAge: 120},
+	})
+	require.NoError(t, err)
+
+	// The values are parsed back to their types, not only served from the cache.
+	newStore := store.New(testingDB.DBInstance, profile)
+	workspaceSettings, err := newStore.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(workspaceSettings))
+	hashVisitorIPs, err := newStore.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_HASH_VISITOR_IPS,
+	})
+	require.NoError(t, err)
+	require.True(t, hashVisitorIPs.GetHashVisitorIps())
+	redirectCacheMaxAge, err := newStore.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REDIRECT_CACHE_MAX_AGE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(120), redirectCacheMaxAge.GetRedirectCacheMaxAge())
+}
+
+func TestWorkspaceSettingStoreUnknownKey(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED,
+	})
+	require.Error(t, err)
+	workspaceSettings, err := ts.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{})
+	require.NoError(t, err)
+	require.Empty(t, workspaceSettings)
+}